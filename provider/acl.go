@@ -0,0 +1,169 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+	"sort"
+	"strings"
+)
+
+type Acl struct{}
+
+type AclArgs struct {
+	TargetArgs
+	Path      string   `pulumi:"path" provider:"replaceOnChanges"`
+	Principal string   `pulumi:"principal" provider:"replaceOnChanges"`
+	Allow     []string `pulumi:"allow,optional"`
+	Deny      []string `pulumi:"deny,optional"`
+}
+
+func (m *AclArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Path, "Absolute repository path to which the access control entry is applied (e.g. '/content/acme').")
+	a.Describe(&m.Principal, "Identifier of the user or group to which the access control entry applies.")
+	a.Describe(&m.Allow, "Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').")
+	a.Describe(&m.Deny, "Privileges denied to the principal.")
+}
+
+type AclInstanceModel struct {
+	ID    string   `pulumi:"id"`
+	Allow []string `pulumi:"allow"`
+	Deny  []string `pulumi:"deny"`
+}
+
+func (m *AclInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Allow, "Privileges granted to the principal on the AEM instance.")
+	a.Describe(&m.Deny, "Privileges denied to the principal on the AEM instance.")
+}
+
+type AclState struct {
+	AclArgs
+	Instances []AclInstanceModel `pulumi:"instances"`
+}
+
+func (m *AclState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Instances, "Current state of the access control entry on the target AEM instances.")
+}
+
+func (m *Acl) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Access control entry of the principal applied to the repository path on the AEM instances. Removed from the instances when the resource is deleted.")
+}
+
+func (Acl) Create(ctx p.Context, name string, input AclArgs, preview bool) (string, AclState, error) {
+	state := AclState{AclArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	ac, err := connectAcl(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer ac.Close()
+
+	if err := ac.save(nil); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = ac.readInstances()
+	return name, state, err
+}
+
+func (Acl) Update(ctx p.Context, id string, olds AclState, news AclArgs, preview bool) (AclState, error) {
+	state := AclState{AclArgs: news, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	ac, err := connectAcl(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer ac.Close()
+
+	if err := ac.deleteFromRemovedInstances(olds); err != nil {
+		return state, err
+	}
+	if err := ac.save(&olds.AclArgs); err != nil {
+		return state, err
+	}
+	state.Instances, err = ac.readInstances()
+	return state, err
+}
+
+func (Acl) Delete(ctx p.Context, id string, props AclState) error {
+	ac, err := connectAcl(ctx, props.AclArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer ac.Close()
+
+	instances, err := ac.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := ac.delete(instance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (Acl) Read(ctx p.Context, id string, inputs AclArgs, state AclState) (string, AclArgs, AclState, error) {
+	ac, err := connectAcl(ctx, state.AclArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer ac.Close()
+
+	state.Instances, err = ac.readInstances()
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores the desired privileges
+	for _, instance := range state.Instances {
+		if !privilegesEqual(state.Allow, instance.Allow) || !privilegesEqual(state.Deny, instance.Deny) {
+			ctx.Logf(diag.Warning, "Access control entry of '%s' at '%s' on instance '%s' differs from the desired one", state.Principal, state.Path, instance.ID)
+			state.Allow = instance.Allow
+			state.Deny = instance.Deny
+			break
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (Acl) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (AclArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "allow", resource.NewArrayProperty([]resource.PropertyValue{}))
+	setDefaultValue(newInputs, "deny", resource.NewArrayProperty([]resource.PropertyValue{}))
+
+	args, failures, err := infer.DefaultCheck[AclArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs["path"].IsString() && !strings.HasPrefix(args.Path, "/") {
+		failures = append(failures, p.CheckFailure{Property: "path", Reason: "path must be absolute"})
+	}
+	if !newInputs.ContainsUnknowns() && len(args.Allow) == 0 && len(args.Deny) == 0 {
+		failures = append(failures, p.CheckFailure{Property: "allow", Reason: "at least one privilege in 'allow' or 'deny' is required"})
+	}
+	return args, failures, nil
+}
+
+func privilegesEqual(desired []string, actual []string) bool {
+	desired = slices.Clone(desired)
+	sort.Strings(desired)
+	return slices.Equal(desired, actual)
+}
+
+func connectAcl(ctx p.Context, model AclArgs, timeout string) (*AclClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &AclClient{tc.cl, ctx, model}, nil
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+	"net/url"
+	"sort"
+)
+
+type AclClient ClientContext[AclArgs]
+
+func (ac *AclClient) Close() error {
+	return ac.cl.Disconnect()
+}
+
+func (ac *AclClient) target() *TargetClient {
+	return &TargetClient{ac.cl, ac.ctx, ac.data.TargetArgs}
+}
+
+// save applies the access control entry using Sling Access Manager. When previous arguments are given, the privileges
+// no longer listed are removed from the entry, so it does not need to be deleted and applied again.
+func (ac *AclClient) save(olds *AclArgs) error {
+	instances, err := ac.target().instances()
+	if err != nil {
+		return err
+	}
+	form := aclForm(olds, ac.data)
+	for _, instance := range instances {
+		action := fmt.Sprintf("Applying access control entry of '%s' at '%s'", ac.data.Principal, ac.data.Path)
+		if err := ac.target().postForm(instance, ac.data.Path+".modifyAce.html", form, action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aclForm maps the privileges to the Sling Access Manager form, where 'none' removes the privilege from the entry.
+func aclForm(olds *AclArgs, news AclArgs) url.Values {
+	form := url.Values{}
+	form.Set("principalId", news.Principal)
+	if olds != nil {
+		for _, privilege := range append(slices.Clone(olds.Allow), olds.Deny...) {
+			form.Set("privilege@"+privilege, "none")
+		}
+	}
+	for _, privilege := range news.Allow {
+		form.Set("privilege@"+privilege, "granted")
+	}
+	for _, privilege := range news.Deny {
+		form.Set("privilege@"+privilege, "denied")
+	}
+	return form
+}
+
+func (ac *AclClient) delete(instance InstanceConfig) error {
+	action := fmt.Sprintf("Deleting access control entry of '%s' at '%s'", ac.data.Principal, ac.data.Path)
+	return ac.target().postForm(instance, ac.data.Path+".deleteAce.html", url.Values{":applyTo": {ac.data.Principal}}, action)
+}
+
+func (ac *AclClient) deleteFromRemovedInstances(olds AclState) error {
+	removedInstances, err := ac.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := ac.delete(instance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readInstances reads the entries of the principal supporting both formats of the Sling Access Manager output
+// (lists 'granted'/'denied' and map 'privileges' with 'allow'/'deny' flags).
+func (ac *AclClient) readInstances() ([]AclInstanceModel, error) {
+	instances, err := ac.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []AclInstanceModel
+	for _, instance := range instances {
+		response, err := ac.target().request(instance, "GET", ac.data.Path+".acl.json", nil)
+		if err != nil {
+			return nil, err
+		}
+		if response.Status != 200 {
+			return nil, fmt.Errorf("unable to read access control list of '%s' on instance '%s' (HTTP status %d)", ac.data.Path, instance.ID, response.Status)
+		}
+		var acl map[string]map[string]any
+		if err := json.Unmarshal(response.Body, &acl); err != nil {
+			return nil, fmt.Errorf("unable to parse access control list of '%s' on instance '%s': %w", ac.data.Path, instance.ID, err)
+		}
+		model := AclInstanceModel{ID: instance.ID, Allow: []string{}, Deny: []string{}}
+		if entry, ok := acl[ac.data.Principal]; ok {
+			model.Allow = append(model.Allow, cast.ToStringSlice(entry["granted"])...)
+			model.Deny = append(model.Deny, cast.ToStringSlice(entry["denied"])...)
+			for privilege, value := range cast.ToStringMap(entry["privileges"]) {
+				flags := cast.ToStringMap(value)
+				if flags["allow"] != nil {
+					model.Allow = append(model.Allow, privilege)
+				}
+				if flags["deny"] != nil {
+					model.Deny = append(model.Deny, privilege)
+				}
+			}
+		}
+		sort.Strings(model.Allow)
+		sort.Strings(model.Deny)
+		result = append(result, model)
+	}
+	return result, nil
+}
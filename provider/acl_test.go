@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAclForm(t *testing.T) {
+	news := AclArgs{Path: "/content/acme", Principal: "authors", Allow: []string{"jcr:read", "rep:write"}, Deny: []string{"crx:replicate"}}
+	tests := []struct {
+		name     string
+		olds     *AclArgs
+		expected url.Values
+	}{
+		{
+			name: "created",
+			olds: nil,
+			expected: url.Values{
+				"principalId":             {"authors"},
+				"privilege@jcr:read":      {"granted"},
+				"privilege@rep:write":     {"granted"},
+				"privilege@crx:replicate": {"denied"},
+			},
+		},
+		{
+			name: "privileges removed and moved",
+			olds: &AclArgs{Path: "/content/acme", Principal: "authors", Allow: []string{"jcr:read", "crx:replicate"}, Deny: []string{"jcr:removeNode"}},
+			expected: url.Values{
+				"principalId":              {"authors"},
+				"privilege@jcr:read":       {"granted"},
+				"privilege@rep:write":      {"granted"},
+				"privilege@crx:replicate":  {"denied"},
+				"privilege@jcr:removeNode": {"none"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, aclForm(test.olds, news))
+		})
+	}
+}
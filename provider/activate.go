@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"net/url"
+	"strings"
+)
+
+type Activate struct{}
+
+type ActivateArgs struct {
+	TargetArgs
+	Paths []string `pulumi:"paths"`
+	Tree  bool     `pulumi:"tree,optional"`
+	Agent string   `pulumi:"agent,optional"`
+}
+
+func (m *ActivateArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Paths, "Repository paths of the content to be replicated (e.g. '/content/acme').")
+	a.Describe(&m.Tree, "Replicate the whole content trees under the paths instead of the single nodes.")
+	a.Describe(&m.Agent, "Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.")
+}
+
+type ActivateResultItem struct {
+	InstanceID string `pulumi:"instance_id"`
+	Path       string `pulumi:"path"`
+	Succeeded  bool   `pulumi:"succeeded"`
+	Status     int    `pulumi:"status"`
+	Message    string `pulumi:"message"`
+}
+
+func (m *ActivateResultItem) Annotate(a infer.Annotator) {
+	a.Describe(&m.InstanceID, "Unique identifier of AEM instance on which the replication was requested.")
+	a.Describe(&m.Path, "Repository path of the replicated content.")
+	a.Describe(&m.Succeeded, "Indicates if the replication request was accepted.")
+	a.Describe(&m.Status, "HTTP status of the replication request.")
+	a.Describe(&m.Message, "Response message of the replication request.")
+}
+
+type ActivateResult struct {
+	Results []ActivateResultItem `pulumi:"results"`
+}
+
+func (m *ActivateResult) Annotate(a infer.Annotator) {
+	a.Describe(&m.Results, "Replication results per instance and path.")
+}
+
+func (m *Activate) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.")
+}
+
+func (Activate) Call(ctx p.Context, input ActivateArgs) (ActivateResult, error) {
+	var result ActivateResult
+	if len(input.Paths) == 0 {
+		return result, fmt.Errorf("at least one path to activate is required")
+	}
+	if input.Tree && input.Agent != "" {
+		return result, fmt.Errorf("agent could be set only when replicating single nodes")
+	}
+	tc, err := connectTargetFunction(ctx, input.TargetArgs)
+	if err != nil {
+		return result, err
+	}
+	defer tc.Close()
+
+	instances, err := tc.instances()
+	if err != nil {
+		return result, err
+	}
+	for _, instance := range instances {
+		for _, path := range input.Paths {
+			item, err := activatePath(tc, instance, path, input.Tree, input.Agent)
+			if err != nil {
+				return result, err
+			}
+			result.Results = append(result.Results, item)
+		}
+	}
+	return result, nil
+}
+
+func activatePath(tc *TargetClient, instance InstanceConfig, path string, tree bool, agent string) (ActivateResultItem, error) {
+	tc.ctx.Logf(diag.Info, "Activating '%s' on instance '%s'", path, instance.ID)
+	form := url.Values{}
+	form.Set("path", path)
+	endpoint := "/bin/replicate.json"
+	if tree {
+		endpoint = "/libs/replication/treeactivation.html"
+		form.Set("cmd", "activate")
+		form.Set("ignoredeactivated", "false")
+		form.Set("onlymodified", "false")
+	} else {
+		form.Set("cmd", "Activate")
+		if agent != "" {
+			form.Set("agentId", agent)
+		}
+	}
+	response, err := tc.request(instance, "POST", endpoint, form)
+	if err != nil {
+		return ActivateResultItem{}, err
+	}
+	item := ActivateResultItem{
+		InstanceID: instance.ID,
+		Path:       path,
+		Status:     response.Status,
+		Succeeded:  response.Status == 200,
+		Message:    strings.TrimSpace(string(response.Body)),
+	}
+	if !item.Succeeded {
+		tc.ctx.Logf(diag.Warning, "Unable to activate '%s' on instance '%s' (HTTP status %d)", path, instance.ID, response.Status)
+	}
+	return item, nil
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"golang.org/x/exp/slices"
+	"net/url"
+	"sort"
+)
+
+// authorizablesPath is the endpoint of AEM Granite Security used to create users and groups.
+const authorizablesPath = "/libs/granite/security/post/authorizables"
+
+type authorizableQueryResult struct {
+	Hits []struct {
+		Path string `json:"path"`
+	} `json:"hits"`
+}
+
+// findAuthorizable determines the repository path of the user or group, returns empty string when it does not exist.
+func (tc *TargetClient) findAuthorizable(instance InstanceConfig, id string) (string, error) {
+	query := url.Values{}
+	query.Set("path", "/home")
+	query.Set("type", "rep:Authorizable")
+	query.Set("property", "rep:authorizableId")
+	query.Set("property.value", id)
+	query.Set("p.limit", "1")
+	response, err := tc.request(instance, "GET", "/bin/querybuilder.json?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	if response.Status != 200 {
+		return "", fmt.Errorf("unable to find authorizable '%s' on instance '%s' (HTTP status %d)", id, instance.ID, response.Status)
+	}
+	var result authorizableQueryResult
+	if err := json.Unmarshal(response.Body, &result); err != nil {
+		return "", fmt.Errorf("unable to parse authorizable '%s' query result on instance '%s': %w", id, instance.ID, err)
+	}
+	if len(result.Hits) == 0 {
+		return "", nil
+	}
+	return result.Hits[0].Path, nil
+}
+
+// postForm sends the form to the AEM instance and fails when it is not accepted.
+func (tc *TargetClient) postForm(instance InstanceConfig, path string, form url.Values, action string) error {
+	tc.ctx.Logf(diag.Info, "%s on instance '%s'", action, instance.ID)
+	response, err := tc.request(instance, "POST", path, form)
+	if err != nil {
+		return err
+	}
+	if response.Status != 200 && response.Status != 201 {
+		return fmt.Errorf("unable to perform action '%s' on instance '%s' (HTTP status %d)", action, instance.ID, response.Status)
+	}
+	return nil
+}
+
+// readAuthorizableIDs reads the authorizable references (e.g. 'declaredMemberOf', 'declaredMembers') of the user or group.
+func (tc *TargetClient) readAuthorizableIDs(instance InstanceConfig, path string, prop string) ([]string, error) {
+	response, err := tc.request(instance, "GET", path+".rw.json?props="+prop, nil)
+	if err != nil {
+		return nil, err
+	}
+	if response.Status != 200 {
+		return nil, fmt.Errorf("unable to read '%s' of authorizable '%s' on instance '%s' (HTTP status %d)", prop, path, instance.ID, response.Status)
+	}
+	var data map[string][]struct {
+		AuthorizableID string `json:"authorizableId"`
+	}
+	if err := json.Unmarshal(response.Body, &data); err != nil {
+		return nil, fmt.Errorf("unable to parse '%s' of authorizable '%s' on instance '%s': %w", prop, path, instance.ID, err)
+	}
+	var result []string
+	for _, item := range data[prop] {
+		result = append(result, item.AuthorizableID)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// updateMembership adds and removes members of the group.
+func (tc *TargetClient) updateMembership(instance InstanceConfig, groupID string, added []string, removed []string) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	groupPath, err := tc.findAuthorizable(instance, groupID)
+	if err != nil {
+		return err
+	}
+	if groupPath == "" {
+		return fmt.Errorf("group '%s' does not exist on instance '%s'", groupID, instance.ID)
+	}
+	form := url.Values{}
+	for _, id := range added {
+		form.Add("addMembers", id)
+	}
+	for _, id := range removed {
+		form.Add("removeMembers", id)
+	}
+	return tc.postForm(instance, groupPath+".rw.html", form, fmt.Sprintf("Updating members of group '%s'", groupID))
+}
+
+func (tc *TargetClient) deleteAuthorizable(instance InstanceConfig, id string) error {
+	path, err := tc.findAuthorizable(instance, id)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		return nil
+	}
+	return tc.postForm(instance, path+".rw.html", url.Values{"deleteAuthorizable": {""}}, fmt.Sprintf("Deleting authorizable '%s'", id))
+}
+
+// authorizableIDsRemoved returns the identifiers present in the old list but missing in the new one.
+func authorizableIDsRemoved(olds []string, news []string) []string {
+	var result []string
+	for _, id := range olds {
+		if !slices.Contains(news, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// authorizableIDsKept returns the desired identifiers which are actually present, so that unmanaged ones are not reported as drift.
+func authorizableIDsKept(desired []string, actual []string) []string {
+	result := []string{}
+	for _, id := range desired {
+		if slices.Contains(actual, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
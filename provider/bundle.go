@@ -0,0 +1,185 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	BundleActive      = "active"
+	BundleStopped     = "stopped"
+	BundleUninstalled = "uninstalled"
+)
+
+var BundleStates = []string{BundleActive, BundleStopped, BundleUninstalled}
+
+type Bundle struct{}
+
+type BundleArgs struct {
+	TargetArgs
+	File     string `pulumi:"file,optional"`
+	URL      string `pulumi:"url,optional"`
+	Checksum string `pulumi:"checksum,optional"`
+	State    string `pulumi:"state,optional"`
+}
+
+func (m *BundleArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.File, "Local path of the OSGi bundle JAR file to be installed.")
+	a.Describe(&m.URL, "URL of the OSGi bundle JAR file to be downloaded on the machine and installed.")
+	a.Describe(&m.Checksum, "SHA-256 checksum of the bundle file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force reinstallation.")
+	a.Describe(&m.State, "Desired state of the bundle. Possible values are 'active', 'stopped' and 'uninstalled'.")
+}
+
+type BundleInstanceModel struct {
+	ID      string `pulumi:"id"`
+	State   string `pulumi:"state"`
+	Version string `pulumi:"version"`
+}
+
+func (m *BundleInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.State, "State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').")
+	a.Describe(&m.Version, "Version of the bundle installed on the AEM instance.")
+}
+
+type BundleState struct {
+	BundleArgs
+	SymbolicName string                `pulumi:"symbolic_name"`
+	Version      string                `pulumi:"version"`
+	RemotePath   string                `pulumi:"remote_path"`
+	Instances    []BundleInstanceModel `pulumi:"instances"`
+}
+
+func (m *BundleState) Annotate(a infer.Annotator) {
+	a.Describe(&m.SymbolicName, "Symbolic name of the bundle read from its manifest.")
+	a.Describe(&m.Version, "Version of the bundle read from its manifest.")
+	a.Describe(&m.RemotePath, "Remote path of the bundle file on the machine.")
+	a.Describe(&m.Instances, "Current state of the bundle on the target AEM instances.")
+}
+
+func (m *Bundle) Annotate(a infer.Annotator) {
+	a.Describe(&m, "OSGi bundle installed on the AEM instances in the desired state (e.g. hotfix). Uninstalled from the instances when the resource is deleted.")
+}
+
+func (Bundle) Create(ctx p.Context, name string, input BundleArgs, preview bool) (string, BundleState, error) {
+	state := BundleState{BundleArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	bc, err := connectBundle(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer bc.Close()
+
+	if err := bc.deploy(name, &state, true); err != nil {
+		return name, state, err
+	}
+	return name, state, nil
+}
+
+func (Bundle) Update(ctx p.Context, id string, olds BundleState, news BundleArgs, preview bool) (BundleState, error) {
+	state := BundleState{BundleArgs: news, SymbolicName: olds.SymbolicName, Version: olds.Version, RemotePath: olds.RemotePath, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	bc, err := connectBundle(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer bc.Close()
+
+	removedInstances, err := bc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return state, err
+	}
+	for _, instance := range removedInstances {
+		if err := bc.uninstall(instance, olds.SymbolicName); err != nil {
+			return state, err
+		}
+	}
+
+	changed := olds.File != news.File || olds.URL != news.URL || olds.Checksum != news.Checksum
+	if err := bc.deploy(id, &state, changed); err != nil {
+		return state, err
+	}
+	return state, nil
+}
+
+func (Bundle) Delete(ctx p.Context, id string, props BundleState) error {
+	bc, err := connectBundle(ctx, props.BundleArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer bc.Close()
+
+	return bc.remove(id, props)
+}
+
+func (Bundle) Read(ctx p.Context, id string, inputs BundleArgs, state BundleState) (string, BundleArgs, BundleState, error) {
+	bc, err := connectBundle(ctx, state.BundleArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer bc.Close()
+
+	state.Instances, err = bc.readInstances(state.SymbolicName)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores the desired state
+	for _, instance := range state.Instances {
+		if instance.State != state.State {
+			ctx.Logf(diag.Warning, "Bundle '%s' on instance '%s' is '%s' instead of '%s'", state.SymbolicName, instance.ID, instance.State, state.State)
+			state.State = instance.State
+			break
+		}
+		if instance.State != BundleUninstalled && instance.Version != state.Version {
+			ctx.Logf(diag.Warning, "Bundle '%s' on instance '%s' has version '%s' instead of '%s'", state.SymbolicName, instance.ID, instance.Version, state.Version)
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (Bundle) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (BundleArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "state", resource.NewStringProperty(BundleActive))
+
+	var failures []p.CheckFailure
+	if newInputs.HasValue("file") == newInputs.HasValue("url") && !newInputs.ContainsUnknowns() {
+		failures = append(failures, p.CheckFailure{Property: "file", Reason: "exactly one of 'file' or 'url' is required"})
+	}
+	if newInputs["file"].IsString() && !newInputs.HasValue("checksum") {
+		checksum, err := fileChecksum(newInputs["file"].StringValue())
+		if err != nil {
+			failures = append(failures, p.CheckFailure{Property: "file", Reason: err.Error()})
+		} else {
+			newInputs["checksum"] = resource.NewStringProperty(checksum)
+		}
+	}
+	if len(failures) > 0 {
+		return BundleArgs{}, failures, nil
+	}
+	args, failures, err := infer.DefaultCheck[BundleArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs["state"].IsString() && !slices.Contains(BundleStates, args.State) {
+		failures = append(failures, p.CheckFailure{Property: "state", Reason: "unknown state '" + args.State + "' (expected 'active', 'stopped' or 'uninstalled')"})
+	}
+	return args, failures, nil
+}
+
+func connectBundle(ctx p.Context, model BundleArgs, timeout string) (*BundleClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &BundleClient{tc.cl, ctx, model}, nil
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"net/url"
+	"path"
+	"strings"
+)
+
+type BundleClient ClientContext[BundleArgs]
+
+func (bc *BundleClient) Close() error {
+	return bc.cl.Disconnect()
+}
+
+func (bc *BundleClient) target() *TargetClient {
+	return &TargetClient{bc.cl, bc.ctx, bc.data.TargetArgs}
+}
+
+func (bc *BundleClient) bundleDir(id string) string {
+	return fmt.Sprintf("%s/aem/home/var/bundle/%s", bc.target().dataDir(), id)
+}
+
+func (bc *BundleClient) fileName() (string, error) {
+	if bc.data.File != "" {
+		return path.Base(bc.data.File), nil
+	}
+	u, err := url.Parse(bc.data.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid bundle URL '%s': %w", bc.data.URL, err)
+	}
+	return path.Base(u.Path), nil
+}
+
+func (bc *BundleClient) provide(remotePath string) error {
+	if bc.data.File != "" {
+		bc.ctx.Logf(diag.Info, "Copying bundle '%s'", bc.data.File)
+		if err := bc.cl.FileCopy(bc.data.File, remotePath, true); err != nil {
+			return fmt.Errorf("unable to copy bundle '%s': %w", bc.data.File, err)
+		}
+		return nil
+	}
+	return bc.target().download(bc.data.URL, remotePath)
+}
+
+// readManifest determines the bundle symbolic name and version from the JAR manifest.
+func (bc *BundleClient) readManifest(remotePath string) (string, string, error) {
+	out, err := bc.cl.RunShellPurely(fmt.Sprintf("unzip -p %s META-INF/MANIFEST.MF", utils.ShellQuote(remotePath)))
+	if err != nil {
+		return "", "", fmt.Errorf("unable to read manifest of bundle '%s': %w", remotePath, err)
+	}
+	headers := parseManifest(string(out))
+	symbolicName := strings.TrimSpace(strings.Split(headers["Bundle-SymbolicName"], ";")[0])
+	if symbolicName == "" {
+		return "", "", fmt.Errorf("file '%s' is not an OSGi bundle (missing 'Bundle-SymbolicName' header)", remotePath)
+	}
+	return symbolicName, headers["Bundle-Version"], nil
+}
+
+// parseManifest reads JAR manifest headers joining continuation lines.
+func parseManifest(text string) map[string]string {
+	result := map[string]string{}
+	lastKey := ""
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.HasPrefix(line, " ") && lastKey != "" {
+			result[lastKey] += line[1:]
+			continue
+		}
+		if key, value, ok := strings.Cut(line, ":"); ok {
+			lastKey = strings.TrimSpace(key)
+			result[lastKey] = strings.TrimSpace(value)
+		}
+	}
+	return result
+}
+
+func (bc *BundleClient) deploy(id string, state *BundleState, changed bool) error {
+	instances, err := bc.target().instances()
+	if err != nil {
+		return err
+	}
+	fileName, err := bc.fileName()
+	if err != nil {
+		return err
+	}
+	remotePath := fmt.Sprintf("%s/%s", bc.bundleDir(id), fileName)
+	if changed || state.RemotePath != remotePath {
+		if state.RemotePath != "" && state.RemotePath != remotePath {
+			_ = bc.cl.PathDelete(state.RemotePath)
+		}
+		if err := bc.provide(remotePath); err != nil {
+			return err
+		}
+	}
+	if err := bc.target().verifyChecksum(remotePath, bc.data.Checksum); err != nil {
+		return err
+	}
+	symbolicName, version, err := bc.readManifest(remotePath)
+	if err != nil {
+		return err
+	}
+	// bundle with other symbolic name is installed alongside, so the previous one is uninstalled first
+	if state.SymbolicName != "" && state.SymbolicName != symbolicName {
+		previous, err := bc.readInstances(state.SymbolicName)
+		if err != nil {
+			return err
+		}
+		for i, instance := range instances {
+			if previous[i].State == BundleUninstalled {
+				continue
+			}
+			if err := bc.uninstall(instance, state.SymbolicName); err != nil {
+				return err
+			}
+		}
+	}
+	state.RemotePath = remotePath
+	state.SymbolicName = symbolicName
+	state.Version = version
+
+	current, err := bc.readInstances(symbolicName)
+	if err != nil {
+		return err
+	}
+	for i, instance := range instances {
+		if bc.data.State == BundleUninstalled {
+			if current[i].State != BundleUninstalled {
+				if err := bc.uninstall(instance, symbolicName); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if changed || current[i].State == BundleUninstalled || current[i].Version != version {
+			bc.ctx.Logf(diag.Info, "Installing bundle '%s' on instance '%s'", symbolicName, instance.ID)
+			out, err := bc.target().runAemw(instance.ID, "bundle", "install", "--file", remotePath)
+			if err != nil {
+				return fmt.Errorf("unable to install bundle '%s' on instance '%s': %w", symbolicName, instance.ID, err)
+			}
+			bc.ctx.Log(diag.Info, string(out))
+		}
+		command := "start"
+		if bc.data.State == BundleStopped {
+			command = "stop"
+		}
+		bc.ctx.Logf(diag.Info, "Ensuring bundle '%s' is '%s' on instance '%s'", symbolicName, bc.data.State, instance.ID)
+		out, err := bc.target().runAemw(instance.ID, "bundle", command, "--symbolic-name", symbolicName)
+		if err != nil {
+			return fmt.Errorf("unable to %s bundle '%s' on instance '%s': %w", command, symbolicName, instance.ID, err)
+		}
+		bc.ctx.Log(diag.Info, string(out))
+	}
+
+	state.Instances, err = bc.readInstances(symbolicName)
+	return err
+}
+
+// remove uninstalls the bundle from the instances and deletes its file from the machine.
+func (bc *BundleClient) remove(id string, state BundleState) error {
+	// bundle in the desired 'uninstalled' state is already not installed on the instances
+	if state.State != BundleUninstalled {
+		instances, err := bc.target().instances()
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			if err := bc.uninstall(instance, state.SymbolicName); err != nil {
+				return err
+			}
+		}
+	}
+	if err := bc.cl.PathDelete(bc.bundleDir(id)); err != nil {
+		return fmt.Errorf("unable to delete bundle directory: %w", err)
+	}
+	return nil
+}
+
+func (bc *BundleClient) uninstall(instance InstanceConfig, symbolicName string) error {
+	if symbolicName == "" {
+		return nil
+	}
+	bc.ctx.Logf(diag.Info, "Uninstalling bundle '%s' from instance '%s'", symbolicName, instance.ID)
+	out, err := bc.target().runAemw(instance.ID, "bundle", "uninstall", "--symbolic-name", symbolicName)
+	if err != nil {
+		return fmt.Errorf("unable to uninstall bundle '%s' from instance '%s': %w", symbolicName, instance.ID, err)
+	}
+	bc.ctx.Log(diag.Info, string(out))
+	return nil
+}
+
+type bundleList struct {
+	Data []struct {
+		SymbolicName string `json:"symbolicName"`
+		Version      string `json:"version"`
+		State        string `json:"state"`
+	} `json:"data"`
+}
+
+// bundleState maps the state reported by Felix Web Console to the one used by the resource.
+func bundleState(consoleState string) string {
+	switch consoleState {
+	case "Active", "Fragment":
+		return BundleActive
+	case "":
+		return BundleUninstalled
+	default:
+		return BundleStopped
+	}
+}
+
+func (bc *BundleClient) readInstances(symbolicName string) ([]BundleInstanceModel, error) {
+	instances, err := bc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []BundleInstanceModel
+	for _, instance := range instances {
+		model := BundleInstanceModel{ID: instance.ID, State: BundleUninstalled}
+		if symbolicName != "" {
+			response, err := bc.target().request(instance, "GET", "/system/console/bundles/"+url.PathEscape(symbolicName)+".json", nil)
+			if err != nil {
+				return nil, err
+			}
+			switch response.Status {
+			case 200:
+				var list bundleList
+				if err := json.Unmarshal(response.Body, &list); err != nil {
+					return nil, fmt.Errorf("unable to parse bundle '%s' status on instance '%s': %w", symbolicName, instance.ID, err)
+				}
+				for _, item := range list.Data {
+					if item.SymbolicName == symbolicName {
+						model.State = bundleState(item.State)
+						model.Version = item.Version
+					}
+				}
+			case 404:
+				// bundle is not installed
+			default:
+				return nil, fmt.Errorf("unable to read bundle '%s' on instance '%s' (HTTP status %d)", symbolicName, instance.ID, response.Status)
+			}
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFelix answers the bundle manifest and status requests, reporting the given bundles as active.
+func fakeFelix(commands *[]string, active ...string) func(cmd string, script string) (string, error) {
+	return func(cmd string, script string) (string, error) {
+		*commands = append(*commands, cmd+"\n"+script)
+		switch {
+		case strings.Contains(cmd, "unzip -p"):
+			return "Manifest-Version: 1.0\nBundle-SymbolicName: com.acme.core;singleton:=true\nBundle-Version: 2.0.0\n", nil
+		case strings.Contains(script, "/system/console/bundles/"):
+			for _, name := range active {
+				if strings.Contains(script, "/system/console/bundles/"+name+".json") {
+					return `{"data":[{"symbolicName":"` + name + `","version":"1.0.0","state":"Active"}]}` + "\n200", nil
+				}
+			}
+			return "\n404", nil
+		}
+		return "", nil
+	}
+}
+
+func bundleActions(commands []string) []string {
+	var result []string
+	for _, command := range commands {
+		if i := strings.Index(command, "aemw 'bundle'"); i >= 0 {
+			result = append(result, command[i:])
+		}
+	}
+	return result
+}
+
+func newTestBundleClient(t *testing.T, handler func(cmd string, script string) (string, error), state string) (*BundleClient, *fakeConnection) {
+	file := filepath.Join(t.TempDir(), "acme core.jar")
+	require.NoError(t, os.WriteFile(file, []byte("jar"), 0644))
+	cl, conn := newFakeClient(handler)
+	config := "instance:\n  config:\n    local_author:\n      http_url: http://127.0.0.1:4502\n"
+	return &BundleClient{cl, newTestContext(t), BundleArgs{TargetArgs: TargetArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: config}}, File: file, State: state}}, conn
+}
+
+func TestBundleDeployUninstallsPreviousSymbolicName(t *testing.T) {
+	var commands []string
+	bc, conn := newTestBundleClient(t, fakeFelix(&commands, "com.acme.legacy"), BundleActive)
+	state := BundleState{SymbolicName: "com.acme.legacy"}
+
+	require.NoError(t, bc.deploy("core", &state, true))
+
+	assert.Equal(t, "com.acme.core", state.SymbolicName)
+	actions := bundleActions(commands)
+	require.Len(t, actions, 3)
+	assert.Contains(t, actions[0], "aemw 'bundle' 'uninstall' '--symbolic-name' 'com.acme.legacy'")
+	assert.Contains(t, actions[1], "aemw 'bundle' 'install' '--file' '/mnt/aemc/aem/home/var/bundle/core/acme core.jar'")
+	assert.Contains(t, actions[2], "aemw 'bundle' 'start' '--symbolic-name' 'com.acme.core'")
+	assert.True(t, conn.executed("unzip -p '/mnt/aemc/aem/home/var/bundle/core/acme core.jar'"))
+}
+
+func TestBundleRemove(t *testing.T) {
+	var commands []string
+	bc, conn := newTestBundleClient(t, fakeFelix(&commands), BundleActive)
+
+	require.NoError(t, bc.remove("core", BundleState{BundleArgs: BundleArgs{State: BundleActive}, SymbolicName: "com.acme.core"}))
+
+	actions := bundleActions(commands)
+	require.Len(t, actions, 1)
+	assert.Contains(t, actions[0], "aemw 'bundle' 'uninstall' '--symbolic-name' 'com.acme.core'")
+	assert.True(t, conn.executed("rm -rf /mnt/aemc/aem/home/var/bundle/core"))
+}
+
+func TestBundleRemoveUninstalled(t *testing.T) {
+	var commands []string
+	bc, _ := newTestBundleClient(t, fakeFelix(&commands), BundleUninstalled)
+
+	require.NoError(t, bc.remove("core", BundleState{BundleArgs: BundleArgs{State: BundleUninstalled}, SymbolicName: "com.acme.core"}))
+
+	assert.Empty(t, bundleActions(commands))
+}
@@ -67,6 +67,15 @@ func (c Client) Connection() Connection {
 	return c.connection
 }
 
+// Tunnel forwards a local port to the address reachable from the machine, so it could be accessed directly by the provider.
+func (c Client) Tunnel(remoteAddr string) (string, error) {
+	tunneler, ok := c.connection.(Tunneler)
+	if !ok {
+		return "", fmt.Errorf("connection type '%s' does not support port forwarding", c.typeName)
+	}
+	return tunneler.Tunnel(remoteAddr)
+}
+
 func (c Client) Command(cmdLine []string) ([]byte, error) {
 	return c.connection.Command(cmdLine)
 }
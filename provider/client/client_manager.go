@@ -11,13 +11,18 @@ func (c ClientManager) Make(typeName string, settings map[string]string) (*Clien
 	if err != nil {
 		return nil, err
 	}
+	return NewClient(typeName, settings, connection), nil
+}
+
+// NewClient creates the client operating over the given connection.
+func NewClient(typeName string, settings map[string]string, connection Connection) *Client {
 	return &Client{
 		typeName:   typeName,
 		settings:   settings,
 		connection: connection,
 
 		Env: map[string]string{},
-	}, nil
+	}
 }
 
 func (c ClientManager) Use(typeName string, settings map[string]string, callback func(c Client) error) error {
@@ -52,6 +57,17 @@ func (c ClientManager) connection(typeName string, settings map[string]string) (
 	return nil, fmt.Errorf("unknown AEM client type: %s", typeName)
 }
 
+// RequiredSettings lists settings (or credentials) which need to be set to connect using the given client type.
+func (c ClientManager) RequiredSettings(typeName string) ([]string, error) {
+	switch typeName {
+	case "ssh":
+		return []string{"host", "user", "private_key"}, nil
+	case "aws-ssm":
+		return []string{"instance_id"}, nil
+	}
+	return nil, fmt.Errorf("unknown AEM client type: %s", typeName)
+}
+
 type ClientManager struct{}
 
 var ClientManagerDefault = &ClientManager{}
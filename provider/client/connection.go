@@ -1,5 +1,12 @@
 package client
 
+import (
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
 type Connection interface {
 	Info() string
 	User() string
@@ -8,3 +15,46 @@ type Connection interface {
 	Command(cmdLine []string) ([]byte, error)
 	CopyFile(localPath string, remotePath string) error
 }
+
+// Tunneler is implemented by connections able to forward TCP traffic from the provider process to the machine.
+type Tunneler interface {
+	// Tunnel forwards a local port to the address reachable from the machine and returns the local address.
+	// Tunnels are reused for the same address and closed on disconnect.
+	Tunnel(remoteAddr string) (string, error)
+}
+
+func freeLocalPort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func awaitLocalPort(localAddr string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.DialTimeout("tcp", localAddr, time.Second)
+		if err == nil {
+			return conn.Close()
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("local port '%s' is not listening after '%s': %w", localAddr, timeout, err)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+func pipeConns(local net.Conn, remote net.Conn) {
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(local, remote)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(remote, local)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -3,11 +3,15 @@ package client
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"net"
 	"os"
+	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -21,6 +25,14 @@ type AWSSSMConnection struct {
 	commandOutputTimeout time.Duration
 	commandWaitMax       time.Duration
 	commandWaitMin       time.Duration
+	tunnels              map[string]*awsSSMTunnel
+	clientRegion         string
+}
+
+type awsSSMTunnel struct {
+	localAddr string
+	sessionId *string
+	plugin    *exec.Cmd
 }
 
 func (a *AWSSSMConnection) Info() string {
@@ -69,11 +81,17 @@ func (a *AWSSSMConnection) Connect() error {
 
 	a.client = client
 	a.sessionId = sessionOut.SessionId
+	a.clientRegion = cfg.Region
 
 	return nil
 }
 
 func (a *AWSSSMConnection) Disconnect() error {
+	for remoteAddr, tunnel := range a.tunnels {
+		a.closeTunnel(tunnel)
+		delete(a.tunnels, remoteAddr)
+	}
+
 	sessionIn := &ssm.TerminateSessionInput{SessionId: a.sessionId}
 	_, err := a.client.TerminateSession(a.context, sessionIn)
 	if err != nil {
@@ -135,3 +153,70 @@ func (a *AWSSSMConnection) CopyFile(localPath string, remotePath string) error {
 	_, err = a.Command([]string{cmd})
 	return err
 }
+
+// Tunnel starts the SSM port forwarding session handled locally by the AWS Session Manager plugin,
+// which needs to be installed on the machine running the provider.
+func (a *AWSSSMConnection) Tunnel(remoteAddr string) (string, error) {
+	if tunnel, ok := a.tunnels[remoteAddr]; ok {
+		return tunnel.localAddr, nil
+	}
+	host, port, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return "", fmt.Errorf("ssm: invalid address '%s' to forward: %v", remoteAddr, err)
+	}
+	localPort, err := freeLocalPort()
+	if err != nil {
+		return "", fmt.Errorf("ssm: cannot determine free local port: %v", err)
+	}
+	sessionIn := &ssm.StartSessionInput{
+		Target:       aws.String(a.instanceID),
+		DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+		Parameters: map[string][]string{
+			"host":            {host},
+			"portNumber":      {port},
+			"localPortNumber": {strconv.Itoa(localPort)},
+		},
+	}
+	sessionOut, err := a.client.StartSession(a.context, sessionIn)
+	if err != nil {
+		return "", fmt.Errorf("ssm: error starting port forwarding session: %v", err)
+	}
+	tunnel := &awsSSMTunnel{
+		localAddr: fmt.Sprintf("127.0.0.1:%d", localPort),
+		sessionId: sessionOut.SessionId,
+	}
+	sessionJSON, err := json.Marshal(sessionOut)
+	if err != nil {
+		a.closeTunnel(tunnel)
+		return "", fmt.Errorf("ssm: cannot encode port forwarding session: %v", err)
+	}
+	sessionInJSON, err := json.Marshal(sessionIn)
+	if err != nil {
+		a.closeTunnel(tunnel)
+		return "", fmt.Errorf("ssm: cannot encode port forwarding session: %v", err)
+	}
+	endpoint := fmt.Sprintf("https://ssm.%s.amazonaws.com", a.clientRegion)
+	tunnel.plugin = exec.Command("session-manager-plugin", string(sessionJSON), a.clientRegion, "StartSession", "", string(sessionInJSON), endpoint)
+	if err := tunnel.plugin.Start(); err != nil {
+		tunnel.plugin = nil
+		a.closeTunnel(tunnel)
+		return "", fmt.Errorf("ssm: cannot start session manager plugin (is it installed?): %v", err)
+	}
+	if err := awaitLocalPort(tunnel.localAddr, 30*time.Second); err != nil {
+		a.closeTunnel(tunnel)
+		return "", fmt.Errorf("ssm: port forwarding to address '%s' is not ready: %v", remoteAddr, err)
+	}
+	if a.tunnels == nil {
+		a.tunnels = map[string]*awsSSMTunnel{}
+	}
+	a.tunnels[remoteAddr] = tunnel
+	return tunnel.localAddr, nil
+}
+
+func (a *AWSSSMConnection) closeTunnel(tunnel *awsSSMTunnel) {
+	if tunnel.plugin != nil && tunnel.plugin.Process != nil {
+		_ = tunnel.plugin.Process.Kill()
+		_ = tunnel.plugin.Wait()
+	}
+	_, _ = a.client.TerminateSession(a.context, &ssm.TerminateSessionInput{SessionId: tunnel.sessionId})
+}
@@ -5,11 +5,13 @@ import (
 	"github.com/melbahja/goph"
 	"github.com/spf13/cast"
 	"golang.org/x/crypto/ssh"
+	"net"
 	"strings"
 )
 
 type SSHConnection struct {
-	client *goph.Client
+	client  *goph.Client
+	tunnels map[string]net.Listener
 
 	host                 string
 	user                 string
@@ -81,6 +83,10 @@ func (s *SSHConnection) Disconnect() error {
 	if s.client == nil {
 		return nil
 	}
+	for remoteAddr, listener := range s.tunnels {
+		_ = listener.Close()
+		delete(s.tunnels, remoteAddr)
+	}
 	if err := s.client.Close(); err != nil {
 		return fmt.Errorf("ssh: cannot disconnect from host '%s': %w", s.host, err)
 	}
@@ -117,3 +123,38 @@ func (s *SSHConnection) CopyFile(localPath string, remotePath string) error {
 	}
 	return nil
 }
+
+func (s *SSHConnection) Tunnel(remoteAddr string) (string, error) {
+	if listener, ok := s.tunnels[remoteAddr]; ok {
+		return listener.Addr().String(), nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("ssh: cannot listen on local port to forward address '%s' on host '%s': %w", remoteAddr, s.host, err)
+	}
+	if s.tunnels == nil {
+		s.tunnels = map[string]net.Listener{}
+	}
+	s.tunnels[remoteAddr] = listener
+	go acceptTunnel(listener, func() (net.Conn, error) { return s.client.Dial("tcp", remoteAddr) })
+	return listener.Addr().String(), nil
+}
+
+// acceptTunnel forwards each local connection to the connection opened by dial (e.g. SSH 'direct-tcpip' channel) until the listener is closed.
+func acceptTunnel(listener net.Listener, dial func() (net.Conn, error)) {
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer local.Close()
+			remote, err := dial()
+			if err != nil {
+				return
+			}
+			defer remote.Close()
+			pipeConns(local, remote)
+		}()
+	}
+}
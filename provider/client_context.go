@@ -2,7 +2,10 @@ package provider
 
 import (
 	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/wttech/pulumi-aem/provider/client"
+	"golang.org/x/exp/maps"
+	"time"
 )
 
 type ClientContext[T interface{}] struct {
@@ -10,3 +13,38 @@ type ClientContext[T interface{}] struct {
 	ctx  p.Context
 	data T
 }
+
+func connectClient(ctx p.Context, clientManager *client.ClientManager, model Client, system *System, compose *Compose, timeout time.Duration) (*client.Client, error) {
+	typeName := model.Type
+	ctx.Logf(diag.Info, "Connecting to AEM instance machine using %s", typeName)
+
+	cl, err := clientManager.Make(typeName, clientSettings(model))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cl.ConnectWithRetry(timeout, func() { ctx.Log(diag.Info, "Awaiting connection to AEM instance machine") }); err != nil {
+		return nil, err
+	}
+
+	cl.Env["AEM_CLI_VERSION"] = compose.Version
+	cl.Env["AEM_OUTPUT_LOG_MODE"] = "both"
+	cl.WorkDir = system.WorkDir
+
+	if err := cl.SetupEnv(); err != nil {
+		return nil, err
+	}
+
+	ctx.Logf(diag.Info, "Connected to AEM instance machine using %s", cl.Connection().Info())
+	return cl, nil
+}
+
+func clientSettings(model Client) map[string]string {
+	settings := model.Settings
+	credentials := model.Credentials
+
+	combined := map[string]string{}
+	maps.Copy(combined, credentials)
+	maps.Copy(combined, settings)
+	return combined
+}
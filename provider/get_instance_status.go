@@ -0,0 +1,54 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"golang.org/x/exp/slices"
+)
+
+type GetInstanceStatus struct{}
+
+type GetInstanceStatusArgs struct {
+	TargetArgs
+}
+
+type GetInstanceStatusResult struct {
+	Instances []InstanceModel `pulumi:"instances"`
+}
+
+func (m *GetInstanceStatusResult) Annotate(a infer.Annotator) {
+	a.Describe(&m.Instances, "Current state of the AEM instances running on the machine.")
+}
+
+func (m *GetInstanceStatus) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).")
+}
+
+func (GetInstanceStatus) Call(ctx p.Context, input GetInstanceStatusArgs) (GetInstanceStatusResult, error) {
+	var result GetInstanceStatusResult
+	tc, err := connectTargetFunction(ctx, input.TargetArgs)
+	if err != nil {
+		return result, err
+	}
+	defer tc.Close()
+
+	ic := &InstanceClient{tc.cl, ctx, InstanceArgs{Client: tc.data.Client, System: tc.data.System, Compose: tc.data.Compose}}
+	status, err := ic.ReadStatus()
+	if err != nil {
+		return result, err
+	}
+	configYAML, err := composeConfigYAML(tc.data.Compose)
+	if err != nil {
+		return result, err
+	}
+	instances, err := status.instanceModels(configYAML)
+	if err != nil {
+		return result, err
+	}
+	for _, instance := range instances {
+		if len(input.InstanceIDs) == 0 || slices.Contains(input.InstanceIDs, instance.ID) {
+			result.Instances = append(result.Instances, instance)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"strings"
+)
+
+type GetLogs struct{}
+
+type GetLogsArgs struct {
+	Client     Client   `pulumi:"client"`
+	System     *System  `pulumi:"system,optional"`
+	Compose    *Compose `pulumi:"compose,optional"`
+	InstanceID string   `pulumi:"instance_id"`
+	File       string   `pulumi:"file,optional"`
+	Lines      int      `pulumi:"lines,optional"`
+	Filter     string   `pulumi:"filter,optional"`
+}
+
+func (m *GetLogsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Client, "Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.System, "Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.Compose, "AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.InstanceID, "Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').")
+	a.Describe(&m.File, "Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.")
+	a.Describe(&m.Lines, "Number of the last lines to be returned. By default, 100 lines are returned.")
+	a.Describe(&m.Filter, "Extended regular expression which the returned lines need to match (applied before limiting the number of lines).")
+}
+
+type GetLogsResult struct {
+	Content string `pulumi:"content"`
+}
+
+func (m *GetLogsResult) Annotate(a infer.Annotator) {
+	a.Describe(&m.Content, "Last lines of the log file.")
+}
+
+func (m *GetLogs) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).")
+}
+
+func (GetLogs) Call(ctx p.Context, input GetLogsArgs) (GetLogsResult, error) {
+	var result GetLogsResult
+	if input.File == "" {
+		input.File = "error.log"
+	}
+	if input.Lines == 0 {
+		input.Lines = 100
+	}
+	if strings.Contains(input.File, "/") || input.File == ".." {
+		return result, fmt.Errorf("log file '%s' needs to be a name of the file in the logs directory", input.File)
+	}
+	if input.Lines < 0 {
+		return result, fmt.Errorf("number of lines must be positive")
+	}
+	tc, err := connectTargetFunction(ctx, TargetArgs{Client: input.Client, System: input.System, Compose: input.Compose, InstanceIDs: []string{input.InstanceID}})
+	if err != nil {
+		return result, err
+	}
+	defer tc.Close()
+
+	if _, err := tc.instances(); err != nil {
+		return result, err
+	}
+	result.Content, err = tc.readLogs(input)
+	return result, err
+}
+
+// readLogs prints the last lines of the log file from the directory of the unpacked instance (optionally filtered).
+func (tc *TargetClient) readLogs(input GetLogsArgs) (string, error) {
+	dir, err := tc.instanceDir(input.InstanceID)
+	if err != nil {
+		return "", err
+	}
+	file := utils.ShellQuote(dir + "/crx-quickstart/logs/" + input.File)
+	read := fmt.Sprintf("cat %s", file)
+	if input.Filter != "" {
+		read = fmt.Sprintf("grep -E -- %s %s", utils.ShellQuote(input.Filter), file)
+	}
+	script := strings.Join([]string{
+		fmt.Sprintf("if [ ! -f %s ]; then echo \"log file %s does not exist\" >&2; exit 1; fi", file, file),
+		fmt.Sprintf("%s | tail -n %d", read, input.Lines),
+	}, "\n")
+	out, err := tc.cl.RunShellScript(scriptName("logs"), script, ".")
+	if err != nil {
+		return "", fmt.Errorf("unable to read log file '%s' of AEM instance '%s': %w", input.File, input.InstanceID, err)
+	}
+	return string(out), nil
+}
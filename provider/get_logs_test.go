@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+func TestReadLogs(t *testing.T) {
+	var scripts []string
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		scripts = append(scripts, script)
+		return "*ERROR* [main] failure\n", nil
+	})
+	tc := &TargetClient{cl, newTestContext(t), TargetArgs{System: &System{DataDir: "/data/aemc"}, Compose: &Compose{Config: instance.ConfigYML}}}
+
+	content, err := tc.readLogs(GetLogsArgs{InstanceID: "local_author", File: "error.log", Lines: 10, Filter: "ERROR"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "*ERROR* [main] failure\n", content)
+	require.Len(t, scripts, 1)
+	assert.Contains(t, scripts[0], "if [ ! -f '/data/aemc/aem/home/var/instance/local_author/crx-quickstart/logs/error.log' ]")
+	assert.Contains(t, scripts[0], "grep -E -- 'ERROR' '/data/aemc/aem/home/var/instance/local_author/crx-quickstart/logs/error.log' | tail -n 10")
+}
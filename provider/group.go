@@ -0,0 +1,156 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+)
+
+type Group struct{}
+
+type GroupArgs struct {
+	TargetArgs
+	GroupID          string   `pulumi:"group_id,optional" provider:"replaceOnChanges"`
+	IntermediatePath string   `pulumi:"intermediate_path,optional" provider:"replaceOnChanges"`
+	DisplayName      string   `pulumi:"display_name,optional"`
+	Description      string   `pulumi:"description,optional"`
+	Members          []string `pulumi:"members,optional"`
+}
+
+func (m *GroupArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.GroupID, "Identifier of the group. By default, the resource name is used.")
+	a.Describe(&m.IntermediatePath, "Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.")
+	a.Describe(&m.DisplayName, "Display name stored in the group profile.")
+	a.Describe(&m.Description, "Description stored in the group profile.")
+	a.Describe(&m.Members, "Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.")
+}
+
+type GroupInstanceModel struct {
+	ID      string   `pulumi:"id"`
+	Exists  bool     `pulumi:"exists"`
+	Path    string   `pulumi:"path"`
+	Members []string `pulumi:"members"`
+}
+
+func (m *GroupInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Exists, "Indicates if the group exists on the AEM instance.")
+	a.Describe(&m.Path, "Repository path of the group on the AEM instance.")
+	a.Describe(&m.Members, "Identifiers of the direct members of the group on the AEM instance.")
+}
+
+type GroupState struct {
+	GroupArgs
+	Instances []GroupInstanceModel `pulumi:"instances"`
+}
+
+func (m *GroupState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Instances, "Current state of the group on the target AEM instances.")
+}
+
+func (m *Group) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Group created on the AEM instances along with its members. Deleted from the instances when the resource is deleted.")
+}
+
+func (Group) Create(ctx p.Context, name string, input GroupArgs, preview bool) (string, GroupState, error) {
+	state := GroupState{GroupArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	gc, err := connectGroup(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer gc.Close()
+
+	if err := gc.save(nil); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = gc.readInstances()
+	return name, state, err
+}
+
+func (Group) Update(ctx p.Context, id string, olds GroupState, news GroupArgs, preview bool) (GroupState, error) {
+	state := GroupState{GroupArgs: news, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	gc, err := connectGroup(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer gc.Close()
+
+	if err := gc.deleteFromRemovedInstances(olds); err != nil {
+		return state, err
+	}
+	if err := gc.save(&olds.GroupArgs); err != nil {
+		return state, err
+	}
+	state.Instances, err = gc.readInstances()
+	return state, err
+}
+
+func (Group) Delete(ctx p.Context, id string, props GroupState) error {
+	gc, err := connectGroup(ctx, props.GroupArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer gc.Close()
+
+	instances, err := gc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := gc.target().deleteAuthorizable(instance, props.GroupID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (Group) Read(ctx p.Context, id string, inputs GroupArgs, state GroupState) (string, GroupArgs, GroupState, error) {
+	gc, err := connectGroup(ctx, state.GroupArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer gc.Close()
+
+	state.Instances, err = gc.readInstances()
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores the desired members
+	for _, instance := range state.Instances {
+		if !instance.Exists || len(authorizableIDsRemoved(state.Members, instance.Members)) > 0 {
+			ctx.Logf(diag.Warning, "Group '%s' on instance '%s' differs from the desired one", state.GroupID, instance.ID)
+			state.Members = authorizableIDsKept(state.Members, instance.Members)
+			break
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (Group) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (GroupArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "group_id", resource.NewStringProperty(name))
+	setDefaultValue(newInputs, "members", resource.NewArrayProperty([]resource.PropertyValue{}))
+
+	args, failures, err := infer.DefaultCheck[GroupArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	return args, validateTarget(newInputs, args.TargetArgs), nil
+}
+
+func connectGroup(ctx p.Context, model GroupArgs, timeout string) (*GroupClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &GroupClient{tc.cl, ctx, model}, nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type GroupClient ClientContext[GroupArgs]
+
+func (gc *GroupClient) Close() error {
+	return gc.cl.Disconnect()
+}
+
+func (gc *GroupClient) target() *TargetClient {
+	return &TargetClient{gc.cl, gc.ctx, gc.data.TargetArgs}
+}
+
+// save creates the group or updates the existing one, then aligns its members.
+func (gc *GroupClient) save(olds *GroupArgs) error {
+	instances, err := gc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		path, err := gc.target().findAuthorizable(instance, gc.data.GroupID)
+		if err != nil {
+			return err
+		}
+		form := url.Values{}
+		form.Set("profile/givenName", gc.data.DisplayName)
+		form.Set("profile/aboutMe", gc.data.Description)
+		if path == "" {
+			form.Set("createGroup", "")
+			form.Set("authorizableId", gc.data.GroupID)
+			if gc.data.IntermediatePath != "" {
+				form.Set("intermediatePath", gc.data.IntermediatePath)
+			}
+			if err := gc.target().postForm(instance, authorizablesPath, form, fmt.Sprintf("Creating group '%s'", gc.data.GroupID)); err != nil {
+				return err
+			}
+		} else if err := gc.target().postForm(instance, path+".rw.html", form, fmt.Sprintf("Updating group '%s'", gc.data.GroupID)); err != nil {
+			return err
+		}
+
+		var membersRemoved []string
+		if olds != nil {
+			membersRemoved = authorizableIDsRemoved(olds.Members, gc.data.Members)
+		}
+		if err := gc.target().updateMembership(instance, gc.data.GroupID, gc.data.Members, membersRemoved); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (gc *GroupClient) deleteFromRemovedInstances(olds GroupState) error {
+	removedInstances, err := gc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := gc.target().deleteAuthorizable(instance, olds.GroupID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (gc *GroupClient) readInstances() ([]GroupInstanceModel, error) {
+	instances, err := gc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []GroupInstanceModel
+	for _, instance := range instances {
+		path, err := gc.target().findAuthorizable(instance, gc.data.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		model := GroupInstanceModel{ID: instance.ID, Exists: path != "", Path: path}
+		if model.Exists {
+			model.Members, err = gc.target().readAuthorizableIDs(instance, path, "declaredMembers")
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
@@ -0,0 +1,95 @@
+#!/bin/sh
+set -e
+
+# Archives AEM instance files and uploads them to the backup target.
+# Instance is stopped for the archiving time, then started again if it was running.
+
+DATA_DIR="[[.DATA_DIR]]"
+INSTANCE_DIR="[[.INSTANCE_DIR]]"
+SERVICE="[[.SERVICE]]"
+TARGET="[[.TARGET]]"
+RETENTION="[[.RETENTION]]"
+COMPRESSION="[[.COMPRESSION]]"
+
+BACKUP_DIR="$DATA_DIR/aem/home/var/backup"
+CATALOG="$BACKUP_DIR/catalog.txt"
+
+case "$COMPRESSION" in
+  zstd) EXT="tar.zst" ;;
+  gzip) EXT="tar.gz" ;;
+  none) EXT="tar" ;;
+  *) echo "Unsupported backup compression '$COMPRESSION'"; exit 1 ;;
+esac
+
+TIMESTAMP=$(date -u +%Y-%m-%dT%H:%M:%SZ)
+NAME="aem-$(date -u +%Y%m%d%H%M%S).$EXT"
+FILE="$BACKUP_DIR/$NAME"
+
+mkdir -p "$BACKUP_DIR"
+
+# Archiving failure must not leave the instance stopped nor the incomplete archive recorded as a backup.
+ACTIVE=0
+abort() {
+  rm -f "$FILE"
+  if [ "$ACTIVE" = "1" ]; then
+    systemctl start "$SERVICE.service"
+  fi
+}
+trap abort EXIT
+
+if systemctl is-active --quiet "$SERVICE.service"; then
+  ACTIVE=1
+  systemctl stop "$SERVICE.service"
+fi
+
+# Compression programs are run by tar (not piped), so their failures fail the script.
+case "$COMPRESSION" in
+  zstd) tar -C "$INSTANCE_DIR" --use-compress-program=zstd -cf "$FILE" . ;;
+  gzip) tar -C "$INSTANCE_DIR" -czf "$FILE" . ;;
+  none) tar -C "$INSTANCE_DIR" -cf "$FILE" . ;;
+esac
+
+trap - EXIT
+if [ "$ACTIVE" = "1" ]; then
+  systemctl start "$SERVICE.service"
+fi
+
+SIZE=$(stat -c %s "$FILE")
+CHECKSUM=$(sha256sum "$FILE" | cut -d ' ' -f 1)
+
+case "$TARGET" in
+  s3://*)
+    LOCATION="${TARGET%/}/$NAME"
+    aws s3 cp --no-progress "$FILE" "$LOCATION"
+    rm -f "$FILE"
+    ;;
+  https://*.blob.core.windows.net/*)
+    LOCATION="${TARGET%/}/$NAME"
+    azcopy copy "$FILE" "$LOCATION"
+    rm -f "$FILE"
+    ;;
+  *)
+    LOCATION="${TARGET%/}/$NAME"
+    if [ "$LOCATION" != "$FILE" ]; then
+      mkdir -p "$TARGET"
+      mv "$FILE" "$LOCATION"
+    fi
+    ;;
+esac
+
+echo "$NAME;$TIMESTAMP;$SIZE;$CHECKSUM;$LOCATION" >> "$CATALOG"
+
+COUNT=$(wc -l < "$CATALOG")
+if [ "$RETENTION" -gt 0 ] && [ "$COUNT" -gt "$RETENTION" ]; then
+  head -n "$((COUNT - RETENTION))" "$CATALOG" | while IFS=';' read -r _ _ _ _ EXPIRED; do
+    case "$EXPIRED" in
+      s3://*) aws s3 rm "$EXPIRED" ;;
+      https://*.blob.core.windows.net/*) azcopy remove "$EXPIRED" ;;
+      *) rm -f "$EXPIRED" ;;
+    esac
+  done
+  tail -n "$RETENTION" "$CATALOG" > "$CATALOG.tmp"
+  mv "$CATALOG.tmp" "$CATALOG"
+fi
+
+echo "Backup '$NAME' saved to '$LOCATION'"
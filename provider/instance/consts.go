@@ -8,9 +8,24 @@ var DescriptionMD string
 //go:embed aem.yml
 var ConfigYML string
 
+//go:embed aem.schema.json
+var ConfigSchemaJSON string
+
 //go:embed systemd.conf
 var ServiceConf string
 
+//go:embed schedule.service
+var ScheduleServiceConf string
+
+//go:embed schedule.timer
+var ScheduleTimerConf string
+
+//go:embed backup.sh
+var BackupScript string
+
+//go:embed restore.sh
+var RestoreScript string
+
 var CreateScriptInline = []string{
 	`sh aemw instance init`,
 	`sh aemw instance create`,
@@ -21,6 +36,16 @@ var LaunchScriptInline = []string{
 	`sh aemw repl agent setup -A --location 'author' --name 'publish' --input-string '{enabled: true, transportUri: "http://localhost:4503/bin/receive?sling:authRequestLogin=1", transportUser: admin, transportPassword: admin, userId: admin}'`,
 }
 
+// RevisionCleanupScript and DatastoreGCScript pass the credentials to curl through the config read from standard input,
+// so they are not visible in the process list.
+var RevisionCleanupScript = `curl -sf -K - -X POST [[.URL]]'/system/console/jmx/org.apache.jackrabbit.oak%3Aname%3DSegment+node+store+revision+garbage+collection%2Ctype%3DRevisionGarbageCollection/op/startRevisionGC/' <<'CURL_CONFIG'
+user = "[[.CREDENTIALS]]"
+CURL_CONFIG`
+
+var DatastoreGCScript = `curl -sf -K - -X POST -d 'markOnly=false' [[.URL]]'/system/console/jmx/com.adobe.granite%3Atype%3DRepository/op/startDataStoreGC/boolean' <<'CURL_CONFIG'
+user = "[[.CREDENTIALS]]"
+CURL_CONFIG`
+
 var DeleteScriptInline = []string{
 	`sh aemw instance delete`,
 }
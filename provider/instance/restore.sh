@@ -0,0 +1,33 @@
+#!/bin/sh
+set -e
+
+# Downloads AEM instance files archived by the backup script and unpacks them.
+
+DATA_DIR="[[.DATA_DIR]]"
+INSTANCE_DIR="[[.INSTANCE_DIR]]"
+SOURCE="[[.SOURCE]]"
+
+BACKUP_DIR="$DATA_DIR/aem/home/var/backup"
+FILE="$BACKUP_DIR/$(basename "$SOURCE")"
+
+mkdir -p "$BACKUP_DIR" "$INSTANCE_DIR"
+
+case "$SOURCE" in
+  s3://*) aws s3 cp --no-progress "$SOURCE" "$FILE" ;;
+  https://*.blob.core.windows.net/*) azcopy copy "$SOURCE" "$FILE" ;;
+  http://*|https://*) curl -sfL "$SOURCE" -o "$FILE" ;;
+  *) if [ "$SOURCE" != "$FILE" ]; then cp "$SOURCE" "$FILE"; fi ;;
+esac
+
+case "$FILE" in
+  *.tar.zst) tar -C "$INSTANCE_DIR" --use-compress-program=zstd -xf "$FILE" ;;
+  *.tar.gz) tar -C "$INSTANCE_DIR" -xzf "$FILE" ;;
+  *.tar) tar -C "$INSTANCE_DIR" -xf "$FILE" ;;
+  *) echo "Unsupported backup file '$FILE'"; exit 1 ;;
+esac
+
+if [ "$SOURCE" != "$FILE" ]; then
+  rm -f "$FILE"
+fi
+
+echo "Backup '$SOURCE' restored to '$INSTANCE_DIR'"
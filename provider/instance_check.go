@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/wttech/pulumi-aem/provider/client"
+	"github.com/wttech/pulumi-aem/provider/instance"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var configSchema = jsonschema.MustCompileString("aem.schema.json", instance.ConfigSchemaJSON)
+
+// validateInstanceArgs checks the inputs which otherwise would fail only after connecting to the machine.
+// Inputs not known yet (e.g. during preview) are skipped.
+func validateInstanceArgs(inputs resource.PropertyMap, args InstanceArgs) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if !inputs["client"].ContainsUnknowns() {
+		failures = append(failures, validateClient(args.Client)...)
+	}
+	if !inputs["files"].ContainsUnknowns() {
+		failures = append(failures, validateFiles(args.Files)...)
+	}
+	if !inputs["system"].ContainsUnknowns() && !inputs["compose"].ContainsUnknowns() {
+		failures = append(failures, validateSchedules(args.System, args.Compose)...)
+	}
+	if !inputs["compose"].ContainsUnknowns() {
+		failures = append(failures, validateCompose(args.Compose)...)
+	}
+	if !inputs["diagnostics"].ContainsUnknowns() {
+		failures = append(failures, validateDiagnostics(args.Diagnostics)...)
+	}
+	if !inputs["preflight"].ContainsUnknowns() {
+		failures = append(failures, validatePreflight(args.Preflight)...)
+	}
+	return failures
+}
+
+func validatePreflight(preflight *Preflight) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if preflight == nil {
+		return failures
+	}
+	if preflight.MinDiskSpace < 0 {
+		failures = append(failures, p.CheckFailure{Property: "preflight.min_disk_space", Reason: "minimum disk space cannot be negative"})
+	}
+	if preflight.JavaVersion != "" {
+		if version, err := strconv.Atoi(preflight.JavaVersion); err != nil || version <= 0 {
+			failures = append(failures, p.CheckFailure{Property: "preflight.java_version", Reason: fmt.Sprintf("invalid Java major version '%s' (expected number like '11')", preflight.JavaVersion)})
+		}
+	}
+	return failures
+}
+
+func validateDiagnostics(diagnostics *Diagnostics) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if diagnostics == nil {
+		return failures
+	}
+	if diagnostics.LogLines <= 0 {
+		failures = append(failures, p.CheckFailure{Property: "diagnostics.log_lines", Reason: "number of log lines must be positive"})
+	}
+	if diagnostics.SummaryLines < 0 || diagnostics.SummaryLines > diagnostics.LogLines {
+		failures = append(failures, p.CheckFailure{Property: "diagnostics.summary_lines", Reason: fmt.Sprintf("number of summary lines must be between 0 and %d", diagnostics.LogLines)})
+	}
+	return failures
+}
+
+func validateClient(model Client) []p.CheckFailure {
+	var failures []p.CheckFailure
+	requiredSettings, err := client.ClientManagerDefault.RequiredSettings(model.Type)
+	if err != nil {
+		failures = append(failures, p.CheckFailure{Property: "client.type", Reason: err.Error()})
+	}
+	for _, name := range requiredSettings {
+		if model.Settings[name] == "" && model.Credentials[name] == "" {
+			failures = append(failures, p.CheckFailure{
+				Property: fmt.Sprintf("client.settings.%s", name),
+				Reason:   fmt.Sprintf("setting '%s' is required by client type '%s' (could be also set in credentials)", name, model.Type),
+			})
+		}
+	}
+	failures = append(failures, validateDuration("client.action_timeout", model.ActionTimeout)...)
+	failures = append(failures, validateDuration("client.state_timeout", model.StateTimeout)...)
+	return failures
+}
+
+func validateDuration(property string, value string) []p.CheckFailure {
+	if value == "" {
+		return nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return []p.CheckFailure{{Property: property, Reason: fmt.Sprintf("invalid duration '%s' (expected format like '30s', '10m' or '1h30m'): %s", value, err)}}
+	}
+	if duration <= 0 {
+		return []p.CheckFailure{{Property: property, Reason: fmt.Sprintf("duration '%s' must be positive", value)}}
+	}
+	return nil
+}
+
+func validateFiles(files map[string]string) []p.CheckFailure {
+	var failures []p.CheckFailure
+	localPaths := maps.Keys(files)
+	sort.Strings(localPaths)
+	for _, localPath := range localPaths {
+		if _, err := os.Stat(localPath); err != nil {
+			failures = append(failures, p.CheckFailure{
+				Property: fmt.Sprintf("files[\"%s\"]", localPath),
+				Reason:   fmt.Sprintf("local path '%s' cannot be read: %s", localPath, err),
+			})
+		}
+	}
+	return failures
+}
+
+func validateSchedules(system *System, compose *Compose) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if system == nil {
+		return failures
+	}
+	names := map[string]bool{}
+	for i, schedule := range system.Schedules {
+		property := fmt.Sprintf("system.schedules[%d]", i)
+		if !scheduleNameRegex.MatchString(schedule.Name) {
+			failures = append(failures, p.CheckFailure{Property: property + ".name", Reason: fmt.Sprintf("invalid name '%s' (only lowercase letters, digits and dashes are allowed)", schedule.Name)})
+		} else if names[schedule.Name] {
+			failures = append(failures, p.CheckFailure{Property: property + ".name", Reason: fmt.Sprintf("duplicated name '%s'", schedule.Name)})
+		}
+		names[schedule.Name] = true
+		if schedule.Builtin != "" && schedule.Script != nil {
+			failures = append(failures, p.CheckFailure{Property: property, Reason: "builtin and script are mutually exclusive"})
+		} else if schedule.Builtin == "" && schedule.Script == nil {
+			failures = append(failures, p.CheckFailure{Property: property, Reason: "builtin or script is required"})
+		} else if schedule.Builtin != "" && !slices.Contains(ScheduleBuiltins, schedule.Builtin) {
+			failures = append(failures, p.CheckFailure{Property: property + ".builtin", Reason: fmt.Sprintf("unknown builtin '%s' (expected one of %v)", schedule.Builtin, ScheduleBuiltins)})
+		} else if schedule.Builtin == ScheduleBuiltinBackup && (compose == nil || compose.Backup == nil) {
+			failures = append(failures, p.CheckFailure{Property: property + ".builtin", Reason: "builtin 'backup' requires compose backup settings"})
+		}
+	}
+	return failures
+}
+
+func validateCompose(compose *Compose) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if compose == nil {
+		return failures
+	}
+	if !slices.Contains([]string{ConfigListsReplace, ConfigListsAppend}, compose.ConfigListsStrategy) {
+		failures = append(failures, p.CheckFailure{Property: "compose.config_lists_strategy", Reason: fmt.Sprintf("unknown strategy '%s' (expected '%s' or '%s')", compose.ConfigListsStrategy, ConfigListsReplace, ConfigListsAppend)})
+	}
+	if compose.Backup != nil && !slices.Contains(BackupCompressions, compose.Backup.Compression) {
+		failures = append(failures, p.CheckFailure{Property: "compose.backup.compression", Reason: fmt.Sprintf("unknown compression '%s' (expected one of %v)", compose.Backup.Compression, BackupCompressions)})
+	}
+	if compose.AdminPassword != "" {
+		for _, instance := range compose.Instances {
+			if instance.Password != "" && isAdminUser(instance.User) {
+				failures = append(failures, p.CheckFailure{Property: "compose.instances", Reason: fmt.Sprintf("password of admin user of instance '%s' cannot be set together with 'admin_password'", instance.ID)})
+			}
+		}
+	}
+	failures = append(failures, validateReadiness(compose.Readiness)...)
+	configYAML, err := composeConfigYAML(compose)
+	if err != nil {
+		return append(failures, p.CheckFailure{Property: "compose.config", Reason: err.Error()})
+	}
+	return append(failures, validateConfigYAML("compose.config", configYAML)...)
+}
+
+func validateReadiness(readiness *Readiness) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if readiness == nil {
+		return failures
+	}
+	for _, attribute := range readiness.Attributes {
+		if !slices.Contains(ReadinessAttributes, attribute) {
+			failures = append(failures, p.CheckFailure{Property: "compose.readiness.attributes", Reason: fmt.Sprintf("unknown attribute '%s' (expected one of %v)", attribute, ReadinessAttributes)})
+		}
+	}
+	for _, probe := range readiness.Probes {
+		if !strings.HasPrefix(probe.Path, "/") {
+			failures = append(failures, p.CheckFailure{Property: "compose.readiness.probes", Reason: fmt.Sprintf("probe path '%s' must start with a slash", probe.Path)})
+		}
+	}
+	if !slices.Contains(ReadinessPolicies, readiness.Policy) {
+		failures = append(failures, p.CheckFailure{Property: "compose.readiness.policy", Reason: fmt.Sprintf("unknown policy '%s' (expected one of %v)", readiness.Policy, ReadinessPolicies)})
+	}
+	failures = append(failures, validateDuration("compose.readiness.timeout", readiness.Timeout)...)
+	return append(failures, validateDuration("compose.readiness.interval", readiness.Interval)...)
+}
+
+func validateConfigYAML(property string, configYAML string) []p.CheckFailure {
+	var data any
+	if err := yaml.Unmarshal([]byte(configYAML), &data); err != nil {
+		return []p.CheckFailure{{Property: property, Reason: fmt.Sprintf("invalid YAML: %s", err)}}
+	}
+	// normalize YAML values to JSON ones as expected by the schema validator
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return []p.CheckFailure{{Property: property, Reason: fmt.Sprintf("unsupported YAML value: %s", err)}}
+	}
+	if err := json.Unmarshal(jsonBytes, &data); err != nil {
+		return []p.CheckFailure{{Property: property, Reason: fmt.Sprintf("unsupported YAML value: %s", err)}}
+	}
+	if err := configSchema.Validate(data); err != nil {
+		validationErr, ok := err.(*jsonschema.ValidationError)
+		if !ok {
+			return []p.CheckFailure{{Property: property, Reason: err.Error()}}
+		}
+		var failures []p.CheckFailure
+		for _, cause := range validationCauses(validationErr) {
+			failures = append(failures, p.CheckFailure{Property: property, Reason: fmt.Sprintf("invalid value at '%s': %s", cause.InstanceLocation, cause.Message)})
+		}
+		return failures
+	}
+	return nil
+}
+
+func validationCauses(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var result []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		result = append(result, validationCauses(cause)...)
+	}
+	return result
+}
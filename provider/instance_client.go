@@ -3,16 +3,35 @@ package provider
 import (
 	"fmt"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"github.com/wttech/pulumi-aem/provider/instance"
 	"github.com/wttech/pulumi-aem/provider/utils"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"gopkg.in/yaml.v3"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 )
 
 const (
-	ServiceName = "aem"
+	ServiceName       = "aem"
+	ComposeWrapperURL = "https://raw.githubusercontent.com/wttech/aemc/main/pkg/project/common/aemw"
 )
 
+var scheduleNameRegex = regexp.MustCompile("^[a-z0-9-]+$")
+
+const (
+	ScheduleBuiltinBackup          = "backup"
+	ScheduleBuiltinRevisionCleanup = "revision-cleanup"
+	ScheduleBuiltinDatastoreGC     = "datastore-gc"
+)
+
+var ScheduleBuiltins = []string{ScheduleBuiltinBackup, ScheduleBuiltinRevisionCleanup, ScheduleBuiltinDatastoreGC}
+
+var BackupCompressions = []string{"gzip", "zstd", "none"}
+
 type InstanceClient ClientContext[InstanceArgs]
 
 func (ic *InstanceClient) Close() error {
@@ -42,7 +61,7 @@ func (ic *InstanceClient) installComposeCLI() error {
 	}
 	if !exists {
 		ic.ctx.Log(diag.Info, "Downloading AEM Compose CLI wrapper")
-		out, err := ic.cl.RunShellCommand("curl -s 'https://raw.githubusercontent.com/wttech/aemc/main/pkg/project/common/aemw' -o 'aemw'", ic.dataDir())
+		out, err := ic.cl.RunShellCommand(fmt.Sprintf("curl -s '%s' -o 'aemw'", ComposeWrapperURL), ic.dataDir())
 		ic.ctx.Log(diag.Info, string(out))
 		if err != nil {
 			return fmt.Errorf("cannot download AEM Compose CLI wrapper: %w", err)
@@ -52,8 +71,15 @@ func (ic *InstanceClient) installComposeCLI() error {
 	return nil
 }
 
+func (ic *InstanceClient) configYAML() (string, error) {
+	return composeConfigYAML(ic.data.Compose)
+}
+
 func (ic *InstanceClient) writeConfigFile() error {
-	configYAML := ic.data.Compose.Config
+	configYAML, err := ic.configYAML()
+	if err != nil {
+		return err
+	}
 	if err := ic.cl.FileWrite(fmt.Sprintf("%s/aem/default/etc/aem.yml", ic.dataDir()), configYAML); err != nil {
 		return fmt.Errorf("unable to copy AEM configuration file: %w", err)
 	}
@@ -78,13 +104,184 @@ func (ic *InstanceClient) create() error {
 	if err := ic.saveProfileScript(); err != nil {
 		return err
 	}
-	if err := ic.runScript("create", ic.data.Compose.Create, ic.dataDir()); err != nil {
+	if ic.data.Compose.RestoreFrom != "" {
+		if err := ic.restore(); err != nil {
+			return err
+		}
+	} else if err := ic.runScript("create", ic.data.Compose.Create, ic.dataDir()); err != nil {
 		return err
 	}
 	ic.ctx.Log(diag.Info, "Created AEM instance(s)")
 	return nil
 }
 
+func (ic *InstanceClient) backupScriptPath() string {
+	return fmt.Sprintf("%s/provider/backup.sh", ic.dataDir())
+}
+
+func (ic *InstanceClient) backupCatalogPath() string {
+	return fmt.Sprintf("%s/aem/home/var/backup/catalog.txt", ic.dataDir())
+}
+
+func (ic *InstanceClient) saveBackupScript() error {
+	backup := ic.data.Compose.Backup
+	if backup == nil {
+		return nil
+	}
+	instanceDir, err := ic.target(ic.data.Compose).unpackDir()
+	if err != nil {
+		return err
+	}
+	vars := map[string]string{
+		"DATA_DIR":     ic.dataDir(),
+		"INSTANCE_DIR": instanceDir,
+		"SERVICE":      ServiceName,
+		"TARGET":       backup.Target,
+		"RETENTION":    fmt.Sprintf("%d", backup.Retention),
+		"COMPRESSION":  backup.Compression,
+	}
+	scriptTemplated, err := utils.TemplateString(instance.BackupScript, vars)
+	if err != nil {
+		return fmt.Errorf("unable to template AEM backup script: %w", err)
+	}
+	scriptFile := ic.backupScriptPath()
+	if err := ic.cl.FileWrite(scriptFile, scriptTemplated); err != nil {
+		return fmt.Errorf("unable to write AEM backup script '%s': %w", scriptFile, err)
+	}
+	if err := ic.cl.FileMakeExecutable(scriptFile); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ic *InstanceClient) backup() error {
+	ic.ctx.Log(diag.Info, "Backing up AEM instance(s)")
+	if err := ic.runBackupScript(); err != nil {
+		return err
+	}
+	ic.ctx.Log(diag.Info, "Backed up AEM instance(s)")
+
+	// the backup script restarts the service only if it was active, starting it returns before AEM is up
+	active, err := ic.serviceActive()
+	if err != nil {
+		return err
+	}
+	if active {
+		return ic.awaitRunning()
+	}
+	return nil
+}
+
+func (ic *InstanceClient) runBackupScript() error {
+	ic.cl.Sudo = true
+	defer func() { ic.cl.Sudo = false }()
+
+	outBytes, err := ic.cl.RunShellCommand(fmt.Sprintf("sh %s", ic.backupScriptPath()), ic.dataDir())
+	if err != nil {
+		return fmt.Errorf("unable to back up AEM instance(s): %w", err)
+	}
+	ic.ctx.Log(diag.Info, string(outBytes))
+	return nil
+}
+
+func (ic *InstanceClient) serviceActive() (bool, error) {
+	out, err := ic.cl.RunShellPurely(fmt.Sprintf("systemctl is-active --quiet %s.service && echo yes || echo no", ServiceName))
+	if err != nil {
+		return false, fmt.Errorf("unable to check if system service '%s' is active: %w", ServiceName, err)
+	}
+	return strings.TrimSpace(string(out)) == "yes", nil
+}
+
+// awaitRunning polls the status until all created instances are running (responding to HTTP requests).
+func (ic *InstanceClient) awaitRunning() error {
+	timeout := cast.ToDuration(ic.data.Client.ActionTimeout)
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := ic.ReadStatus()
+		if err != nil {
+			return err
+		}
+		var pending []string
+		for _, item := range status.Data.Instances {
+			if slices.Contains(item.Attributes, "created") && !slices.Contains(item.Attributes, "running") {
+				pending = append(pending, item.ID)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("AEM instance(s) '%s' are not running within %s", strings.Join(pending, "', '"), timeout)
+		}
+		ic.ctx.Logf(diag.Info, "Awaiting AEM instance(s) '%s' to be running", strings.Join(pending, "', '"))
+		time.Sleep(5 * time.Second)
+	}
+}
+
+func (ic *InstanceClient) restore() error {
+	ic.ctx.Logf(diag.Info, "Restoring AEM instance(s) from backup '%s'", ic.data.Compose.RestoreFrom)
+	if _, err := ic.cl.RunShellCommand("sh aemw instance init", ic.dataDir()); err != nil {
+		return fmt.Errorf("unable to init AEM instance(s) before restoring: %w", err)
+	}
+	instanceDir, err := ic.target(ic.data.Compose).unpackDir()
+	if err != nil {
+		return err
+	}
+	vars := map[string]string{
+		"DATA_DIR":     ic.dataDir(),
+		"INSTANCE_DIR": instanceDir,
+		"SOURCE":       ic.data.Compose.RestoreFrom,
+	}
+	scriptTemplated, err := utils.TemplateString(instance.RestoreScript, vars)
+	if err != nil {
+		return fmt.Errorf("unable to template AEM restore script: %w", err)
+	}
+	if err := ic.runScriptMultiline("restore", scriptTemplated, ic.dataDir()); err != nil {
+		return err
+	}
+	ic.ctx.Log(diag.Info, "Restored AEM instance(s)")
+	return nil
+}
+
+func (ic *InstanceClient) ReadBackups() ([]BackupModel, error) {
+	var backups []BackupModel
+	if ic.data.Compose.Backup == nil {
+		return backups, nil
+	}
+	catalogFile := ic.backupCatalogPath()
+	exists, err := ic.cl.FileExists(catalogFile)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return backups, nil
+	}
+	outBytes, err := ic.cl.RunShellPurely(fmt.Sprintf("cat %s", catalogFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read AEM backup catalog '%s': %w", catalogFile, err)
+	}
+	return parseBackupCatalog(string(outBytes)), nil
+}
+
+// parseBackupCatalog reads the lines 'name;timestamp;size;checksum;location' appended by the backup script. Malformed lines are skipped.
+func parseBackupCatalog(catalog string) []BackupModel {
+	var backups []BackupModel
+	for _, line := range strings.Split(strings.TrimSpace(catalog), "\n") {
+		parts := strings.Split(strings.TrimSpace(line), ";")
+		if len(parts) != 5 {
+			continue
+		}
+		backups = append(backups, BackupModel{
+			Name:      parts[0],
+			Timestamp: parts[1],
+			Size:      cast.ToInt(parts[2]),
+			Checksum:  parts[3],
+			Location:  parts[4],
+		})
+	}
+	return backups
+}
+
 func (ic *InstanceClient) saveProfileScript() error {
 	envFile := fmt.Sprintf("/etc/profile.d/%s.sh", ServiceName)
 
@@ -103,22 +300,29 @@ func (ic *InstanceClient) saveProfileScript() error {
 	return nil
 }
 
-func (ic *InstanceClient) configureService() error {
-	user := ic.data.System.User
-	if user == "" {
-		user = ic.cl.Connection().User()
-	}
+func (ic *InstanceClient) serviceUser() string {
+	return ic.target(ic.data.Compose).serviceUser()
+}
+
+func (ic *InstanceClient) serviceConfig() (string, error) {
 	vars := map[string]string{
 		"DATA_DIR": ic.dataDir(),
-		"USER":     user,
+		"USER":     ic.serviceUser(),
 	}
+	serviceTemplated, err := utils.TemplateString(ic.data.System.ServiceConfig, vars)
+	if err != nil {
+		return "", fmt.Errorf("unable to template AEM system service definition: %w", err)
+	}
+	return serviceTemplated, nil
+}
 
+func (ic *InstanceClient) configureService() error {
 	ic.cl.Sudo = true
 	defer func() { ic.cl.Sudo = false }()
 
-	serviceTemplated, err := utils.TemplateString(ic.data.System.ServiceConfig, vars)
+	serviceTemplated, err := ic.serviceConfig()
 	if err != nil {
-		return fmt.Errorf("unable to template AEM system service definition: %w", err)
+		return err
 	}
 	serviceFile := fmt.Sprintf("/etc/systemd/system/%s.service", ServiceName)
 	if err := ic.cl.FileWrite(serviceFile, serviceTemplated); err != nil {
@@ -132,18 +336,197 @@ func (ic *InstanceClient) configureService() error {
 }
 
 func (ic *InstanceClient) runServiceAction(action string) error {
+	return ic.runSystemctl(action, ServiceName+".service")
+}
+
+func (ic *InstanceClient) runSystemctl(action string, unit string) error {
 	ic.cl.Sudo = true
 	defer func() { ic.cl.Sudo = false }()
 
-	outBytes, err := ic.cl.RunShellCommand(fmt.Sprintf("systemctl %s %s.service", action, ServiceName), ".")
+	outBytes, err := ic.cl.RunShellCommand(strings.TrimSpace(fmt.Sprintf("systemctl %s %s", action, unit)), ".")
 	if err != nil {
-		return fmt.Errorf("unable to perform AEM system service action '%s': %w", action, err)
+		return fmt.Errorf("unable to perform system service action '%s' on '%s': %w", action, unit, err)
 	}
 	outText := string(outBytes)
 	ic.ctx.Log(diag.Info, outText)
 	return nil
 }
 
+func (ic *InstanceClient) scheduleUnit(name string) string {
+	return fmt.Sprintf("%s-schedule-%s", ServiceName, name)
+}
+
+func (ic *InstanceClient) scheduleScriptPath(name string) string {
+	return fmt.Sprintf("%s/provider/schedule/%s.sh", ic.dataDir(), name)
+}
+
+func (ic *InstanceClient) configureSchedules() error {
+	schedules := ic.data.System.Schedules
+	if len(schedules) > 0 {
+		ic.ctx.Log(diag.Info, "Configuring AEM scheduled tasks")
+	}
+	units := map[string]bool{}
+	for _, schedule := range schedules {
+		if err := ic.configureSchedule(schedule); err != nil {
+			return err
+		}
+		units[ic.scheduleUnit(schedule.Name)] = true
+	}
+	return ic.deleteSchedulesExcept(units)
+}
+
+func (ic *InstanceClient) configureSchedule(schedule Schedule) error {
+	if !scheduleNameRegex.MatchString(schedule.Name) {
+		return fmt.Errorf("invalid AEM scheduled task name '%s'", schedule.Name)
+	}
+	script, user, err := ic.scheduleScript(schedule)
+	if err != nil {
+		return err
+	}
+	unit := ic.scheduleUnit(schedule.Name)
+	scriptFile := ic.scheduleScriptPath(schedule.Name)
+	vars := map[string]string{
+		"NAME":        schedule.Name,
+		"SERVICE":     ServiceName,
+		"UNIT":        unit,
+		"USER":        user,
+		"DATA_DIR":    ic.dataDir(),
+		"SCRIPT":      scriptFile,
+		"ON_CALENDAR": schedule.OnCalendar,
+	}
+	serviceTemplated, err := utils.TemplateString(instance.ScheduleServiceConf, vars)
+	if err != nil {
+		return fmt.Errorf("unable to template AEM scheduled task service definition: %w", err)
+	}
+	timerTemplated, err := utils.TemplateString(instance.ScheduleTimerConf, vars)
+	if err != nil {
+		return fmt.Errorf("unable to template AEM scheduled task timer definition: %w", err)
+	}
+
+	ic.cl.Sudo = true
+	defer func() { ic.cl.Sudo = false }()
+
+	// scripts of builtin tasks contain instance credentials, so only the user running them could read them
+	if err := ic.cl.FileWrite(scriptFile, script); err != nil {
+		return fmt.Errorf("unable to write AEM scheduled task script '%s': %w", scriptFile, err)
+	}
+	if _, err := ic.cl.RunShellPurely(fmt.Sprintf("chown %s %s && chmod 600 %s", utils.ShellQuote(user), scriptFile, scriptFile)); err != nil {
+		return fmt.Errorf("unable to restrict access to AEM scheduled task script '%s': %w", scriptFile, err)
+	}
+
+	serviceFile := fmt.Sprintf("/etc/systemd/system/%s.service", unit)
+	if err := ic.cl.FileWrite(serviceFile, serviceTemplated); err != nil {
+		return fmt.Errorf("unable to write AEM scheduled task service definition '%s': %w", serviceFile, err)
+	}
+	timerFile := fmt.Sprintf("/etc/systemd/system/%s.timer", unit)
+	if err := ic.cl.FileWrite(timerFile, timerTemplated); err != nil {
+		return fmt.Errorf("unable to write AEM scheduled task timer definition '%s': %w", timerFile, err)
+	}
+	if err := ic.runSystemctl("daemon-reload", ""); err != nil {
+		return err
+	}
+	if err := ic.runSystemctl("enable --now", unit+".timer"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ic *InstanceClient) scheduleScript(schedule Schedule) (string, string, error) {
+	if schedule.Builtin != "" && schedule.Script != nil {
+		return "", "", fmt.Errorf("AEM scheduled task '%s' cannot define both builtin and script", schedule.Name)
+	}
+	if schedule.Script != nil {
+		var sb strings.Builder
+		sb.WriteString("#!/bin/sh\nset -e\n")
+		if schedule.Script.Script != "" {
+			sb.WriteString(schedule.Script.Script + "\n")
+		}
+		for _, cmd := range schedule.Script.Inline {
+			sb.WriteString(cmd + "\n")
+		}
+		return sb.String(), ic.serviceUser(), nil
+	}
+	switch schedule.Builtin {
+	case ScheduleBuiltinBackup:
+		if ic.data.Compose.Backup == nil {
+			return "", "", fmt.Errorf("AEM scheduled task '%s' requires compose backup settings", schedule.Name)
+		}
+		return fmt.Sprintf("#!/bin/sh\nsh %s\n", ic.backupScriptPath()), "root", nil
+	case ScheduleBuiltinRevisionCleanup:
+		script, err := ic.instanceScript(instance.RevisionCleanupScript)
+		return script, ic.serviceUser(), err
+	case ScheduleBuiltinDatastoreGC:
+		script, err := ic.instanceScript(instance.DatastoreGCScript)
+		return script, ic.serviceUser(), err
+	case "":
+		return "", "", fmt.Errorf("AEM scheduled task '%s' must define builtin or script", schedule.Name)
+	}
+	return "", "", fmt.Errorf("AEM scheduled task '%s' has unknown builtin '%s'", schedule.Name, schedule.Builtin)
+}
+
+// instanceScript templates the command for each active AEM instance defined in the configuration.
+func (ic *InstanceClient) instanceScript(cmdTemplate string) (string, error) {
+	configYAML, err := ic.configYAML()
+	if err != nil {
+		return "", err
+	}
+	configs, err := parseInstanceConfigs(configYAML)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	sb.WriteString("#!/bin/sh\nset -e\n")
+	for _, config := range configs {
+		if !config.IsActive() {
+			continue
+		}
+		cmd, err := utils.TemplateString(cmdTemplate, map[string]string{
+			"URL":         utils.ShellQuote(strings.TrimSuffix(config.HTTPURL, "/")),
+			"CREDENTIALS": curlConfigEscaper.Replace(config.User + ":" + config.Password),
+		})
+		if err != nil {
+			return "", fmt.Errorf("unable to template command for AEM instance '%s': %w", config.ID, err)
+		}
+		sb.WriteString(cmd + "\n")
+	}
+	return sb.String(), nil
+}
+
+// curlConfigEscaper escapes the value put in double quotes in the curl config file.
+var curlConfigEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+func (ic *InstanceClient) deleteSchedules() error {
+	return ic.deleteSchedulesExcept(map[string]bool{})
+}
+
+func (ic *InstanceClient) deleteSchedulesExcept(units map[string]bool) error {
+	outBytes, err := ic.cl.RunShellPurely(fmt.Sprintf("ls /etc/systemd/system/ | grep '^%s-schedule-.*\\.timer$' || true", ServiceName))
+	if err != nil {
+		return fmt.Errorf("unable to list AEM scheduled tasks: %w", err)
+	}
+	for _, timer := range strings.Fields(string(outBytes)) {
+		unit := strings.TrimSuffix(timer, ".timer")
+		if units[unit] {
+			continue
+		}
+		ic.ctx.Logf(diag.Info, "Deleting AEM scheduled task '%s'", unit)
+		if err := ic.runSystemctl("disable --now", unit+".timer"); err != nil {
+			return err
+		}
+		scriptFile := ic.scheduleScriptPath(strings.TrimPrefix(unit, ServiceName+"-schedule-"))
+		ic.cl.Sudo = true
+		err := ic.cl.PathDelete(fmt.Sprintf("/etc/systemd/system/%s.timer /etc/systemd/system/%s.service %s", unit, unit, scriptFile))
+		ic.cl.Sudo = false
+		if err != nil {
+			return fmt.Errorf("unable to delete AEM scheduled task '%s': %w", unit, err)
+		}
+		if err := ic.runSystemctl("daemon-reload", ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ic *InstanceClient) launch() error {
 	ic.ctx.Log(diag.Info, "Launching AEM instance(s)")
 	if err := ic.runServiceAction("start"); err != nil {
@@ -204,6 +587,66 @@ type InstanceStatus struct {
 	}
 }
 
+// instanceModels converts the status to outputs completing them with the details available only in the configuration.
+func (s InstanceStatus) instanceModels(configYAML string) ([]InstanceModel, error) {
+	configs, err := parseInstanceConfigs(configYAML)
+	if err != nil {
+		return nil, err
+	}
+	var result []InstanceModel
+	for _, item := range s.Data.Instances {
+		model := InstanceModel{
+			ID:           item.ID,
+			URL:          item.URL,
+			AemVersion:   item.AemVersion,
+			Dir:          item.Dir,
+			Attributes:   item.Attributes,
+			RunModes:     item.RunModes,
+			HealthChecks: item.HealthChecks,
+			Created:      slices.Contains(item.Attributes, "created"),
+			Running:      slices.Contains(item.Attributes, "running"),
+			UpToDate:     slices.Contains(item.Attributes, "up-to-date"),
+			HTTPPort:     instanceHTTPPort(item.URL),
+		}
+		model.Reachable = model.Running && !slices.Contains(item.Attributes, "unreachable")
+		if i := slices.IndexFunc(configs, func(c InstanceConfig) bool { return c.ID == item.ID }); i >= 0 {
+			if len(model.RunModes) == 0 {
+				model.RunModes = configs[i].RunModes
+			}
+			model.DebugPort = instanceDebugPort(configs[i].JvmOpts)
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
+
+func instanceHTTPPort(instanceURL string) int {
+	u, err := url.Parse(instanceURL)
+	if err != nil {
+		return 0
+	}
+	if u.Port() != "" {
+		return cast.ToInt(u.Port())
+	}
+	if u.Scheme == "https" {
+		return 443
+	}
+	return 80
+}
+
+var instanceDebugAddressRegex = regexp.MustCompile(`^-(?:agentlib:jdwp=|Xrunjdwp:).*address=(?:[^,]*:)?(\d+)`)
+
+// instanceDebugPort determines the port of Java debugger agent from the JVM options
+// (e.g. '-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=0.0.0.0:14502' or legacy '-Xrunjdwp:transport=dt_socket,address=14502').
+func instanceDebugPort(jvmOpts []string) int {
+	for _, opt := range jvmOpts {
+		if match := instanceDebugAddressRegex.FindStringSubmatch(opt); match != nil {
+			return cast.ToInt(match[1])
+		}
+	}
+	return 0
+}
+
 func (ic *InstanceClient) ReadStatus() (InstanceStatus, error) {
 	var status InstanceStatus
 	yamlBytes, err := ic.cl.RunShellCommand("sh aemw instance status --output-format yaml", ic.dataDir())
@@ -284,3 +727,81 @@ func (ic *InstanceClient) doActionOnce(name string, lockDir string, action func(
 	}
 	return nil
 }
+
+// target exposes the AEM instances defined in the given configuration for performing HTTP requests.
+func (ic *InstanceClient) target(compose *Compose) *TargetClient {
+	return &TargetClient{ic.cl, ic.ctx, TargetArgs{Client: ic.data.Client, System: ic.data.System, Compose: compose}}
+}
+
+type passwordChange struct {
+	instance InstanceConfig
+	password string
+}
+
+// passwordChanges determines the instances on which the password of the same user differs between the configurations.
+// Instances keep the previous password, as it is used for authentication.
+func passwordChanges(oldInstances []InstanceConfig, newInstances []InstanceConfig) []passwordChange {
+	var result []passwordChange
+	for _, newInstance := range newInstances {
+		for _, oldInstance := range oldInstances {
+			if oldInstance.ID == newInstance.ID && oldInstance.User == newInstance.User && oldInstance.Password != newInstance.Password {
+				result = append(result, passwordChange{oldInstance, newInstance.Password})
+			}
+		}
+	}
+	return result
+}
+
+// rotatePasswords changes the passwords on the running instances which differ between the previous and the next configuration.
+// Authentication uses the previous password. On failure, the passwords already changed are restored.
+func (ic *InstanceClient) rotatePasswords(oldCompose *Compose, newCompose *Compose) error {
+	if oldCompose == nil || newCompose == nil {
+		return nil
+	}
+	oldInstances, err := ic.target(oldCompose).instances()
+	if err != nil {
+		return err
+	}
+	newInstances, err := ic.target(newCompose).instances()
+	if err != nil {
+		return err
+	}
+	changes := passwordChanges(oldInstances, newInstances)
+	if len(changes) == 0 {
+		return nil
+	}
+	// instances could be restarted just before (e.g. by the backup), so they need to respond to change the passwords
+	if err := ic.awaitRunning(); err != nil {
+		return err
+	}
+	var rotated []passwordChange
+	for _, change := range changes {
+		if err := ic.changePassword(change.instance, change.password); err != nil {
+			for _, done := range rotated {
+				instance := done.instance
+				instance.Password = done.password
+				if rollbackErr := ic.changePassword(instance, done.instance.Password); rollbackErr != nil {
+					ic.ctx.Logf(diag.Warning, "Unable to restore password on instance '%s' %s", instance.ID, rollbackErr)
+				}
+			}
+			return err
+		}
+		rotated = append(rotated, change)
+	}
+	return nil
+}
+
+func (ic *InstanceClient) changePassword(instance InstanceConfig, password string) error {
+	tc := ic.target(ic.data.Compose)
+	path, err := tc.findAuthorizable(instance, instance.User)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		return fmt.Errorf("user '%s' does not exist on instance '%s'", instance.User, instance.ID)
+	}
+	form := url.Values{}
+	form.Set("rep:password", password)
+	form.Set(":currentPassword", instance.Password)
+	return tc.postForm(instance, path+".rw.html", form, fmt.Sprintf("Changing password of user '%s'", instance.User))
+}
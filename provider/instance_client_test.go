@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseBackupCatalog(t *testing.T) {
+	catalog := `aem-20240101000000.tar.gz;2024-01-01T00:00:00Z;1024;abc123;s3://backups/aem-20240101000000.tar.gz
+malformed line
+aem-20240102000000.tar.zst;2024-01-02T00:00:00Z;2048;def456;/mnt/backup/aem-20240102000000.tar.zst
+`
+	backups := parseBackupCatalog(catalog)
+
+	assert.Equal(t, []BackupModel{
+		{Name: "aem-20240101000000.tar.gz", Timestamp: "2024-01-01T00:00:00Z", Size: 1024, Checksum: "abc123", Location: "s3://backups/aem-20240101000000.tar.gz"},
+		{Name: "aem-20240102000000.tar.zst", Timestamp: "2024-01-02T00:00:00Z", Size: 2048, Checksum: "def456", Location: "/mnt/backup/aem-20240102000000.tar.zst"},
+	}, backups)
+	assert.Empty(t, parseBackupCatalog(""))
+}
+
+func TestSaveBackupScript(t *testing.T) {
+	cl, conn := newFakeClient(nil)
+	compose := &Compose{Config: "instance:\n  local:\n    unpack_dir: /mnt/aem\n", Backup: &Backup{Target: "s3://acme/backups", Retention: 3, Compression: "zstd"}}
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{System: &System{DataDir: "/data/aemc"}, Compose: compose}}
+
+	require.NoError(t, ic.saveBackupScript())
+
+	script := conn.files["/data/aemc/provider/backup.sh"]
+	assert.Contains(t, script, `INSTANCE_DIR="/mnt/aem"`)
+	assert.Contains(t, script, `TARGET="s3://acme/backups"`)
+}
+
+func TestInstanceHTTPPort(t *testing.T) {
+	tests := map[string]int{
+		"http://127.0.0.1:4502":  4502,
+		"https://127.0.0.1:8443": 8443,
+		"http://aem.local":       80,
+		"https://aem.local/":     443,
+		"://invalid":             0,
+	}
+	for url, expected := range tests {
+		t.Run(url, func(t *testing.T) {
+			assert.Equal(t, expected, instanceHTTPPort(url))
+		})
+	}
+}
+
+func TestInstanceDebugPort(t *testing.T) {
+	tests := []struct {
+		name     string
+		jvmOpts  []string
+		expected int
+	}{
+		{"agent with host", []string{"-Xmx2g", "-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=0.0.0.0:14502"}, 14502},
+		{"agent with wildcard host", []string{"-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:5005"}, 5005},
+		{"agent address not last", []string{"-agentlib:jdwp=transport=dt_socket,address=14503,server=y,suspend=n"}, 14503},
+		{"legacy option", []string{"-Xdebug", "-Xrunjdwp:transport=dt_socket,server=y,suspend=n,address=30303"}, 30303},
+		{"debugging disabled", []string{"-Xmx2g", "-Djava.awt.headless=true"}, 0},
+		{"no options", nil, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, instanceDebugPort(test.jvmOpts))
+		})
+	}
+}
+
+func TestInstanceModels(t *testing.T) {
+	var status InstanceStatus
+	require.NoError(t, yaml.Unmarshal([]byte(`data:
+  instances:
+    - id: local_author
+      url: http://127.0.0.1:4502
+      attributes: [local, author, created, running, up-to-date]
+      health_checks: []
+    - id: local_publish
+      url: http://127.0.0.1:4503
+      attributes: [local, publish, created, unreachable, out-of-date]
+      run_modes: [publish, local]
+      health_checks: ["bundle 'com.acme.core' is not active"]
+`), &status))
+	config := `instance:
+  config:
+    local_author:
+      run_modes: [local]
+      jvm_opts: ["-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=0.0.0.0:14502"]
+    local_publish:
+      run_modes: [ignored]
+`
+
+	models, err := status.instanceModels(config)
+
+	require.NoError(t, err)
+	require.Len(t, models, 2)
+	assert.True(t, models[0].Created)
+	assert.True(t, models[0].Running)
+	assert.True(t, models[0].Reachable)
+	assert.True(t, models[0].UpToDate)
+	assert.Equal(t, []string{"local"}, models[0].RunModes)
+	assert.Equal(t, 4502, models[0].HTTPPort)
+	assert.Equal(t, 14502, models[0].DebugPort)
+	assert.True(t, models[1].Created)
+	assert.False(t, models[1].Running)
+	assert.False(t, models[1].Reachable)
+	assert.False(t, models[1].UpToDate)
+	assert.Equal(t, []string{"publish", "local"}, models[1].RunModes)
+	assert.Equal(t, []string{"bundle 'com.acme.core' is not active"}, models[1].HealthChecks)
+	assert.Equal(t, 0, models[1].DebugPort)
+}
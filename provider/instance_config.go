@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"sort"
+	"strings"
+)
+
+type InstanceConfig struct {
+	ID         string   `yaml:"-"`
+	Active     *bool    `yaml:"active"`
+	HTTPURL    string   `yaml:"http_url"`
+	User       string   `yaml:"user"`
+	Password   string   `yaml:"password"`
+	RunModes   []string `yaml:"run_modes"`
+	JvmOpts    []string `yaml:"jvm_opts"`
+	StartOpts  []string `yaml:"start_opts"`
+	EnvVars    []string `yaml:"env_vars"`
+	SecretVars []string `yaml:"secret_vars"`
+	SlingProps []string `yaml:"sling_props"`
+}
+
+func (c InstanceConfig) IsActive() bool {
+	return c.Active == nil || *c.Active
+}
+
+type composeConfig struct {
+	Instance struct {
+		Config map[string]InstanceConfig `yaml:"config"`
+		Local  struct {
+			UnpackDir string `yaml:"unpack_dir"`
+		} `yaml:"local"`
+	} `yaml:"instance"`
+}
+
+// InstanceUnpackDir is the default directory (relative to the data directory) in which AEM Compose CLI unpacks the instances.
+const InstanceUnpackDir = "aem/home/var/instance"
+
+// parseInstanceUnpackDir reads the directory in which AEM Compose CLI unpacks the instances from the AEM Compose YML configuration.
+func parseInstanceUnpackDir(configYAML string) (string, error) {
+	var config composeConfig
+	if err := yaml.Unmarshal([]byte(configYAML), &config); err != nil {
+		return "", fmt.Errorf("unable to parse AEM configuration: %w", err)
+	}
+	if config.Instance.Local.UnpackDir == "" {
+		return InstanceUnpackDir, nil
+	}
+	return config.Instance.Local.UnpackDir, nil
+}
+
+// parseInstanceConfigs reads AEM instance definitions from the AEM Compose YML configuration.
+func parseInstanceConfigs(configYAML string) ([]InstanceConfig, error) {
+	var config composeConfig
+	if err := yaml.Unmarshal([]byte(configYAML), &config); err != nil {
+		return nil, fmt.Errorf("unable to parse AEM configuration: %w", err)
+	}
+	var result []InstanceConfig
+	for id, ic := range config.Instance.Config {
+		ic.ID = id
+		result = append(result, ic)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+const AdminUser = "admin"
+
+const (
+	ConfigListsReplace = "replace"
+	ConfigListsAppend  = "append"
+)
+
+// composeConfigYAML determines the effective AEM Compose YML configuration by deep-merging overrides over the base config.
+func composeConfigYAML(compose *Compose) (string, error) {
+	overridesYAML, err := configOverridesYAML(compose.ConfigOverrides)
+	if err != nil {
+		return "", err
+	}
+	if overridesYAML == "" && len(compose.Instances) == 0 && compose.AdminPassword == "" {
+		return compose.Config, nil
+	}
+	var base yaml.Node
+	if err := yaml.Unmarshal([]byte(compose.Config), &base); err != nil {
+		return "", fmt.Errorf("unable to parse AEM configuration: %w", err)
+	}
+	if len(base.Content) == 0 {
+		base = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	if overridesYAML != "" {
+		var overrides yaml.Node
+		if err := yaml.Unmarshal([]byte(overridesYAML), &overrides); err != nil {
+			return "", fmt.Errorf("unable to parse AEM configuration overrides: %w", err)
+		}
+		if len(overrides.Content) > 0 {
+			base.Content[0] = mergeConfigNodes(base.Content[0], overrides.Content[0], compose.ConfigListsStrategy)
+		}
+	}
+	if len(compose.Instances) > 0 {
+		instances, err := composeInstancesNode(compose.Instances)
+		if err != nil {
+			return "", err
+		}
+		setConfigNode(base.Content[0], instances, "instance", "config")
+	}
+	if compose.AdminPassword != "" {
+		setAdminPassword(base.Content[0], compose.AdminPassword)
+	}
+
+	var sb strings.Builder
+	encoder := yaml.NewEncoder(&sb)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&base); err != nil {
+		return "", fmt.Errorf("unable to serialize merged AEM configuration: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("unable to serialize merged AEM configuration: %w", err)
+	}
+	return sb.String(), nil
+}
+
+func configOverridesYAML(overrides any) (string, error) {
+	switch value := overrides.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(value), nil
+	default:
+		out, err := yaml.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("unable to serialize AEM configuration overrides: %w", err)
+		}
+		return string(out), nil
+	}
+}
+
+// mergeConfigNodes merges mappings recursively while scalars and lists from overrides take precedence (lists could be also appended).
+func mergeConfigNodes(base *yaml.Node, override *yaml.Node, listsStrategy string) *yaml.Node {
+	if base.Kind == yaml.SequenceNode && override.Kind == yaml.SequenceNode && listsStrategy == ConfigListsAppend {
+		result := *base
+		result.Content = append(append([]*yaml.Node{}, base.Content...), override.Content...)
+		return &result
+	}
+	if base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		return override
+	}
+	result := *base
+	result.Content = append([]*yaml.Node{}, base.Content...)
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+		found := false
+		for j := 0; j+1 < len(result.Content); j += 2 {
+			if result.Content[j].Value == key.Value {
+				result.Content[j+1] = mergeConfigNodes(result.Content[j+1], value, listsStrategy)
+				found = true
+				break
+			}
+		}
+		if !found {
+			result.Content = append(result.Content, key, value)
+		}
+	}
+	return &result
+}
+
+// composeInstancesNode renders typed instance definitions skipping unset options to keep AEM Compose defaults.
+func composeInstancesNode(instances []ComposeInstance) (*yaml.Node, error) {
+	type instanceConfig struct {
+		HTTPURL    string   `yaml:"http_url"`
+		User       string   `yaml:"user,omitempty"`
+		Password   string   `yaml:"password,omitempty"`
+		RunModes   []string `yaml:"run_modes,omitempty"`
+		JvmOpts    []string `yaml:"jvm_opts,omitempty"`
+		StartOpts  []string `yaml:"start_opts,omitempty"`
+		EnvVars    []string `yaml:"env_vars,omitempty"`
+		SecretVars []string `yaml:"secret_vars,omitempty"`
+		SlingProps []string `yaml:"sling_props,omitempty"`
+	}
+	result := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, i := range instances {
+		var value yaml.Node
+		if err := value.Encode(instanceConfig{
+			HTTPURL:    i.HTTPURL,
+			User:       i.User,
+			Password:   i.Password,
+			RunModes:   i.RunModes,
+			JvmOpts:    i.JvmOpts,
+			StartOpts:  i.StartOpts,
+			EnvVars:    i.EnvVars,
+			SecretVars: i.SecretVars,
+			SlingProps: i.SlingProps,
+		}); err != nil {
+			return nil, fmt.Errorf("unable to serialize AEM instance '%s' definition: %w", i.ID, err)
+		}
+		result.Content = append(result.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: i.ID}, &value)
+	}
+	return result, nil
+}
+
+// setAdminPassword overrides the password of each instance defined in the 'instance.config' section which communicates
+// using the admin user (also by default, when no user is set). Instances using other users are left untouched.
+func setAdminPassword(root *yaml.Node, password string) {
+	instances := findConfigNode(root, "instance", "config")
+	if instances == nil || instances.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 1; i < len(instances.Content); i += 2 {
+		if instances.Content[i].Kind != yaml.MappingNode {
+			continue
+		}
+		if user := findConfigNode(instances.Content[i], "user"); user != nil && !isAdminUser(user.Value) {
+			continue
+		}
+		setConfigNode(instances.Content[i], &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: password}, "password")
+	}
+}
+
+// isAdminUser checks if the user is the admin one. AEM Compose CLI uses it by default when the user is not set.
+func isAdminUser(user string) bool {
+	return user == "" || user == AdminUser
+}
+
+// findConfigNode returns the value under the given path or nil if it does not exist.
+func findConfigNode(root *yaml.Node, path ...string) *yaml.Node {
+	node := root
+	for _, key := range path {
+		var child *yaml.Node
+		for j := 0; node.Kind == yaml.MappingNode && j+1 < len(node.Content); j += 2 {
+			if node.Content[j].Value == key {
+				child = node.Content[j+1]
+				break
+			}
+		}
+		if child == nil {
+			return nil
+		}
+		node = child
+	}
+	return node
+}
+
+// setConfigNode replaces the value under the given path creating missing mappings on the way.
+func setConfigNode(root *yaml.Node, value *yaml.Node, path ...string) {
+	node := root
+	for i, key := range path {
+		last := i == len(path)-1
+		var child *yaml.Node
+		for j := 0; j+1 < len(node.Content); j += 2 {
+			if node.Content[j].Value == key {
+				if last || node.Content[j+1].Kind != yaml.MappingNode {
+					node.Content[j+1] = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+				}
+				child = node.Content[j+1]
+				break
+			}
+		}
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+		}
+		if last {
+			*child = *value
+			return
+		}
+		node = child
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestComposeConfigYAMLOverrides(t *testing.T) {
+	config := `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      run_modes: [local]
+java:
+  home_dir: /usr/lib/jvm/java-11
+`
+	tests := []struct {
+		name     string
+		strategy string
+		override any
+		expected string
+	}{
+		{
+			name:     "no overrides",
+			strategy: ConfigListsReplace,
+			override: nil,
+			expected: config,
+		},
+		{
+			name:     "string override replacing scalar and adding key",
+			strategy: ConfigListsReplace,
+			override: "java:\n  home_dir: /usr/lib/jvm/java-17\n  download: false\n",
+			expected: `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      run_modes: [local]
+java:
+  home_dir: /usr/lib/jvm/java-17
+  download: false
+`,
+		},
+		{
+			name:     "map override merged deeply",
+			strategy: ConfigListsReplace,
+			override: map[string]any{"instance": map[string]any{"config": map[string]any{"local_author": map[string]any{"http_url": "http://127.0.0.1:14502"}}}},
+			expected: `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:14502
+      run_modes: [local]
+java:
+  home_dir: /usr/lib/jvm/java-11
+`,
+		},
+		{
+			name:     "lists replaced",
+			strategy: ConfigListsReplace,
+			override: "instance:\n  config:\n    local_author:\n      run_modes: [dev]\n",
+			expected: `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      run_modes: [dev]
+java:
+  home_dir: /usr/lib/jvm/java-11
+`,
+		},
+		{
+			name:     "lists appended",
+			strategy: ConfigListsAppend,
+			override: map[string]any{"instance": map[string]any{"config": map[string]any{"local_author": map[string]any{"run_modes": []any{"dev"}}}}},
+			expected: `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      run_modes: [local, dev]
+java:
+  home_dir: /usr/lib/jvm/java-11
+`,
+		},
+		{
+			name:     "mapping replaced by scalar",
+			strategy: ConfigListsAppend,
+			override: "java: none\n",
+			expected: `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      run_modes: [local]
+java: none
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := composeConfigYAML(&Compose{Config: config, ConfigOverrides: test.override, ConfigListsStrategy: test.strategy})
+			require.NoError(t, err)
+			assertYAMLEqual(t, test.expected, actual)
+		})
+	}
+}
+
+func assertYAMLEqual(t *testing.T, expected string, actual string) {
+	var expectedData, actualData any
+	require.NoError(t, yaml.Unmarshal([]byte(expected), &expectedData))
+	require.NoError(t, yaml.Unmarshal([]byte(actual), &actualData))
+	assert.Equal(t, expectedData, actualData)
+}
+
+func TestComposeConfigYAMLInstances(t *testing.T) {
+	config := `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+    local_publish:
+      http_url: http://127.0.0.1:4503
+java:
+  home_dir: /usr/lib/jvm/java-11
+`
+	actual, err := composeConfigYAML(&Compose{Config: config, ConfigListsStrategy: ConfigListsReplace, Instances: []ComposeInstance{
+		{ID: "dev_author", HTTPURL: "http://127.0.0.1:4502", Password: "s3cret", RunModes: []string{"dev"}},
+	}})
+
+	require.NoError(t, err)
+	assertYAMLEqual(t, `instance:
+  config:
+    dev_author:
+      http_url: http://127.0.0.1:4502
+      password: s3cret
+      run_modes: [dev]
+java:
+  home_dir: /usr/lib/jvm/java-11
+`, actual)
+}
+
+func TestComposeConfigYAMLAdminPassword(t *testing.T) {
+	config := `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      password: admin
+    local_publish:
+      http_url: http://127.0.0.1:4503
+    remote_publish:
+      http_url: http://publish.acme.com
+      user: deployer
+      password: deployer-secret
+`
+	actual, err := composeConfigYAML(&Compose{
+		Config:              config,
+		ConfigOverrides:     "instance:\n  config:\n    local_publish:\n      password: overridden\n",
+		ConfigListsStrategy: ConfigListsReplace,
+		AdminPassword:       "s3cret",
+	})
+
+	require.NoError(t, err)
+	assertYAMLEqual(t, `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      password: s3cret
+    local_publish:
+      http_url: http://127.0.0.1:4503
+      password: s3cret
+    remote_publish:
+      http_url: http://publish.acme.com
+      user: deployer
+      password: deployer-secret
+`, actual)
+}
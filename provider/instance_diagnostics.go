@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type diagnosticsCommand struct {
+	name   string
+	script string
+}
+
+type diagnosticsFile struct {
+	name    string
+	content string
+}
+
+// diagnose collects the diagnostics from the machine after the failure (if enabled) and enriches the error with their summary.
+// Problems with collecting the diagnostics are only logged, so the original error is always reported.
+func (ic *InstanceClient) diagnose(cause error) error {
+	diagnostics := ic.data.Diagnostics
+	if diagnostics == nil {
+		return cause
+	}
+	ic.ctx.Log(diag.Info, "Collecting AEM instance diagnostics")
+	files, err := ic.collectDiagnostics(diagnostics)
+	if err != nil {
+		ic.ctx.Logf(diag.Warning, "Unable to collect AEM instance diagnostics %s", err)
+		return cause
+	}
+	if diagnostics.OutputDir != "" {
+		file, err := writeDiagnosticsTarball(diagnostics.OutputDir, files)
+		if err != nil {
+			ic.ctx.Logf(diag.Warning, "Unable to save AEM instance diagnostics %s", err)
+		} else {
+			ic.ctx.Logf(diag.Warning, "Saved AEM instance diagnostics to file '%s'", file)
+		}
+	}
+	ic.ctx.Log(diag.Info, "Collected AEM instance diagnostics")
+	if diagnostics.SummaryLines == 0 {
+		return cause
+	}
+	return fmt.Errorf("%w\n\n%s", cause, diagnosticsSummary(files, diagnostics.SummaryLines))
+}
+
+func (ic *InstanceClient) collectDiagnostics(diagnostics *Diagnostics) ([]diagnosticsFile, error) {
+	tc := ic.target(ic.data.Compose)
+	instances, err := tc.instances()
+	if err != nil {
+		return nil, err
+	}
+	commands := []diagnosticsCommand{
+		{"status.txt", "sh aemw instance status"},
+		{"journal.txt", fmt.Sprintf("journalctl -u %s --no-pager -n %d", ServiceName, diagnostics.LogLines)},
+		{"system.txt", "uptime; echo; df -h; echo; free -m"},
+	}
+	for _, instance := range instances {
+		dir, err := tc.instanceDir(instance.ID)
+		if err != nil {
+			return nil, err
+		}
+		commands = append(commands,
+			diagnosticsCommand{instance.ID + "/error.log", fmt.Sprintf("tail -n %d %s", diagnostics.LogLines, utils.ShellQuote(dir+"/crx-quickstart/logs/error.log"))},
+			diagnosticsCommand{instance.ID + "/stdout.log", fmt.Sprintf("tail -n %d %s", diagnostics.LogLines, utils.ShellQuote(dir+"/crx-quickstart/logs/stdout.log"))},
+		)
+		if diagnostics.ThreadDump {
+			commands = append(commands, diagnosticsCommand{instance.ID + "/thread-dump.txt", threadDumpScript(dir, tc.serviceUser())})
+		}
+	}
+	var files []diagnosticsFile
+	for _, command := range commands {
+		// failures of single commands (e.g. missing log files) are recorded in place of their output
+		out, err := ic.cl.RunShellScript(scriptName("diagnostics"), fmt.Sprintf("(%s) 2>&1 || true", command.script), ic.dataDir())
+		if err != nil {
+			out = []byte(err.Error())
+		}
+		files = append(files, diagnosticsFile{command.name, string(out)})
+	}
+	return files, nil
+}
+
+// threadDumpScript prints the thread dump of the running AEM instance using JDK tools available on the machine.
+// JDK tools attach to the JVM only when run by the same user as the JVM, so they are run as the service user.
+func threadDumpScript(instanceDir string, user string) string {
+	pidFile := utils.ShellQuote(instanceDir + "/crx-quickstart/conf/cq.pid")
+	userQuoted := utils.ShellQuote(user)
+	return fmt.Sprintf(`pid=$(cat %s 2>/dev/null)
+if [ -z "$pid" ]; then
+  echo "AEM instance is not running" >&2
+  exit 1
+elif command -v jcmd > /dev/null; then
+  sudo -u %s jcmd "$pid" Thread.print
+else
+  sudo -u %s jstack "$pid"
+fi`, pidFile, userQuoted, userQuoted)
+}
+
+func diagnosticsSummary(files []diagnosticsFile, lines int) string {
+	var sb strings.Builder
+	sb.WriteString("AEM instance diagnostics:")
+	for _, file := range files {
+		if file.name != "status.txt" && !strings.HasSuffix(file.name, "/error.log") {
+			continue
+		}
+		content := strings.Split(strings.TrimRight(file.content, "\n"), "\n")
+		if len(content) > lines {
+			content = content[len(content)-lines:]
+		}
+		sb.WriteString(fmt.Sprintf("\n\n--- %s ---\n%s", file.name, strings.Join(content, "\n")))
+	}
+	return sb.String()
+}
+
+func writeDiagnosticsTarball(dir string, files []diagnosticsFile) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("aem-diagnostics-%s.tar.gz", time.Now().Format("20060102-150405")))
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	gw := gzip.NewWriter(file)
+	tw := tar.NewWriter(gw)
+	for _, f := range files {
+		header := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), ModTime: time.Now()}
+		if err := tw.WriteHeader(header); err != nil {
+			return "", err
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			return "", err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	if err := gw.Close(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+)
+
+// instanceImportSettings are the import ID query parameters which are not passed to the client settings.
+var instanceImportSettings = []string{"data_dir", "work_dir", "private_key_file"}
+
+// parseInstanceImportID determines the instance inputs from the import ID in format:
+// 'ssh://user@host:port?private_key_file=~/.ssh/id_rsa&data_dir=/mnt/aemc' or 'aws-ssm://i-0123456789?region=eu-central-1&data_dir=/mnt/aemc'.
+// The inputs not encoded in the ID get the same defaults as when the resource is declared.
+func parseInstanceImportID(ctx p.Context, id string) (InstanceArgs, error) {
+	u, err := url.Parse(id)
+	if err != nil || u.Scheme == "" {
+		return InstanceArgs{}, fmt.Errorf("invalid AEM instance import ID '%s' (expected format like 'ssh://user@host:22?private_key_file=path&data_dir=/mnt/aemc' or 'aws-ssm://i-0123456789?region=eu-central-1')", id)
+	}
+	query := u.Query()
+	settings := resource.PropertyMap{}
+	credentials := resource.PropertyMap{}
+	switch u.Scheme {
+	case "ssh":
+		settings["host"] = resource.NewStringProperty(u.Hostname())
+		settings["user"] = resource.NewStringProperty(u.User.Username())
+		if u.Port() != "" {
+			settings["port"] = resource.NewStringProperty(u.Port())
+		}
+		if file := query.Get("private_key_file"); file != "" {
+			privateKey, err := os.ReadFile(expandHomeDir(file))
+			if err != nil {
+				return InstanceArgs{}, fmt.Errorf("unable to read private key file '%s': %w", file, err)
+			}
+			credentials["private_key"] = resource.NewStringProperty(string(privateKey))
+		}
+	case "aws-ssm":
+		settings["instance_id"] = resource.NewStringProperty(u.Host)
+	}
+	keys := maps.Keys(query)
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !slices.Contains(instanceImportSettings, key) {
+			settings[resource.PropertyKey(key)] = resource.NewStringProperty(query.Get(key))
+		}
+	}
+	system := resource.PropertyMap{}
+	for _, key := range []string{"data_dir", "work_dir"} {
+		if query.Has(key) {
+			system[resource.PropertyKey(key)] = resource.NewStringProperty(query.Get(key))
+		}
+	}
+	inputs := resource.PropertyMap{
+		"client": resource.NewObjectProperty(resource.PropertyMap{
+			"type":        resource.NewStringProperty(u.Scheme),
+			"settings":    resource.NewObjectProperty(settings),
+			"credentials": resource.NewObjectProperty(credentials),
+		}),
+		"system": resource.NewObjectProperty(system),
+	}
+	args, failures, err := Instance{}.Check(ctx, "", nil, inputs)
+	if err != nil {
+		return args, err
+	}
+	if len(failures) > 0 {
+		return args, fmt.Errorf("invalid AEM instance import ID '%s': property '%s' %s", id, failures[0].Property, failures[0].Reason)
+	}
+	return args, nil
+}
+
+// serviceConfigUser determines the user running the system service from its definition.
+func serviceConfigUser(serviceConfig string) string {
+	for _, line := range strings.Split(serviceConfig, "\n") {
+		if user, ok := strings.CutPrefix(strings.TrimSpace(line), "User="); ok {
+			return strings.TrimSpace(user)
+		}
+	}
+	return ""
+}
+
+// extractConfigCredentials removes the instance passwords from the AEM Compose YML configuration. The password shared by
+// all instances using the admin user becomes the admin password. Otherwise, the instances are defined separately with their own passwords.
+func extractConfigCredentials(configYAML string) (string, string, []ComposeInstance, error) {
+	configs, err := parseInstanceConfigs(configYAML)
+	if err != nil {
+		return "", "", nil, err
+	}
+	var adminPasswords []string
+	otherPasswords := false
+	for _, config := range configs {
+		if config.Password == "" {
+			continue
+		}
+		if !isAdminUser(config.User) {
+			otherPasswords = true
+		} else if !slices.Contains(adminPasswords, config.Password) {
+			adminPasswords = append(adminPasswords, config.Password)
+		}
+	}
+	if len(adminPasswords) == 0 && !otherPasswords {
+		return configYAML, "", nil, nil
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(configYAML), &root); err != nil {
+		return "", "", nil, fmt.Errorf("unable to parse AEM configuration: %w", err)
+	}
+	var adminPassword string
+	var instances []ComposeInstance
+	if len(adminPasswords) == 1 && !otherPasswords {
+		adminPassword = adminPasswords[0]
+		if node := findConfigNode(root.Content[0], "instance", "config"); node != nil && node.Kind == yaml.MappingNode {
+			for i := 1; i < len(node.Content); i += 2 {
+				deleteConfigNode(node.Content[i], "password")
+			}
+		}
+	} else {
+		for _, config := range configs {
+			if !config.IsActive() {
+				continue
+			}
+			instances = append(instances, ComposeInstance{
+				ID:         config.ID,
+				HTTPURL:    config.HTTPURL,
+				User:       config.User,
+				Password:   config.Password,
+				RunModes:   config.RunModes,
+				JvmOpts:    config.JvmOpts,
+				StartOpts:  config.StartOpts,
+				EnvVars:    config.EnvVars,
+				SecretVars: config.SecretVars,
+				SlingProps: config.SlingProps,
+			})
+		}
+		if node := findConfigNode(root.Content[0], "instance"); node != nil {
+			deleteConfigNode(node, "config")
+		}
+	}
+	var sb strings.Builder
+	encoder := yaml.NewEncoder(&sb)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&root); err != nil {
+		return "", "", nil, fmt.Errorf("unable to serialize AEM configuration: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", "", nil, fmt.Errorf("unable to serialize AEM configuration: %w", err)
+	}
+	return sb.String(), adminPassword, instances, nil
+}
+
+// deleteConfigNode removes the key from the mapping (if exists).
+func deleteConfigNode(mapping *yaml.Node, key string) {
+	for j := 0; mapping.Kind == yaml.MappingNode && j+1 < len(mapping.Content); j += 2 {
+		if mapping.Content[j].Value == key {
+			mapping.Content = append(mapping.Content[:j], mapping.Content[j+2:]...)
+			return
+		}
+	}
+}
+
+func expandHomeDir(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return home + path[1:]
+		}
+	}
+	return path
+}
+
+// readImportedArgs pulls the configuration of the instance set up outside of the stack, so that it could be adopted without recreation.
+func (ic *InstanceClient) readImportedArgs() (InstanceArgs, error) {
+	args := ic.data
+	system := *args.System
+	compose := *args.Compose
+
+	configYAML, err := ic.cl.RunShellPurely(fmt.Sprintf("cat %s/aem/default/etc/aem.yml", ic.dataDir()))
+	if err != nil {
+		return args, fmt.Errorf("unable to read AEM configuration file: %w", err)
+	}
+	// credentials are moved to the secret inputs, so they are not stored in plain text in the state and the generated program
+	compose.Config, compose.AdminPassword, compose.Instances, err = extractConfigCredentials(string(configYAML))
+	if err != nil {
+		return args, err
+	}
+
+	serviceConfig, err := ic.cl.RunShellPurely(fmt.Sprintf("cat /etc/systemd/system/%s.service", ServiceName))
+	if err != nil {
+		return args, fmt.Errorf("unable to read AEM system service definition: %w", err)
+	}
+	if user := serviceConfigUser(string(serviceConfig)); user != "" && user != ic.serviceUser() {
+		system.User = user
+	}
+	// the definition is rendered, so it is kept only when it differs from the one rendered from the template being set
+	rendered, err := (&InstanceClient{ic.cl, ic.ctx, InstanceArgs{System: &system}}).serviceConfig()
+	if err != nil {
+		return args, err
+	}
+	if strings.TrimSpace(rendered) != strings.TrimSpace(string(serviceConfig)) {
+		system.ServiceConfig = string(serviceConfig)
+	}
+
+	envScript, err := ic.cl.RunShellPurely(fmt.Sprintf("cat /etc/profile.d/%s.sh 2>/dev/null || true", ServiceName))
+	if err != nil {
+		return args, fmt.Errorf("unable to read AEM environment variables file: %w", err)
+	}
+	env := utils.ScriptToEnv(string(envScript))
+	if version, ok := env["AEM_CLI_VERSION"]; ok {
+		compose.Version = version
+	}
+	for _, name := range []string{"AEM_CLI_VERSION", "AEM_OUTPUT_LOG_MODE"} {
+		delete(env, name)
+	}
+	system.Env = env
+
+	args.System = &system
+	args.Compose = &compose
+	return args, nil
+}
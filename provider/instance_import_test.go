@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+func TestParseInstanceImportIDSSH(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "id_rsa")
+	require.NoError(t, os.WriteFile(keyFile, []byte("private-key"), 0600))
+
+	args, err := parseInstanceImportID(newTestContext(t), "ssh://ec2-user@10.0.0.1:2222?private_key_file="+keyFile+"&data_dir=/data/aemc&secure=false")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ssh", args.Client.Type)
+	assert.Equal(t, map[string]string{"host": "10.0.0.1", "user": "ec2-user", "port": "2222", "secure": "false"}, args.Client.Settings)
+	assert.Equal(t, "private-key", args.Client.Credentials["private_key"])
+	assert.Equal(t, "/data/aemc", args.System.DataDir)
+	assert.Equal(t, "/tmp/aemc", args.System.WorkDir)
+	assert.Equal(t, instance.ServiceConf, args.System.ServiceConfig)
+}
+
+func TestParseInstanceImportIDAwsSSM(t *testing.T) {
+	args, err := parseInstanceImportID(newTestContext(t), "aws-ssm://i-0123456789?region=eu-central-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "aws-ssm", args.Client.Type)
+	assert.Equal(t, map[string]string{"instance_id": "i-0123456789", "region": "eu-central-1"}, args.Client.Settings)
+	assert.Equal(t, "/mnt/aemc", args.System.DataDir)
+}
+
+func TestParseInstanceImportIDInvalid(t *testing.T) {
+	for _, id := range []string{"i-0123456789", "ssh://ec2-user@10.0.0.1", "ftp://host"} {
+		t.Run(id, func(t *testing.T) {
+			_, err := parseInstanceImportID(newTestContext(t), id)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReadImportedArgsServiceConfig(t *testing.T) {
+	customService := "[Service]\nUser=aem\nExecStart=/opt/aem/start.sh\n"
+	tests := []struct {
+		name          string
+		service       string
+		serviceConfig string
+		user          string
+	}{
+		{"default service", strings.ReplaceAll(strings.ReplaceAll(instance.ServiceConf, "[[.DATA_DIR]]", "/mnt/aemc"), "[[.USER]]", "ec2-user"), instance.ServiceConf, ""},
+		{"default service run by other user", strings.ReplaceAll(strings.ReplaceAll(instance.ServiceConf, "[[.DATA_DIR]]", "/mnt/aemc"), "[[.USER]]", "aem"), instance.ServiceConf, "aem"},
+		{"custom service", customService, customService, "aem"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+				switch {
+				case strings.HasPrefix(cmd, "cat /etc/systemd/system/aem.service"):
+					return test.service, nil
+				case strings.HasPrefix(cmd, "cat /etc/profile.d/aem.sh"):
+					return "#!/bin/sh\nexport AEM_CLI_VERSION=\"1.6.0\"\nexport JAVA_HOME=\"/usr/lib/jvm/java-11\"\n", nil
+				}
+				return "instance: {}\n", nil
+			})
+			args, err := parseInstanceImportID(newTestContext(t), "aws-ssm://i-0123456789")
+			require.NoError(t, err)
+			ic := &InstanceClient{cl, newTestContext(t), args}
+
+			imported, err := ic.readImportedArgs()
+
+			require.NoError(t, err)
+			assert.Equal(t, test.serviceConfig, imported.System.ServiceConfig)
+			assert.Equal(t, test.user, imported.System.User)
+			assert.Equal(t, "1.6.0", imported.Compose.Version)
+			assert.Equal(t, map[string]string{"JAVA_HOME": "/usr/lib/jvm/java-11"}, imported.System.Env)
+		})
+	}
+}
+
+func TestExtractConfigCredentials(t *testing.T) {
+	t.Run("no credentials", func(t *testing.T) {
+		config := "instance:\n  config:\n    local_author:\n      http_url: http://127.0.0.1:4502\n"
+
+		actual, adminPassword, instances, err := extractConfigCredentials(config)
+
+		require.NoError(t, err)
+		assert.Equal(t, config, actual)
+		assert.Empty(t, adminPassword)
+		assert.Empty(t, instances)
+	})
+	t.Run("shared admin password", func(t *testing.T) {
+		actual, adminPassword, instances, err := extractConfigCredentials(`instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      user: admin
+      password: s3cret
+    local_publish:
+      http_url: http://127.0.0.1:4503
+      password: s3cret
+`)
+
+		require.NoError(t, err)
+		assertYAMLEqual(t, `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      user: admin
+    local_publish:
+      http_url: http://127.0.0.1:4503
+`, actual)
+		assert.Equal(t, "s3cret", adminPassword)
+		assert.Empty(t, instances)
+	})
+	t.Run("different passwords", func(t *testing.T) {
+		actual, adminPassword, instances, err := extractConfigCredentials(`instance:
+  check:
+    interval: 5s
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      password: author-secret
+      run_modes: [local]
+    remote_publish:
+      http_url: http://publish.acme.com
+      user: deployer
+      password: deployer-secret
+    local_preview:
+      active: false
+      http_url: http://127.0.0.1:4505
+      password: preview-secret
+`)
+
+		require.NoError(t, err)
+		assertYAMLEqual(t, "instance:\n  check:\n    interval: 5s\n", actual)
+		assert.NotContains(t, actual, "secret")
+		assert.Empty(t, adminPassword)
+		assert.Equal(t, []ComposeInstance{
+			{ID: "local_author", HTTPURL: "http://127.0.0.1:4502", Password: "author-secret", RunModes: []string{"local"}},
+			{ID: "remote_publish", HTTPURL: "http://publish.acme.com", User: "deployer", Password: "deployer-secret"},
+		}, instances)
+	})
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+// fakeAEM answers the status and user management requests, failing the password change on instances listed.
+func fakeAEM(requests *[]string, failing ...string) func(cmd string, script string) (string, error) {
+	return func(cmd string, script string) (string, error) {
+		if strings.Contains(cmd, "aemw instance status") {
+			return "data:\n  instances:\n    - id: local_author\n      attributes: [created, running]\n    - id: local_publish\n      attributes: [created, running]\n", nil
+		}
+		*requests = append(*requests, script)
+		if strings.Contains(script, "querybuilder") {
+			return `{"hits":[{"path":"/home/users/a/admin"}]}` + "\n200", nil
+		}
+		for _, url := range failing {
+			if strings.Contains(script, url) {
+				return "\n500", nil
+			}
+		}
+		return "\n200", nil
+	}
+}
+
+func passwordChangeRequests(requests []string) []string {
+	var result []string
+	for _, request := range requests {
+		if strings.Contains(request, ".rw.html") {
+			result = append(result, request)
+		}
+	}
+	return result
+}
+
+func TestRotatePasswords(t *testing.T) {
+	var requests []string
+	cl, _ := newFakeClient(fakeAEM(&requests))
+	oldCompose := &Compose{Config: instance.ConfigYML}
+	newCompose := &Compose{Config: instance.ConfigYML, AdminPassword: "n3w"}
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{Client: Client{ActionTimeout: "1s"}, System: &System{DataDir: "/mnt/aemc"}, Compose: newCompose}}
+
+	require.NoError(t, ic.rotatePasswords(oldCompose, newCompose))
+
+	changes := passwordChangeRequests(requests)
+	require.Len(t, changes, 2)
+	for i, url := range []string{"http://127.0.0.1:4502", "http://127.0.0.1:4503"} {
+		assert.Contains(t, changes[i], "-u 'admin:admin'")
+		assert.Contains(t, changes[i], "--data-urlencode ':currentPassword=admin' --data-urlencode 'rep:password=n3w'")
+		assert.Contains(t, changes[i], url+"/home/users/a/admin.rw.html")
+	}
+}
+
+func TestRotatePasswordsUnchanged(t *testing.T) {
+	cl, conn := newFakeClient(nil)
+	compose := &Compose{Config: instance.ConfigYML, AdminPassword: "s3cret"}
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{Client: Client{ActionTimeout: "1s"}, System: &System{DataDir: "/mnt/aemc"}, Compose: compose}}
+
+	require.NoError(t, ic.rotatePasswords(compose, compose))
+
+	assert.Empty(t, conn.commands)
+}
+
+func TestRotatePasswordsRollback(t *testing.T) {
+	var requests []string
+	cl, _ := newFakeClient(fakeAEM(&requests, "http://127.0.0.1:4503"))
+	oldCompose := &Compose{Config: instance.ConfigYML}
+	newCompose := &Compose{Config: instance.ConfigYML, AdminPassword: "n3w"}
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{Client: Client{ActionTimeout: "1s"}, System: &System{DataDir: "/mnt/aemc"}, Compose: newCompose}}
+
+	assert.Error(t, ic.rotatePasswords(oldCompose, newCompose))
+
+	changes := passwordChangeRequests(requests)
+	require.Len(t, changes, 3)
+	assert.Contains(t, changes[0], "http://127.0.0.1:4502")
+	assert.Contains(t, changes[1], "http://127.0.0.1:4503")
+	assert.Contains(t, changes[2], "-u 'admin:n3w'")
+	assert.Contains(t, changes[2], "--data-urlencode ':currentPassword=n3w' --data-urlencode 'rep:password=admin'")
+	assert.Contains(t, changes[2], "http://127.0.0.1:4502/home/users/a/admin.rw.html")
+}
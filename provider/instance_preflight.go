@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"golang.org/x/exp/slices"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	preflightJavaVersionRegex = regexp.MustCompile(`version "(\d+)(?:\.(\d+))?`)
+	preflightLocalHosts       = []string{"localhost", "127.0.0.1", "0.0.0.0"}
+)
+
+// preflight checks the machine before creating the instances (if enabled) and reports all detected problems at once.
+func (ic *InstanceClient) preflight() error {
+	preflight := ic.data.Preflight
+	if preflight == nil {
+		return nil
+	}
+	ic.ctx.Log(diag.Info, "Performing AEM instance preflight checks")
+	ports, err := ic.preflightPorts()
+	if err != nil {
+		return err
+	}
+	out, err := ic.cl.RunShellScript(scriptName("preflight"), ic.preflightScript(ports), ".")
+	if err != nil {
+		return fmt.Errorf("unable to perform preflight checks: %w", err)
+	}
+	facts := map[string]string{}
+	for _, line := range strings.Split(string(out), "\n") {
+		if key, value, ok := strings.Cut(strings.TrimSpace(line), "="); ok {
+			facts[key] = value
+		}
+	}
+	problems := preflightProblems(preflight, facts, ports, ic.data.Compose.Download)
+	if len(problems) > 0 {
+		return fmt.Errorf("preflight checks failed:\n- %s", strings.Join(problems, "\n- "))
+	}
+	ic.ctx.Log(diag.Info, "Performed AEM instance preflight checks")
+	return nil
+}
+
+// preflightPorts determines the ports of the local instances to be checked. Instances already unpacked on the machine
+// (e.g. by the previous attempt to create them) are skipped as their ports are expected to be in use.
+func (ic *InstanceClient) preflightPorts() ([]int, error) {
+	if !ic.data.Preflight.Ports {
+		return nil, nil
+	}
+	tc := ic.target(ic.data.Compose)
+	instances, err := tc.instances()
+	if err != nil {
+		return nil, err
+	}
+	var ports []int
+	for _, instance := range instances {
+		u, err := url.Parse(instance.HTTPURL)
+		if err != nil || !slices.Contains(preflightLocalHosts, u.Hostname()) {
+			continue
+		}
+		dir, err := tc.instanceDir(instance.ID)
+		if err != nil {
+			return nil, err
+		}
+		exists, err := ic.cl.DirExists(dir)
+		if err != nil {
+			return nil, fmt.Errorf("cannot check if AEM instance '%s' is already unpacked: %w", instance.ID, err)
+		}
+		if exists {
+			continue
+		}
+		for _, port := range []int{instanceHTTPPort(instance.HTTPURL), instanceDebugPort(instance.JvmOpts)} {
+			if port > 0 && !slices.Contains(ports, port) {
+				ports = append(ports, port)
+			}
+		}
+	}
+	return ports, nil
+}
+
+// preflightScript prints the facts about the machine as 'KEY=value' lines, so they could be evaluated at once.
+func (ic *InstanceClient) preflightScript(ports []int) string {
+	lines := []string{
+		fmt.Sprintf("d=%s; while [ ! -d \"$d\" ]; do d=$(dirname \"$d\"); done", utils.ShellQuote(ic.dataDir())),
+		"echo \"DISK_FREE_KB=$(df -Pk \"$d\" | awk 'NR==2 {print $4}')\"",
+		"echo \"JAVA_VERSION=$(java -version 2>&1 | head -n 1 | tr -d '\\r')\"",
+		"command -v systemctl > /dev/null && echo SYSTEMCTL=yes || echo SYSTEMCTL=no",
+		"sudo -n true > /dev/null 2>&1 && echo SUDO=yes || echo SUDO=no",
+		"command -v curl > /dev/null && echo CURL=yes || echo CURL=no",
+	}
+	if ic.data.Compose.Download {
+		lines = append(lines, fmt.Sprintf("curl -sfIL -o /dev/null %s && echo DOWNLOAD=yes || echo DOWNLOAD=no", utils.ShellQuote(ComposeWrapperURL)))
+	}
+	for _, port := range ports {
+		lines = append(lines, fmt.Sprintf("(ss -ltnH 2> /dev/null || netstat -ltn 2> /dev/null) | awk '{print $4}' | grep -Eq '[:.]%d$' && echo PORT_%d=busy || echo PORT_%d=free", port, port, port))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func preflightProblems(preflight *Preflight, facts map[string]string, ports []int, download bool) []string {
+	var problems []string
+	if preflight.MinDiskSpace > 0 {
+		freeGB := float64(cast.ToInt64(facts["DISK_FREE_KB"])) / (1024 * 1024)
+		if freeGB < float64(preflight.MinDiskSpace) {
+			problems = append(problems, fmt.Sprintf("free disk space in data directory is %.1f GB (required %d GB)", freeGB, preflight.MinDiskSpace))
+		}
+	}
+	if preflight.JavaVersion != "" {
+		actual := javaMajorVersion(facts["JAVA_VERSION"])
+		if actual == "" {
+			problems = append(problems, fmt.Sprintf("Java runtime is not available (required version %s)", preflight.JavaVersion))
+		} else if actual != preflight.JavaVersion {
+			problems = append(problems, fmt.Sprintf("Java runtime version is %s (required %s)", actual, preflight.JavaVersion))
+		}
+	}
+	if facts["SYSTEMCTL"] != "yes" {
+		problems = append(problems, "command 'systemctl' is not available")
+	}
+	if facts["SUDO"] != "yes" {
+		problems = append(problems, "command 'sudo' is not available to the connecting user without password")
+	}
+	if facts["CURL"] != "yes" {
+		problems = append(problems, "command 'curl' is not available")
+	} else if download && facts["DOWNLOAD"] != "yes" {
+		problems = append(problems, fmt.Sprintf("AEM Compose CLI wrapper cannot be downloaded from '%s'", ComposeWrapperURL))
+	}
+	for _, port := range ports {
+		if facts[fmt.Sprintf("PORT_%d", port)] != "free" {
+			problems = append(problems, fmt.Sprintf("port %d is already in use", port))
+		}
+	}
+	return problems
+}
+
+// javaMajorVersion extracts the major version from the 'java -version' output, e.g. '8' from '1.8.0_392' or '11' from '11.0.21'.
+func javaMajorVersion(versionLine string) string {
+	match := preflightJavaVersionRegex.FindStringSubmatch(versionLine)
+	if match == nil {
+		return ""
+	}
+	if match[1] == "1" && match[2] != "" {
+		return match[2]
+	}
+	return match[1]
+}
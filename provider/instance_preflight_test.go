@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+func TestPreflightPortsSkipsUnpackedInstances(t *testing.T) {
+	cl, conn := newFakeClient(func(cmd string, script string) (string, error) {
+		if strings.Contains(cmd, "test -d /data/aemc/aem/home/var/instance/local_author") {
+			return "0", nil
+		}
+		return "1", nil
+	})
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{System: &System{DataDir: "/data/aemc"}, Compose: &Compose{Config: instance.ConfigYML}, Preflight: &Preflight{Ports: true}}}
+
+	ports, err := ic.preflightPorts()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{4503, 14503}, ports)
+	assert.True(t, conn.executed("test -d /data/aemc/aem/home/var/instance/local_publish"))
+}
+
+func TestJavaMajorVersion(t *testing.T) {
+	tests := map[string]string{
+		`openjdk version "1.8.0_392"`:             "8",
+		`java version "1.8.0_202"`:                "8",
+		`openjdk version "11.0.21" 2023-10-17`:    "11",
+		`openjdk version "17" 2021-09-14`:         "17",
+		`openjdk version "21.0.1" 2023-10-17 LTS`: "21",
+		`sh: java: command not found`:             "",
+		``:                                        "",
+	}
+	for versionLine, expected := range tests {
+		assert.Equal(t, expected, javaMajorVersion(versionLine), versionLine)
+	}
+}
+
+func TestPreflightProblems(t *testing.T) {
+	facts := map[string]string{
+		"DISK_FREE_KB": "52428800",
+		"JAVA_VERSION": `openjdk version "11.0.21" 2023-10-17`,
+		"SYSTEMCTL":    "yes",
+		"SUDO":         "yes",
+		"CURL":         "yes",
+		"DOWNLOAD":     "yes",
+		"PORT_4502":    "free",
+		"PORT_14502":   "free",
+	}
+	preflight := &Preflight{MinDiskSpace: 50, JavaVersion: "11", Ports: true}
+
+	t.Run("passed", func(t *testing.T) {
+		assert.Empty(t, preflightProblems(preflight, facts, []int{4502, 14502}, true))
+	})
+	t.Run("failed", func(t *testing.T) {
+		problems := preflightProblems(preflight, map[string]string{
+			"DISK_FREE_KB": "10485760",
+			"JAVA_VERSION": `openjdk version "1.8.0_392"`,
+			"SYSTEMCTL":    "no",
+			"SUDO":         "no",
+			"CURL":         "yes",
+			"DOWNLOAD":     "no",
+			"PORT_4502":    "busy",
+		}, []int{4502, 14502}, true)
+
+		assert.Equal(t, []string{
+			"free disk space in data directory is 10.0 GB (required 50 GB)",
+			"Java runtime version is 8 (required 11)",
+			"command 'systemctl' is not available",
+			"command 'sudo' is not available to the connecting user without password",
+			"AEM Compose CLI wrapper cannot be downloaded from '" + ComposeWrapperURL + "'",
+			"port 4502 is already in use",
+			"port 14502 is already in use",
+		}, problems)
+	})
+	t.Run("missing tools", func(t *testing.T) {
+		problems := preflightProblems(&Preflight{JavaVersion: "11"}, map[string]string{"JAVA_VERSION": "sh: java: command not found", "SYSTEMCTL": "yes", "SUDO": "yes"}, nil, true)
+
+		assert.Equal(t, []string{
+			"Java runtime is not available (required version 11)",
+			"command 'curl' is not available",
+		}, problems)
+	})
+	t.Run("optional checks disabled", func(t *testing.T) {
+		assert.Empty(t, preflightProblems(&Preflight{}, map[string]string{"SYSTEMCTL": "yes", "SUDO": "yes", "CURL": "yes"}, nil, false))
+	})
+}
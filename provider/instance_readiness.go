@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+	"strings"
+	"time"
+)
+
+const (
+	ReadinessCreated   = "created"
+	ReadinessRunning   = "running"
+	ReadinessReachable = "reachable"
+	ReadinessUpToDate  = "up-to-date"
+	ReadinessHealthy   = "healthy"
+)
+
+var ReadinessAttributes = []string{ReadinessCreated, ReadinessRunning, ReadinessReachable, ReadinessUpToDate, ReadinessHealthy}
+
+const (
+	ReadinessFail = "fail"
+	ReadinessWarn = "warn"
+)
+
+var ReadinessPolicies = []string{ReadinessFail, ReadinessWarn}
+
+// awaitReadiness polls the status of the instances until they meet the readiness conditions.
+// When they do not become ready in time, the operation fails or only a warning is logged depending on the policy.
+func (ic *InstanceClient) awaitReadiness() (InstanceStatus, error) {
+	readiness := ic.data.Compose.Readiness
+	if readiness == nil {
+		return ic.ReadStatus()
+	}
+	timeout := cast.ToDuration(readiness.Timeout)
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := ic.ReadStatus()
+		if err != nil {
+			return status, err
+		}
+		problems, err := ic.readinessProblems(status)
+		if err != nil {
+			return status, err
+		}
+		if len(problems) == 0 {
+			ic.ctx.Log(diag.Info, "AEM instances are ready")
+			return status, nil
+		}
+		if time.Now().After(deadline) {
+			err := fmt.Errorf("AEM instances are not ready within %s:\n%s", timeout, strings.Join(problems, "\n"))
+			if readiness.Policy == ReadinessWarn {
+				ic.ctx.Log(diag.Warning, err.Error())
+				return status, nil
+			}
+			return status, err
+		}
+		ic.ctx.Logf(diag.Info, "Awaiting AEM instances readiness (%s)", strings.Join(problems, ", "))
+		time.Sleep(cast.ToDuration(readiness.Interval))
+	}
+}
+
+func (ic *InstanceClient) readinessProblems(status InstanceStatus) ([]string, error) {
+	readiness := ic.data.Compose.Readiness
+	configYAML, err := ic.configYAML()
+	if err != nil {
+		return nil, err
+	}
+	models, err := status.instanceModels(configYAML)
+	if err != nil {
+		return nil, err
+	}
+	var problems []string
+	for _, model := range models {
+		met := map[string]bool{
+			ReadinessCreated:   model.Created,
+			ReadinessRunning:   model.Running,
+			ReadinessReachable: model.Reachable,
+			ReadinessUpToDate:  model.UpToDate,
+			ReadinessHealthy:   len(model.HealthChecks) == 0,
+		}
+		for _, attribute := range readiness.Attributes {
+			if !met[attribute] {
+				problems = append(problems, fmt.Sprintf("instance '%s' is not %s", model.ID, attribute))
+			}
+		}
+	}
+	if len(readiness.Probes) == 0 || len(problems) > 0 {
+		return problems, nil
+	}
+	tc := ic.target(ic.data.Compose)
+	instances, err := tc.instances()
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if !slices.ContainsFunc(models, func(m InstanceModel) bool { return m.ID == instance.ID }) {
+			continue
+		}
+		for _, probe := range readiness.Probes {
+			response, err := tc.request(instance, "GET", probe.Path, nil)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("instance '%s' probe '%s' failed: %s", instance.ID, probe.Path, err))
+			} else if response.Status != probe.Status {
+				problems = append(problems, fmt.Sprintf("instance '%s' probe '%s' responded with status %d instead of %d", instance.ID, probe.Path, response.Status, probe.Status))
+			}
+		}
+	}
+	return problems, nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+	"gopkg.in/yaml.v3"
+)
+
+func readinessStatus(t *testing.T, statusYAML string) InstanceStatus {
+	var status InstanceStatus
+	require.NoError(t, yaml.Unmarshal([]byte(statusYAML), &status))
+	return status
+}
+
+func TestReadinessProblemsAttributes(t *testing.T) {
+	cl, conn := newFakeClient(nil)
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{Compose: &Compose{Config: instance.ConfigYML, Readiness: &Readiness{
+		Attributes: []string{ReadinessRunning, ReadinessUpToDate, ReadinessHealthy},
+		Probes:     []ReadinessProbe{{Path: "/libs/granite/core/content/login.html", Status: 200}},
+	}}}}
+	status := readinessStatus(t, `data:
+  instances:
+    - id: local_author
+      attributes: [created, running, out-of-date]
+      health_checks: ["bundle 'com.acme.core' is not active"]
+    - id: local_publish
+      attributes: [created, running, up-to-date]
+`)
+
+	problems, err := ic.readinessProblems(status)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"instance 'local_author' is not up-to-date", "instance 'local_author' is not healthy"}, problems)
+	assert.Empty(t, conn.commands, "probes are not performed until attributes are met")
+}
+
+func TestReadinessProblemsProbes(t *testing.T) {
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		if strings.Contains(script, "http://127.0.0.1:4503/") {
+			return "Service Unavailable\n503", nil
+		}
+		return "<html></html>\n200", nil
+	})
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: instance.ConfigYML, Readiness: &Readiness{
+		Attributes: []string{ReadinessRunning},
+		Probes:     []ReadinessProbe{{Path: "/libs/granite/core/content/login.html", Status: 200}},
+	}}}}
+	status := readinessStatus(t, `data:
+  instances:
+    - id: local_author
+      attributes: [created, running]
+    - id: local_publish
+      attributes: [created, running]
+`)
+
+	problems, err := ic.readinessProblems(status)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"instance 'local_publish' probe '/libs/granite/core/content/login.html' responded with status 503 instead of 200"}, problems)
+}
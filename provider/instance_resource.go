@@ -5,7 +5,6 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/spf13/cast"
 	"github.com/wttech/pulumi-aem/provider/client"
-	"golang.org/x/exp/maps"
 	"time"
 )
 
@@ -19,21 +18,22 @@ type InstanceResource struct {
 	clientManager *client.ClientManager
 }
 
-func (r *InstanceResource) Create(ctx p.Context, model InstanceArgs) (*InstanceStatus, error) {
-	return r.createOrUpdate(ctx, model, true)
+func (r *InstanceResource) Create(ctx p.Context, model InstanceArgs) (*InstanceStatus, []BackupModel, error) {
+	return r.createOrUpdate(ctx, nil, model)
 }
 
-func (r *InstanceResource) Update(ctx p.Context, model InstanceArgs) (*InstanceStatus, error) {
-	return r.createOrUpdate(ctx, model, false)
+func (r *InstanceResource) Update(ctx p.Context, olds InstanceArgs, model InstanceArgs) (*InstanceStatus, []BackupModel, error) {
+	return r.createOrUpdate(ctx, &olds, model)
 }
 
-func (r *InstanceResource) createOrUpdate(ctx p.Context, model InstanceArgs, create bool) (*InstanceStatus, error) {
+func (r *InstanceResource) createOrUpdate(ctx p.Context, olds *InstanceArgs, model InstanceArgs) (*InstanceStatus, []BackupModel, error) {
 	ctx.Log(diag.Info, "Started setting up AEM instance resource")
+	create := olds == nil
 
 	ic, err := r.client(ctx, model, cast.ToDuration(model.Client.ActionTimeout))
 	if err != nil {
 		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
-		return nil, err
+		return nil, nil, err
 	}
 	defer func(ic *InstanceClient) {
 		err := ic.Close()
@@ -45,49 +45,84 @@ func (r *InstanceResource) createOrUpdate(ctx p.Context, model InstanceArgs, cre
 	if create {
 		if err := ic.bootstrap(); err != nil {
 			ctx.Logf(diag.Error, "Unable to bootstrap AEM instance machine %s", err)
-			return nil, err
+			return nil, nil, err
+		}
+		if err := ic.preflight(); err != nil {
+			ctx.Logf(diag.Error, "Unable to pass AEM instance preflight checks %s", err)
+			return nil, nil, err
 		}
 	}
 	if err := ic.copyFiles(); err != nil {
 		ctx.Logf(diag.Error, "Unable to copy AEM instance files %s", err)
-		return nil, err
+		return nil, nil, err
 	}
 	if err := ic.prepareWorkDir(); err != nil {
 		ctx.Logf(diag.Error, "Unable to prepare AEM work directory %s", err)
-		return nil, err
+		return nil, nil, err
 	}
 	if err := ic.prepareDataDir(); err != nil {
 		ctx.Logf(diag.Error, "Unable to prepare AEM data directory %s", err)
-		return nil, err
+		return nil, nil, err
+	}
+	if err := ic.saveBackupScript(); err != nil {
+		ctx.Logf(diag.Error, "Unable to save AEM backup script %s", err)
+		return nil, nil, err
+	}
+	if !create && model.Compose.Backup != nil && model.Compose.Backup.BeforeUpdate {
+		if err := ic.backup(); err != nil {
+			ctx.Logf(diag.Error, "Unable to back up AEM instance %s", err)
+			return nil, nil, err
+		}
 	}
 	if err := ic.installComposeCLI(); err != nil {
 		ctx.Logf(diag.Error, "Unable to install AEM Compose CLI %s", err)
-		return nil, err
+		return nil, nil, err
+	}
+	if !create {
+		if err := ic.rotatePasswords(olds.Compose, model.Compose); err != nil {
+			ctx.Logf(diag.Error, "Unable to rotate AEM instance passwords %s", err)
+			return nil, nil, err
+		}
 	}
 	if err := ic.writeConfigFile(); err != nil {
 		ctx.Logf(diag.Error, "Unable to write AEM configuration file %s", err)
-		return nil, err
+		if !create {
+			if err := ic.rotatePasswords(model.Compose, olds.Compose); err != nil {
+				ctx.Logf(diag.Warning, "Unable to restore AEM instance passwords %s", err)
+			}
+		}
+		return nil, nil, err
 	}
 	if create {
 		if err := ic.create(); err != nil {
 			ctx.Logf(diag.Error, "Unable to create AEM instance %s", err)
-			return nil, err
+			return nil, nil, ic.diagnose(err)
 		}
 	}
 	if err := ic.launch(); err != nil {
 		ctx.Logf(diag.Error, "Unable to launch AEM instance %s", err)
-		return nil, err
+		return nil, nil, ic.diagnose(err)
+	}
+	if err := ic.configureSchedules(); err != nil {
+		ctx.Logf(diag.Error, "Unable to configure AEM scheduled tasks %s", err)
+		return nil, nil, err
+	}
+
+	status, err := ic.awaitReadiness()
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to await AEM instance readiness %s", err)
+		return nil, nil, ic.diagnose(err)
 	}
 
 	ctx.Log(diag.Info, "Finished setting up AEM instance resource")
 
-	status, err := ic.ReadStatus()
+	backups, err := ic.ReadBackups()
 	if err != nil {
-		ctx.Logf(diag.Error, "Unable to read AEM instance status %s", err)
-		return nil, err
+		ctx.Logf(diag.Error, "Unable to read AEM instance backups %s", err)
+		return nil, nil, err
 	}
 
-	return &status, nil
+	return &status, backups, nil
 }
 
 func (r *InstanceResource) Delete(ctx p.Context, model InstanceArgs) error {
@@ -105,6 +140,22 @@ func (r *InstanceResource) Delete(ctx p.Context, model InstanceArgs) error {
 		}
 	}(ic)
 
+	if model.Compose.Backup != nil && model.Compose.Backup.BeforeDelete {
+		if err := ic.saveBackupScript(); err != nil {
+			ctx.Logf(diag.Error, "Unable to save AEM backup script %s", err)
+			return err
+		}
+		if err := ic.backup(); err != nil {
+			ctx.Logf(diag.Error, "Unable to back up AEM instance %s", err)
+			return err
+		}
+	}
+
+	if err := ic.deleteSchedules(); err != nil {
+		ctx.Logf(diag.Error, "Unable to delete AEM scheduled tasks %s", err)
+		return err
+	}
+
 	if err := ic.terminate(); err != nil {
 		ctx.Logf(diag.Error, "Unable to terminate AEM instance %s", err)
 		return err
@@ -119,37 +170,45 @@ func (r *InstanceResource) Delete(ctx p.Context, model InstanceArgs) error {
 	return nil
 }
 
-func (r *InstanceResource) client(ctx p.Context, model InstanceArgs, timeout time.Duration) (*InstanceClient, error) {
-	typeName := model.Client.Type
-	ctx.Logf(diag.Info, "Connecting to AEM instance machine using %s", typeName)
-
-	cl, err := r.clientManager.Make(typeName, r.clientSettings(model))
+// Read refreshes the status of the instances. When importing, the configuration of the machine is read as well.
+func (r *InstanceResource) Read(ctx p.Context, model InstanceArgs, importing bool) (InstanceArgs, *InstanceStatus, []BackupModel, error) {
+	ic, err := r.client(ctx, model, cast.ToDuration(model.Client.StateTimeout))
 	if err != nil {
-		return nil, err
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return model, nil, nil, err
 	}
+	defer func(ic *InstanceClient) {
+		err := ic.Close()
+		if err != nil {
+			ctx.Logf(diag.Warning, "Unable to disconnect from AEM instance %s", err)
+		}
+	}(ic)
 
-	if err := cl.ConnectWithRetry(timeout, func() { ctx.Log(diag.Info, "Awaiting connection to AEM instance machine") }); err != nil {
-		return nil, err
+	if importing {
+		model, err = ic.readImportedArgs()
+		if err != nil {
+			ctx.Logf(diag.Error, "Unable to read AEM instance configuration %s", err)
+			return model, nil, nil, err
+		}
+		ic.data = model
 	}
+	status, err := ic.ReadStatus()
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to read AEM instance status %s", err)
+		return model, nil, nil, err
+	}
+	backups, err := ic.ReadBackups()
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to read AEM instance backups %s", err)
+		return model, nil, nil, err
+	}
+	return model, &status, backups, nil
+}
 
-	cl.Env["AEM_CLI_VERSION"] = model.Compose.Version
-	cl.Env["AEM_OUTPUT_LOG_MODE"] = "both"
-	cl.WorkDir = model.System.WorkDir
-
-	if err := cl.SetupEnv(); err != nil {
+func (r *InstanceResource) client(ctx p.Context, model InstanceArgs, timeout time.Duration) (*InstanceClient, error) {
+	cl, err := connectClient(ctx, r.clientManager, model.Client, model.System, model.Compose, timeout)
+	if err != nil {
 		return nil, err
 	}
-
-	ctx.Logf(diag.Info, "Connected to AEM instance machine using %s", cl.Connection().Info())
 	return &InstanceClient{cl, ctx, model}, nil
 }
-
-func (r *InstanceResource) clientSettings(model InstanceArgs) map[string]string {
-	settings := model.Client.Settings
-	credentials := model.Client.Credentials
-
-	combined := map[string]string{}
-	maps.Copy(combined, credentials)
-	maps.Copy(combined, settings)
-	return combined
-}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+func TestConfigureSchedules(t *testing.T) {
+	cl, conn := newFakeClient(func(cmd string, script string) (string, error) {
+		if strings.HasPrefix(cmd, "ls /etc/systemd/system/") {
+			return "aem-schedule-gc.timer\naem-schedule-obsolete.timer\n", nil
+		}
+		return "", nil
+	})
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{
+		System: &System{DataDir: "/mnt/aemc", User: "aem", Schedules: []Schedule{
+			{Name: "gc", OnCalendar: "Sun *-*-* 02:00:00", Builtin: ScheduleBuiltinDatastoreGC},
+		}},
+		Compose: &Compose{Config: instance.ConfigYML},
+	}}
+
+	require.NoError(t, ic.configureSchedules())
+
+	script := conn.files["/mnt/aemc/provider/schedule/gc.sh"]
+	assert.Contains(t, script, "http://127.0.0.1:4502")
+	assert.Contains(t, script, "http://127.0.0.1:4503")
+	assert.True(t, conn.executed("chown 'aem' /mnt/aemc/provider/schedule/gc.sh && chmod 600 /mnt/aemc/provider/schedule/gc.sh"))
+
+	service := conn.files["/etc/systemd/system/aem-schedule-gc.service"]
+	assert.Contains(t, service, "User=aem")
+	assert.Contains(t, service, `ExecStart=/bin/sh -c ". /etc/profile && cd /mnt/aemc && sh /mnt/aemc/provider/schedule/gc.sh"`)
+	assert.Contains(t, conn.files["/etc/systemd/system/aem-schedule-gc.timer"], "OnCalendar=Sun *-*-* 02:00:00")
+	assert.True(t, conn.executed("systemctl enable --now aem-schedule-gc.timer"))
+
+	assert.True(t, conn.executed("systemctl disable --now aem-schedule-obsolete.timer"))
+	assert.False(t, conn.executed("systemctl disable --now aem-schedule-gc.timer"))
+}
+
+func TestDeleteSchedules(t *testing.T) {
+	cl, conn := newFakeClient(func(cmd string, script string) (string, error) {
+		if strings.HasPrefix(cmd, "ls /etc/systemd/system/") {
+			return "aem-schedule-backup.timer\n", nil
+		}
+		return "", nil
+	})
+	ic := &InstanceClient{cl, newTestContext(t), InstanceArgs{System: &System{DataDir: "/mnt/aemc"}}}
+
+	require.NoError(t, ic.deleteSchedules())
+
+	assert.True(t, conn.executed("systemctl disable --now aem-schedule-backup.timer"))
+	assert.True(t, conn.executed("rm -rf /etc/systemd/system/aem-schedule-backup.timer /etc/systemd/system/aem-schedule-backup.service /mnt/aemc/provider/schedule/backup.sh"))
+}
+
+func TestInstanceScriptCredentials(t *testing.T) {
+	config := `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+      user: admin
+      password: it's "secret" \o/
+`
+	ic := &InstanceClient{nil, newTestContext(t), InstanceArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: config}}}
+
+	script, err := ic.instanceScript(instance.DatastoreGCScript)
+
+	require.NoError(t, err)
+	assert.NotContains(t, script, " -u ")
+	assert.Contains(t, script, "curl -sf -K - -X POST -d 'markOnly=false' 'http://127.0.0.1:4502''/system/console/jmx/")
+	assert.Contains(t, script, "<<'CURL_CONFIG'\nuser = \"admin:it's \\\"secret\\\" \\\\o/\"\nCURL_CONFIG\n")
+}
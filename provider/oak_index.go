@@ -0,0 +1,195 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+	"reflect"
+	"regexp"
+)
+
+var OakIndexTypes = []string{"lucene", "property"}
+
+var oakIndexNameRegex = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+
+type OakIndex struct{}
+
+type OakIndexArgs struct {
+	TargetArgs
+	Name           string         `pulumi:"name,optional" provider:"replaceOnChanges"`
+	Type           string         `pulumi:"type,optional"`
+	Async          []string       `pulumi:"async,optional"`
+	IncludedPaths  []string       `pulumi:"included_paths,optional"`
+	Properties     map[string]any `pulumi:"properties,optional"`
+	Children       map[string]any `pulumi:"children,optional"`
+	ReindexTimeout string         `pulumi:"reindex_timeout,optional"`
+}
+
+func (m *OakIndexArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Name, "Name of the index definition node under '/oak:index'. By default, the resource name is used.")
+	a.Describe(&m.Type, "Type of the index. Possible values are 'lucene' and 'property'.")
+	a.Describe(&m.Async, "Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.")
+	a.Describe(&m.IncludedPaths, "Repository paths covered by the index.")
+	a.Describe(&m.Properties, "Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').")
+	a.Describe(&m.Children, "Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').")
+	a.Describe(&m.ReindexTimeout, "Maximum time to wait for reindexing to complete after the definition is changed.")
+}
+
+type OakIndexInstanceModel struct {
+	ID           string `pulumi:"id"`
+	Exists       bool   `pulumi:"exists"`
+	Reindexing   bool   `pulumi:"reindexing"`
+	ReindexCount int    `pulumi:"reindex_count"`
+}
+
+func (m *OakIndexInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Exists, "Indicates if the index definition exists on the AEM instance.")
+	a.Describe(&m.Reindexing, "Indicates if the index is being rebuilt on the AEM instance.")
+	a.Describe(&m.ReindexCount, "Number of times the index was rebuilt on the AEM instance.")
+}
+
+type OakIndexState struct {
+	OakIndexArgs
+	Path      string                  `pulumi:"path"`
+	Instances []OakIndexInstanceModel `pulumi:"instances"`
+}
+
+func (m *OakIndexState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Path, "Repository path of the index definition.")
+	a.Describe(&m.Instances, "Current state of the index on the target AEM instances.")
+}
+
+func (m *OakIndex) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Oak index definition saved on the AEM instances. Reindexing is triggered when the definition changes and awaited before the operation completes. Removed from the instances when the resource is deleted.")
+}
+
+func (OakIndex) Create(ctx p.Context, name string, input OakIndexArgs, preview bool) (string, OakIndexState, error) {
+	state := OakIndexState{OakIndexArgs: input, Path: oakIndexPath(input)}
+	if preview {
+		return name, state, nil
+	}
+	oc, err := connectOakIndex(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer oc.Close()
+
+	if err := oc.save(nil); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = oc.awaitReindex()
+	return name, state, err
+}
+
+func (OakIndex) Update(ctx p.Context, id string, olds OakIndexState, news OakIndexArgs, preview bool) (OakIndexState, error) {
+	state := OakIndexState{OakIndexArgs: news, Path: olds.Path, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	oc, err := connectOakIndex(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer oc.Close()
+
+	if err := oc.deleteFromRemovedInstances(olds); err != nil {
+		return state, err
+	}
+	if oakIndexDefinitionChanged(olds.OakIndexArgs, news) {
+		if err := oc.save(&olds.OakIndexArgs); err != nil {
+			return state, err
+		}
+		state.Instances, err = oc.awaitReindex()
+	} else {
+		state.Instances, err = oc.readInstances()
+	}
+	return state, err
+}
+
+func (OakIndex) Delete(ctx p.Context, id string, props OakIndexState) error {
+	oc, err := connectOakIndex(ctx, props.OakIndexArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer oc.Close()
+
+	instances, err := oc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := oc.node(nil).deleteNode(instance, props.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (OakIndex) Read(ctx p.Context, id string, inputs OakIndexArgs, state OakIndexState) (string, OakIndexArgs, OakIndexState, error) {
+	oc, err := connectOakIndex(ctx, state.OakIndexArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer oc.Close()
+
+	state.Instances, err = oc.readInstances()
+	if err != nil {
+		return id, inputs, state, err
+	}
+	for _, instance := range state.Instances {
+		if !instance.Exists {
+			ctx.Logf(diag.Warning, "Index definition '%s' does not exist on instance '%s'", state.Path, instance.ID)
+		} else if instance.Reindexing {
+			ctx.Logf(diag.Warning, "Index '%s' is being rebuilt on instance '%s'", state.Path, instance.ID)
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (OakIndex) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (OakIndexArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "name", resource.NewStringProperty(name))
+	setDefaultValue(newInputs, "type", resource.NewStringProperty("lucene"))
+	setDefaultValue(newInputs, "async", resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("async")}))
+	setDefaultValue(newInputs, "reindex_timeout", resource.NewStringProperty("30m"))
+
+	args, failures, err := infer.DefaultCheck[OakIndexArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs["name"].IsString() && !oakIndexNameRegex.MatchString(args.Name) {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name must contain only letters, digits and characters '_.:-'"})
+	}
+	if newInputs["type"].IsString() && !slices.Contains(OakIndexTypes, args.Type) {
+		failures = append(failures, p.CheckFailure{Property: "type", Reason: "unknown type '" + args.Type + "' (expected 'lucene' or 'property')"})
+	}
+	failures = append(failures, validateDuration("reindex_timeout", args.ReindexTimeout)...)
+	return args, failures, nil
+}
+
+func oakIndexPath(model OakIndexArgs) string {
+	return "/oak:index/" + model.Name
+}
+
+// oakIndexDefinitionChanged compares only the options stored in the index definition, so that e.g. changing timeouts does not cause reindexing.
+func oakIndexDefinitionChanged(olds OakIndexArgs, news OakIndexArgs) bool {
+	return olds.Type != news.Type ||
+		!reflect.DeepEqual(olds.Async, news.Async) ||
+		!reflect.DeepEqual(olds.IncludedPaths, news.IncludedPaths) ||
+		!reflect.DeepEqual(olds.Properties, news.Properties) ||
+		!reflect.DeepEqual(olds.Children, news.Children)
+}
+
+func connectOakIndex(ctx p.Context, model OakIndexArgs, timeout string) (*OakIndexClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &OakIndexClient{tc.cl, ctx, model}, nil
+}
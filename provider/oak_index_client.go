@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"time"
+)
+
+// oakIndexPollInterval is the delay between subsequent checks of the reindexing status.
+const oakIndexPollInterval = 5 * time.Second
+
+type OakIndexClient ClientContext[OakIndexArgs]
+
+func (oc *OakIndexClient) Close() error {
+	return oc.cl.Disconnect()
+}
+
+func (oc *OakIndexClient) target() *TargetClient {
+	return &TargetClient{oc.cl, oc.ctx, oc.data.TargetArgs}
+}
+
+// node renders the index definition as the repository node so that it could be saved the same way as 'RepoNode' resource.
+func (oc *OakIndexClient) node(model *OakIndexArgs) *RepoNodeClient {
+	if model == nil {
+		model = &oc.data
+	}
+	properties := map[string]any{
+		"jcr:primaryType": "oak:QueryIndexDefinition",
+		"type":            model.Type,
+	}
+	if len(model.Async) > 0 {
+		properties["async"] = model.Async
+	}
+	if len(model.IncludedPaths) > 0 {
+		properties["includedPaths"] = model.IncludedPaths
+	}
+	maps.Copy(properties, model.Properties)
+	return &RepoNodeClient{oc.cl, oc.ctx, RepoNodeArgs{
+		TargetArgs: model.TargetArgs,
+		Path:       oakIndexPath(*model),
+		Mode:       RepoNodeMerge,
+		Properties: properties,
+		Children:   model.Children,
+	}}
+}
+
+// save writes the index definition and requests reindexing.
+func (oc *OakIndexClient) save(olds *OakIndexArgs) error {
+	node := oc.node(nil)
+	node.data.Properties["reindex"] = true
+	var oldNode *RepoNodeArgs
+	if olds != nil {
+		oldNode = &oc.node(olds).data
+	}
+	oc.ctx.Logf(diag.Info, "Saving index definition '%s' and requesting reindexing", node.data.Path)
+	return node.save(oldNode)
+}
+
+func (oc *OakIndexClient) deleteFromRemovedInstances(olds OakIndexState) error {
+	removedInstances, err := oc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := oc.node(nil).deleteNode(instance, olds.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// awaitReindex polls the index definitions until the 'reindex' flag is reset by Oak on all instances.
+func (oc *OakIndexClient) awaitReindex() ([]OakIndexInstanceModel, error) {
+	timeout := cast.ToDuration(oc.data.ReindexTimeout)
+	deadline := time.Now().Add(timeout)
+	for {
+		instances, err := oc.readInstances()
+		if err != nil {
+			return nil, err
+		}
+		pending := slices.ContainsFunc(instances, func(m OakIndexInstanceModel) bool { return m.Reindexing })
+		if !pending {
+			oc.ctx.Logf(diag.Info, "Reindexing of '%s' completed", oakIndexPath(oc.data))
+			return instances, nil
+		}
+		if time.Now().After(deadline) {
+			return instances, fmt.Errorf("reindexing of '%s' has not completed within %s", oakIndexPath(oc.data), timeout)
+		}
+		oc.ctx.Logf(diag.Info, "Awaiting reindexing of '%s'", oakIndexPath(oc.data))
+		time.Sleep(oakIndexPollInterval)
+	}
+}
+
+func (oc *OakIndexClient) readInstances() ([]OakIndexInstanceModel, error) {
+	instances, err := oc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	path := oakIndexPath(oc.data)
+	var result []OakIndexInstanceModel
+	for _, instance := range instances {
+		model := OakIndexInstanceModel{ID: instance.ID}
+		response, err := oc.target().request(instance, "GET", path+".json", nil)
+		if err != nil {
+			return nil, err
+		}
+		switch response.Status {
+		case 200:
+			var props map[string]any
+			if err := json.Unmarshal(response.Body, &props); err != nil {
+				return nil, fmt.Errorf("unable to parse index definition '%s' on instance '%s': %w", path, instance.ID, err)
+			}
+			model.Exists = true
+			model.Reindexing = cast.ToBool(props["reindex"])
+			model.ReindexCount = cast.ToInt(props["reindexCount"])
+		case 404:
+			// index definition does not exist
+		default:
+			return nil, fmt.Errorf("unable to read index definition '%s' on instance '%s' (HTTP status %d)", path, instance.ID, response.Status)
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
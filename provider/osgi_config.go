@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+)
+
+type OsgiConfig struct{}
+
+type OsgiConfigArgs struct {
+	TargetArgs
+	PID        string         `pulumi:"pid,optional" provider:"replaceOnChanges"`
+	FactoryPID string         `pulumi:"factory_pid,optional" provider:"replaceOnChanges"`
+	Name       string         `pulumi:"name,optional" provider:"replaceOnChanges"`
+	Properties map[string]any `pulumi:"properties"`
+}
+
+func (m *OsgiConfigArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.PID, "Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.")
+	a.Describe(&m.FactoryPID, "Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.")
+	a.Describe(&m.Name, "Name of the factory OSGi configuration instance. By default, the resource name is used.")
+	a.Describe(&m.Properties, "Properties of the OSGi configuration.")
+}
+
+type OsgiConfigInstanceModel struct {
+	ID         string         `pulumi:"id"`
+	Properties map[string]any `pulumi:"properties"`
+}
+
+func (m *OsgiConfigInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Properties, "Properties of the OSGi configuration read from the AEM instance.")
+}
+
+type OsgiConfigState struct {
+	OsgiConfigArgs
+	EffectivePID string                    `pulumi:"effective_pid"`
+	Instances    []OsgiConfigInstanceModel `pulumi:"instances"`
+}
+
+func (m *OsgiConfigState) Annotate(a infer.Annotator) {
+	a.Describe(&m.EffectivePID, "Persistent identifier under which the OSGi configuration is saved (for factory configurations in format 'factory_pid~name').")
+	a.Describe(&m.Instances, "Current state of the OSGi configuration on the target AEM instances.")
+}
+
+func (m *OsgiConfig) Annotate(a infer.Annotator) {
+	a.Describe(&m, "OSGi configuration saved on the AEM instances. Deleted from the instances when the resource is deleted.")
+}
+
+func (OsgiConfig) Create(ctx p.Context, name string, input OsgiConfigArgs, preview bool) (string, OsgiConfigState, error) {
+	state := OsgiConfigState{OsgiConfigArgs: input, EffectivePID: osgiConfigPID(name, input)}
+	if preview {
+		return name, state, nil
+	}
+	oc, err := connectOsgiConfig(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer oc.Close()
+
+	if err := oc.save(state.EffectivePID, false); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = oc.readInstances(state.EffectivePID)
+	return name, state, err
+}
+
+func (OsgiConfig) Update(ctx p.Context, id string, olds OsgiConfigState, news OsgiConfigArgs, preview bool) (OsgiConfigState, error) {
+	state := OsgiConfigState{OsgiConfigArgs: news, EffectivePID: olds.EffectivePID, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	oc, err := connectOsgiConfig(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer oc.Close()
+
+	if err := oc.deleteFromRemovedInstances(olds); err != nil {
+		return state, err
+	}
+	propertiesRemoved := false
+	for key := range olds.Properties {
+		if _, ok := news.Properties[key]; !ok {
+			propertiesRemoved = true
+		}
+	}
+	if err := oc.save(state.EffectivePID, propertiesRemoved); err != nil {
+		return state, err
+	}
+	state.Instances, err = oc.readInstances(state.EffectivePID)
+	return state, err
+}
+
+func (OsgiConfig) Delete(ctx p.Context, id string, props OsgiConfigState) error {
+	oc, err := connectOsgiConfig(ctx, props.OsgiConfigArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer oc.Close()
+
+	instances, err := oc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := oc.delete(instance, props.EffectivePID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (OsgiConfig) Read(ctx p.Context, id string, inputs OsgiConfigArgs, state OsgiConfigState) (string, OsgiConfigArgs, OsgiConfigState, error) {
+	oc, err := connectOsgiConfig(ctx, state.OsgiConfigArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer oc.Close()
+
+	state.Instances, err = oc.readInstances(state.EffectivePID)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores desired properties
+	for _, instance := range state.Instances {
+		if !propertiesEqual(state.Properties, instance.Properties) {
+			ctx.Logf(diag.Warning, "OSGi configuration '%s' on instance '%s' differs from the desired one", state.EffectivePID, instance.ID)
+			state.Properties = instance.Properties
+			break
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (OsgiConfig) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (OsgiConfigArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+
+	args, failures, err := infer.DefaultCheck[OsgiConfigArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs["pid"].IsString() == newInputs["factory_pid"].IsString() && !newInputs.ContainsUnknowns() {
+		failures = append(failures, p.CheckFailure{Property: "pid", Reason: "exactly one of 'pid' or 'factory_pid' is required"})
+	}
+	if args.Name != "" && args.PID != "" {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name could be set only for factory configurations"})
+	}
+	return args, failures, nil
+}
+
+func osgiConfigPID(name string, model OsgiConfigArgs) string {
+	if model.FactoryPID == "" {
+		return model.PID
+	}
+	if model.Name != "" {
+		name = model.Name
+	}
+	return fmt.Sprintf("%s~%s", model.FactoryPID, name)
+}
+
+func propertiesEqual(desired map[string]any, actual map[string]any) bool {
+	if len(desired) != len(actual) {
+		return false
+	}
+	for key, value := range desired {
+		actualValue, ok := actual[key]
+		if !ok || fmt.Sprint(value) != fmt.Sprint(actualValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func connectOsgiConfig(ctx p.Context, model OsgiConfigArgs, timeout string) (*OsgiConfigClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &OsgiConfigClient{tc.cl, ctx, model}, nil
+}
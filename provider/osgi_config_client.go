@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+	"sort"
+	"strings"
+)
+
+type OsgiConfigClient ClientContext[OsgiConfigArgs]
+
+func (oc *OsgiConfigClient) Close() error {
+	return oc.cl.Disconnect()
+}
+
+func (oc *OsgiConfigClient) target() *TargetClient {
+	return &TargetClient{oc.cl, oc.ctx, oc.data.TargetArgs}
+}
+
+func (oc *OsgiConfigClient) save(pid string, recreate bool) error {
+	instances, err := oc.target().instances()
+	if err != nil {
+		return err
+	}
+	propertiesYAML, err := yaml.Marshal(oc.data.Properties)
+	if err != nil {
+		return fmt.Errorf("unable to serialize properties of OSGi configuration '%s': %w", pid, err)
+	}
+	for _, instance := range instances {
+		if recreate {
+			if err := oc.delete(instance, pid); err != nil {
+				return err
+			}
+		}
+		oc.ctx.Logf(diag.Info, "Saving OSGi configuration '%s' on instance '%s'", pid, instance.ID)
+		out, err := oc.target().runAemw(instance.ID, "osgi", "config", "save", "--pid", pid, "--input-string", string(propertiesYAML))
+		if err != nil {
+			return fmt.Errorf("unable to save OSGi configuration '%s' on instance '%s': %w", pid, instance.ID, err)
+		}
+		oc.ctx.Log(diag.Info, string(out))
+	}
+	return nil
+}
+
+func (oc *OsgiConfigClient) delete(instance InstanceConfig, pid string) error {
+	oc.ctx.Logf(diag.Info, "Deleting OSGi configuration '%s' on instance '%s'", pid, instance.ID)
+	out, err := oc.target().runAemw(instance.ID, "osgi", "config", "delete", "--pid", pid)
+	if err != nil {
+		return fmt.Errorf("unable to delete OSGi configuration '%s' on instance '%s': %w", pid, instance.ID, err)
+	}
+	oc.ctx.Log(diag.Info, string(out))
+	return nil
+}
+
+func (oc *OsgiConfigClient) deleteFromRemovedInstances(olds OsgiConfigState) error {
+	removedInstances, err := oc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := oc.delete(instance, olds.EffectivePID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// osgiConfigPropertiesIgnored are set by OSGi framework itself, so they are not a part of the desired state.
+var osgiConfigPropertiesIgnored = []string{"service.pid", "service.factoryPid", "service.bundleLocation", ":org.apache.felix.configadmin.revision:"}
+
+func (oc *OsgiConfigClient) readInstances(pid string) ([]OsgiConfigInstanceModel, error) {
+	instances, err := oc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []OsgiConfigInstanceModel
+	for _, instance := range instances {
+		out, err := oc.target().runAemw(instance.ID, "osgi", "config", "read", "--pid", pid, "--output-format", "yaml")
+		if err != nil {
+			return nil, fmt.Errorf("unable to read OSGi configuration '%s' on instance '%s': %w", pid, instance.ID, err)
+		}
+		var data any
+		if err := yaml.Unmarshal(out, &data); err != nil {
+			return nil, fmt.Errorf("unable to parse OSGi configuration '%s' on instance '%s': %w", pid, instance.ID, err)
+		}
+		properties := map[string]any{}
+		if found, ok := findYAMLKey(data, "properties").(map[string]any); ok {
+			for key, value := range found {
+				if !slices.Contains(osgiConfigPropertiesIgnored, key) {
+					properties[key] = value
+				}
+			}
+		}
+		result = append(result, OsgiConfigInstanceModel{ID: instance.ID, Properties: properties})
+	}
+	return result, nil
+}
+
+// findYAMLKey looks up the value of the first key with the given name in the AEM Compose CLI output.
+func findYAMLKey(data any, key string) any {
+	switch value := data.(type) {
+	case map[string]any:
+		keys := maps.Keys(value)
+		sort.Strings(keys)
+		for _, k := range keys {
+			if strings.EqualFold(k, key) {
+				return value[k]
+			}
+		}
+		for _, k := range keys {
+			if found := findYAMLKey(value[k], key); found != nil {
+				return found
+			}
+		}
+	case []any:
+		for _, v := range value {
+			if found := findYAMLKey(v, key); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"io"
+	"os"
+)
+
+type Package struct{}
+
+type PackageArgs struct {
+	TargetArgs
+	File            string `pulumi:"file,optional"`
+	URL             string `pulumi:"url,optional"`
+	Maven           string `pulumi:"maven,optional"`
+	MavenRepository string `pulumi:"maven_repository,optional"`
+	Checksum        string `pulumi:"checksum,optional"`
+}
+
+func (m *PackageArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.File, "Local path of the AEM package file to be deployed.")
+	a.Describe(&m.URL, "URL of the AEM package file to be downloaded on the machine and deployed.")
+	a.Describe(&m.Maven, "Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').")
+	a.Describe(&m.MavenRepository, "URL of the Maven repository from which the AEM package is downloaded.")
+	a.Describe(&m.Checksum, "SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.")
+}
+
+type PackageInstanceModel struct {
+	ID           string `pulumi:"id"`
+	Installed    bool   `pulumi:"installed"`
+	LastUnpacked string `pulumi:"last_unpacked"`
+}
+
+func (m *PackageInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Installed, "Indicates if the package is installed on the AEM instance.")
+	a.Describe(&m.LastUnpacked, "Time at which the package was installed on the AEM instance.")
+}
+
+type PackageState struct {
+	PackageArgs
+	PID        string                 `pulumi:"pid"`
+	RemotePath string                 `pulumi:"remote_path"`
+	Instances  []PackageInstanceModel `pulumi:"instances"`
+}
+
+func (m *PackageState) Annotate(a infer.Annotator) {
+	a.Describe(&m.PID, "Identifier of the AEM package (in format 'group:name:version').")
+	a.Describe(&m.RemotePath, "Remote path of the AEM package file on the machine.")
+	a.Describe(&m.Instances, "Current state of the AEM package on the target AEM instances.")
+}
+
+func (m *Package) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Content package deployed to the AEM instances. Redeployed when the package file changes and uninstalled when the resource is deleted.")
+}
+
+func (Package) Create(ctx p.Context, name string, input PackageArgs, preview bool) (string, PackageState, error) {
+	state := PackageState{PackageArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	pc, err := connectPackage(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer pc.Close()
+
+	if err := pc.deploy(name, &state, true); err != nil {
+		return name, state, err
+	}
+	return name, state, nil
+}
+
+func (Package) Update(ctx p.Context, id string, olds PackageState, news PackageArgs, preview bool) (PackageState, error) {
+	state := PackageState{PackageArgs: news, PID: olds.PID, RemotePath: olds.RemotePath, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	pc, err := connectPackage(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer pc.Close()
+
+	removedInstances, err := pc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return state, err
+	}
+	for _, instance := range removedInstances {
+		if err := pc.uninstall(instance, olds.PID); err != nil {
+			return state, err
+		}
+	}
+
+	changed := olds.File != news.File || olds.URL != news.URL || olds.Maven != news.Maven || olds.Checksum != news.Checksum
+	if err := pc.deploy(id, &state, changed); err != nil {
+		return state, err
+	}
+	return state, nil
+}
+
+func (Package) Delete(ctx p.Context, id string, props PackageState) error {
+	pc, err := connectPackage(ctx, props.PackageArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer pc.Close()
+
+	instances, err := pc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := pc.uninstall(instance, props.PID); err != nil {
+			return err
+		}
+	}
+	if err := pc.cl.PathDelete(pc.packageDir(id)); err != nil {
+		return fmt.Errorf("unable to delete AEM package directory: %w", err)
+	}
+	return nil
+}
+
+func (Package) Read(ctx p.Context, id string, inputs PackageArgs, state PackageState) (string, PackageArgs, PackageState, error) {
+	pc, err := connectPackage(ctx, state.PackageArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer pc.Close()
+
+	instances, err := pc.readInstances(state.PID)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	state.Instances = instances
+	return id, inputs, state, nil
+}
+
+func (Package) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (PackageArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "maven_repository", resource.NewStringProperty("https://repo1.maven.org/maven2"))
+
+	var failures []p.CheckFailure
+	sources := 0
+	for _, key := range []resource.PropertyKey{"file", "url", "maven"} {
+		if _, ok := newInputs[key]; ok {
+			sources++
+		}
+	}
+	if sources != 1 {
+		failures = append(failures, p.CheckFailure{Property: "file", Reason: "exactly one of 'file', 'url' or 'maven' is required"})
+	}
+	if newInputs["file"].IsString() && !newInputs.HasValue("checksum") {
+		checksum, err := fileChecksum(newInputs["file"].StringValue())
+		if err != nil {
+			failures = append(failures, p.CheckFailure{Property: "file", Reason: err.Error()})
+		} else {
+			newInputs["checksum"] = resource.NewStringProperty(checksum)
+		}
+	}
+	if len(failures) > 0 {
+		return PackageArgs{}, failures, nil
+	}
+	args, failures, err := infer.DefaultCheck[PackageArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	return args, validateTarget(newInputs, args.TargetArgs), nil
+}
+
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read file '%s': %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("cannot compute checksum of file '%s': %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func connectPackage(ctx p.Context, model PackageArgs, timeout string) (*PackageClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &PackageClient{tc.cl, ctx, model}, nil
+}
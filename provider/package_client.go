@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+type PackageClient ClientContext[PackageArgs]
+
+func (pc *PackageClient) Close() error {
+	return pc.cl.Disconnect()
+}
+
+func (pc *PackageClient) target() *TargetClient {
+	return &TargetClient{pc.cl, pc.ctx, pc.data.TargetArgs}
+}
+
+func (pc *PackageClient) packageDir(id string) string {
+	return fmt.Sprintf("%s/aem/home/var/package/%s", pc.target().dataDir(), id)
+}
+
+func (pc *PackageClient) fileName() (string, error) {
+	switch {
+	case pc.data.File != "":
+		return path.Base(pc.data.File), nil
+	case pc.data.URL != "":
+		u, err := url.Parse(pc.data.URL)
+		if err != nil {
+			return "", fmt.Errorf("invalid AEM package URL '%s': %w", pc.data.URL, err)
+		}
+		return path.Base(u.Path), nil
+	case pc.data.Maven != "":
+		_, fileName, err := pc.mavenPath()
+		return fileName, err
+	}
+	return "", fmt.Errorf("AEM package source is not specified")
+}
+
+// mavenPath resolves Maven coordinates 'group:artifact:version[:classifier]' to the repository path.
+func (pc *PackageClient) mavenPath() (string, string, error) {
+	parts := strings.Split(pc.data.Maven, ":")
+	if len(parts) < 3 || len(parts) > 4 {
+		return "", "", fmt.Errorf("invalid AEM package Maven coordinates '%s' (expected 'group:artifact:version[:classifier]')", pc.data.Maven)
+	}
+	group, artifact, version := parts[0], parts[1], parts[2]
+	fileName := fmt.Sprintf("%s-%s.zip", artifact, version)
+	if len(parts) == 4 {
+		fileName = fmt.Sprintf("%s-%s-%s.zip", artifact, version, parts[3])
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", strings.ReplaceAll(group, ".", "/"), artifact, version, fileName), fileName, nil
+}
+
+func (pc *PackageClient) provide(remotePath string) error {
+	switch {
+	case pc.data.File != "":
+		pc.ctx.Logf(diag.Info, "Copying AEM package '%s'", pc.data.File)
+		if err := pc.cl.FileCopy(pc.data.File, remotePath, true); err != nil {
+			return fmt.Errorf("unable to copy AEM package '%s': %w", pc.data.File, err)
+		}
+		return nil
+	case pc.data.URL != "":
+		return pc.target().download(pc.data.URL, remotePath)
+	default:
+		mavenPath, _, err := pc.mavenPath()
+		if err != nil {
+			return err
+		}
+		return pc.target().download(strings.TrimSuffix(pc.data.MavenRepository, "/")+"/"+mavenPath, remotePath)
+	}
+}
+
+type packageProperties struct {
+	Entries []struct {
+		Key   string `xml:"key,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"entry"`
+}
+
+// readPID determines the package identifier from its metadata stored in the ZIP file.
+func (pc *PackageClient) readPID(remotePath string) (string, error) {
+	out, err := pc.cl.RunShellPurely(fmt.Sprintf("unzip -p %s META-INF/vault/properties.xml", utils.ShellQuote(remotePath)))
+	if err != nil {
+		return "", fmt.Errorf("unable to read properties of AEM package '%s': %w", remotePath, err)
+	}
+	var props packageProperties
+	if err := xml.Unmarshal(out, &props); err != nil {
+		return "", fmt.Errorf("unable to parse properties of AEM package '%s': %w", remotePath, err)
+	}
+	values := map[string]string{}
+	for _, entry := range props.Entries {
+		values[entry.Key] = strings.TrimSpace(entry.Value)
+	}
+	return fmt.Sprintf("%s:%s:%s", values["group"], values["name"], values["version"]), nil
+}
+
+func (pc *PackageClient) deploy(id string, state *PackageState, changed bool) error {
+	instances, err := pc.target().instances()
+	if err != nil {
+		return err
+	}
+	fileName, err := pc.fileName()
+	if err != nil {
+		return err
+	}
+	remotePath := fmt.Sprintf("%s/%s", pc.packageDir(id), fileName)
+	if changed || state.RemotePath != remotePath {
+		if state.RemotePath != "" && state.RemotePath != remotePath {
+			_ = pc.cl.PathDelete(state.RemotePath)
+		}
+		if err := pc.provide(remotePath); err != nil {
+			return err
+		}
+	}
+	if err := pc.target().verifyChecksum(remotePath, pc.data.Checksum); err != nil {
+		return err
+	}
+	pid, err := pc.readPID(remotePath)
+	if err != nil {
+		return err
+	}
+	// package with other identifier is not replaced by the deployment, so the previous one is uninstalled first
+	if state.PID != "" && state.PID != pid {
+		previous, err := pc.readInstances(state.PID)
+		if err != nil {
+			return err
+		}
+		for i, instance := range instances {
+			if !previous[i].Installed {
+				continue
+			}
+			if err := pc.uninstall(instance, state.PID); err != nil {
+				return err
+			}
+		}
+	}
+	state.RemotePath = remotePath
+	state.PID = pid
+
+	current, err := pc.readInstances(pid)
+	if err != nil {
+		return err
+	}
+	for i, instance := range instances {
+		if !changed && current[i].Installed {
+			pc.ctx.Logf(diag.Info, "Skipping deployment of AEM package '%s' on instance '%s' (already installed)", pid, instance.ID)
+			continue
+		}
+		pc.ctx.Logf(diag.Info, "Deploying AEM package '%s' on instance '%s'", pid, instance.ID)
+		out, err := pc.target().runAemw(instance.ID, "package", "deploy", "--file", remotePath)
+		if err != nil {
+			return fmt.Errorf("unable to deploy AEM package '%s' on instance '%s': %w", pid, instance.ID, err)
+		}
+		pc.ctx.Log(diag.Info, string(out))
+	}
+
+	state.Instances, err = pc.readInstances(pid)
+	return err
+}
+
+func (pc *PackageClient) uninstall(instance InstanceConfig, pid string) error {
+	if pid == "" {
+		return nil
+	}
+	pc.ctx.Logf(diag.Info, "Uninstalling AEM package '%s' from instance '%s'", pid, instance.ID)
+	out, err := pc.target().runAemw(instance.ID, "package", "uninstall", "--pid", pid)
+	if err != nil {
+		return fmt.Errorf("unable to uninstall AEM package '%s' from instance '%s': %w", pid, instance.ID, err)
+	}
+	pc.ctx.Log(diag.Info, string(out))
+	return nil
+}
+
+// packagePath determines the JCR path under which the package is stored by CRX Package Manager.
+func packagePath(pid string) string {
+	parts := strings.SplitN(pid, ":", 3)
+	if len(parts) != 3 {
+		return ""
+	}
+	name := parts[1]
+	if parts[2] != "" {
+		name = fmt.Sprintf("%s-%s", parts[1], parts[2])
+	}
+	if parts[0] == "" {
+		return fmt.Sprintf("/etc/packages/%s.zip", name)
+	}
+	return fmt.Sprintf("/etc/packages/%s/%s.zip", parts[0], name)
+}
+
+type packageList struct {
+	Results []struct {
+		PID          string `json:"pid"`
+		LastUnpacked any    `json:"lastUnpacked"`
+	} `json:"results"`
+}
+
+func (pc *PackageClient) readInstances(pid string) ([]PackageInstanceModel, error) {
+	instances, err := pc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []PackageInstanceModel
+	for _, instance := range instances {
+		model := PackageInstanceModel{ID: instance.ID}
+		if pid != "" {
+			response, err := pc.target().request(instance, "GET", "/crx/packmgr/list.jsp?path="+url.QueryEscape(packagePath(pid)), nil)
+			if err != nil {
+				return nil, err
+			}
+			if response.Status != 200 {
+				return nil, fmt.Errorf("unable to read AEM package '%s' on instance '%s' (HTTP status %d)", pid, instance.ID, response.Status)
+			}
+			var list packageList
+			if err := json.Unmarshal(response.Body, &list); err != nil {
+				return nil, fmt.Errorf("unable to parse AEM package '%s' status on instance '%s': %w", pid, instance.ID, err)
+			}
+			for _, item := range list.Results {
+				if item.PID == pid && item.LastUnpacked != nil {
+					model.Installed = true
+					model.LastUnpacked = time.UnixMilli(cast.ToInt64(item.LastUnpacked)).UTC().Format(time.RFC3339)
+				}
+			}
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
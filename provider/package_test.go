@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePackageManager answers the package metadata and status requests, reporting the given packages as installed.
+func fakePackageManager(commands *[]string, installed ...string) func(cmd string, script string) (string, error) {
+	return func(cmd string, script string) (string, error) {
+		*commands = append(*commands, cmd+"\n"+script)
+		switch {
+		case strings.Contains(cmd, "unzip -p"):
+			return `<properties><entry key="group">acme</entry><entry key="name">site</entry><entry key="version">2.0.0</entry></properties>`, nil
+		case strings.Contains(script, "/crx/packmgr/list.jsp"):
+			for _, pid := range installed {
+				if strings.Contains(script, strings.ReplaceAll(packagePath(pid), "/", "%2F")) {
+					return `{"results":[{"pid":"` + pid + `","lastUnpacked":1700000000000}]}` + "\n200", nil
+				}
+			}
+			return `{"results":[]}` + "\n200", nil
+		}
+		return "", nil
+	}
+}
+
+func TestPackageDeployUninstallsPreviousPID(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "site 2.0.0.zip")
+	require.NoError(t, os.WriteFile(file, []byte("zip"), 0644))
+	var commands []string
+	cl, _ := newFakeClient(fakePackageManager(&commands, "acme:site-legacy:1.0.0"))
+	config := "instance:\n  config:\n    local_author:\n      http_url: http://127.0.0.1:4502\n"
+	pc := &PackageClient{cl, newTestContext(t), PackageArgs{TargetArgs: TargetArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: config}}, File: file}}
+	state := PackageState{PID: "acme:site-legacy:1.0.0"}
+
+	require.NoError(t, pc.deploy("site", &state, true))
+
+	assert.Equal(t, "acme:site:2.0.0", state.PID)
+	var actions []string
+	for _, command := range commands {
+		if strings.Contains(command, "unzip -p") {
+			assert.Contains(t, command, "unzip -p '/mnt/aemc/aem/home/var/package/site/site 2.0.0.zip'")
+		}
+		if strings.Contains(command, "aemw 'package'") {
+			actions = append(actions, command[strings.Index(command, "aemw 'package'"):])
+		}
+	}
+	require.Len(t, actions, 2)
+	assert.Contains(t, actions[0], "aemw 'package' 'uninstall' '--pid' 'acme:site-legacy:1.0.0'")
+	assert.Contains(t, actions[1], "aemw 'package' 'deploy' '--file' '/mnt/aemc/aem/home/var/package/site/site 2.0.0.zip'")
+}
+
+func TestPackageDeploySamePID(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "site.zip")
+	require.NoError(t, os.WriteFile(file, []byte("zip"), 0644))
+	var commands []string
+	cl, _ := newFakeClient(fakePackageManager(&commands))
+	config := "instance:\n  config:\n    local_author:\n      http_url: http://127.0.0.1:4502\n"
+	pc := &PackageClient{cl, newTestContext(t), PackageArgs{TargetArgs: TargetArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: config}}, File: file}}
+	state := PackageState{PID: "acme:site:2.0.0"}
+
+	require.NoError(t, pc.deploy("site", &state, true))
+
+	for _, command := range commands {
+		assert.NotContains(t, command, "'uninstall'")
+	}
+}
@@ -21,6 +21,24 @@ func Provider() p.Provider {
 	return infer.Provider(infer.Options{
 		Resources: []infer.InferredResource{
 			infer.Resource[Instance, InstanceArgs, InstanceState](),
+			infer.Resource[Package, PackageArgs, PackageState](),
+			infer.Resource[OsgiConfig, OsgiConfigArgs, OsgiConfigState](),
+			infer.Resource[ReplicationAgent, ReplicationAgentArgs, ReplicationAgentState](),
+			infer.Resource[RepoNode, RepoNodeArgs, RepoNodeState](),
+			infer.Resource[User, UserArgs, UserState](),
+			infer.Resource[Group, GroupArgs, GroupState](),
+			infer.Resource[Acl, AclArgs, AclState](),
+			infer.Resource[Bundle, BundleArgs, BundleState](),
+			infer.Resource[Script, ScriptArgs, ScriptState](),
+			infer.Resource[OakIndex, OakIndexArgs, OakIndexState](),
+			infer.Resource[SmokeTest, SmokeTestArgs, SmokeTestState](),
+		},
+		Functions: []infer.InferredFunction{
+			infer.Function[Activate, ActivateArgs, ActivateResult](),
+			infer.Function[GetInstanceStatus, GetInstanceStatusArgs, GetInstanceStatusResult](),
+			infer.Function[Run, RunArgs, RunResult](),
+			infer.Function[GetLogs, GetLogsArgs, GetLogsResult](),
+			infer.Function[ThreadDump, ThreadDumpArgs, ThreadDumpResult](),
 		},
 		ModuleMap: map[tokens.ModuleName]tokens.ModuleName{
 			"provider": "compose",
@@ -69,10 +87,12 @@ func Provider() p.Provider {
 type Instance struct{}
 
 type InstanceArgs struct {
-	Client  Client            `pulumi:"client"`
-	Files   map[string]string `pulumi:"files,optional"`
-	System  *System           `pulumi:"system,optional"`
-	Compose *Compose          `pulumi:"compose,optional"`
+	Client      Client            `pulumi:"client"`
+	Files       map[string]string `pulumi:"files,optional"`
+	System      *System           `pulumi:"system,optional"`
+	Compose     *Compose          `pulumi:"compose,optional"`
+	Diagnostics *Diagnostics      `pulumi:"diagnostics,optional"`
+	Preflight   *Preflight        `pulumi:"preflight,optional"`
 }
 
 func (m *InstanceArgs) Annotate(a infer.Annotator) {
@@ -80,6 +100,8 @@ func (m *InstanceArgs) Annotate(a infer.Annotator) {
 	a.Describe(&m.Files, "Files or directories to be copied into the machine.")
 	a.Describe(&m.System, "Operating system configuration for the machine on which AEM instance will be running.")
 	a.Describe(&m.Compose, "AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).")
+	a.Describe(&m.Diagnostics, "Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.")
+	a.Describe(&m.Preflight, "Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.")
 }
 
 type Client struct {
@@ -88,6 +110,7 @@ type Client struct {
 	Credentials   map[string]string `pulumi:"credentials,optional"`
 	ActionTimeout string            `pulumi:"action_timeout,optional"`
 	StateTimeout  string            `pulumi:"state_timeout,optional"`
+	PortForward   bool              `pulumi:"port_forward,optional"`
 }
 
 func (m *Client) Annotate(a infer.Annotator) {
@@ -96,6 +119,7 @@ func (m *Client) Annotate(a infer.Annotator) {
 	a.Describe(&m.Credentials, "Credentials for the connection type")
 	a.Describe(&m.ActionTimeout, "Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.")
 	a.Describe(&m.StateTimeout, "Used when reading the AEM instance state when determining the plan.")
+	a.Describe(&m.PortForward, "Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.")
 }
 
 type System struct {
@@ -105,6 +129,7 @@ type System struct {
 	ServiceConfig string            `pulumi:"service_config,optional"`
 	User          string            `pulumi:"user,optional"`
 	Bootstrap     *InstanceScript   `pulumi:"bootstrap,optional"`
+	Schedules     []Schedule        `pulumi:"schedules,optional"`
 }
 
 func (m *System) Annotate(a infer.Annotator) {
@@ -114,24 +139,121 @@ func (m *System) Annotate(a infer.Annotator) {
 	a.Describe(&m.ServiceConfig, "Contents of the AEM system service definition file (systemd).")
 	a.Describe(&m.User, "System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.")
 	a.Describe(&m.Bootstrap, "Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.")
+	a.Describe(&m.Schedules, "Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.")
+}
+
+type Schedule struct {
+	Name       string          `pulumi:"name"`
+	OnCalendar string          `pulumi:"on_calendar"`
+	Builtin    string          `pulumi:"builtin,optional"`
+	Script     *InstanceScript `pulumi:"script,optional"`
+}
+
+func (m *Schedule) Annotate(a infer.Annotator) {
+	a.Describe(&m.Name, "Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.")
+	a.Describe(&m.OnCalendar, "Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).")
+	a.Describe(&m.Builtin, "Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.")
+	a.Describe(&m.Script, "Script(s) to be executed. Mutually exclusive with 'builtin'.")
 }
 
 type Compose struct {
-	Download  bool            `pulumi:"download,optional"`
-	Version   string          `pulumi:"version,optional"`
-	Config    string          `pulumi:"config,optional"`
-	Create    *InstanceScript `pulumi:"create,optional"`
-	Configure *InstanceScript `pulumi:"configure,optional"`
-	Delete    *InstanceScript `pulumi:"delete,optional"`
+	Download            bool              `pulumi:"download,optional"`
+	Version             string            `pulumi:"version,optional"`
+	Config              string            `pulumi:"config,optional"`
+	ConfigOverrides     any               `pulumi:"config_overrides,optional"`
+	ConfigListsStrategy string            `pulumi:"config_lists_strategy,optional"`
+	Instances           []ComposeInstance `pulumi:"instances,optional"`
+	Create              *InstanceScript   `pulumi:"create,optional"`
+	Configure           *InstanceScript   `pulumi:"configure,optional"`
+	Delete              *InstanceScript   `pulumi:"delete,optional"`
+	Backup              *Backup           `pulumi:"backup,optional"`
+	RestoreFrom         string            `pulumi:"restore_from,optional"`
+	AdminPassword       string            `pulumi:"admin_password,optional" provider:"secret"`
+	Readiness           *Readiness        `pulumi:"readiness,optional"`
 }
 
 func (m *Compose) Annotate(a infer.Annotator) {
 	a.Describe(&m.Download, "Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.")
 	a.Describe(&m.Version, "Version of AEM Compose tool to use on remote machine.")
 	a.Describe(&m.Config, "Contents of the AEM Compose YML configuration file.")
+	a.Describe(&m.ConfigOverrides, "Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.")
+	a.Describe(&m.Instances, "Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.")
+	a.Describe(&m.ConfigListsStrategy, "Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).")
 	a.Describe(&m.Create, "Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.")
-	a.Describe(&m.Configure, "Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.")
+	a.Describe(&m.Configure, "Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.")
 	a.Describe(&m.Delete, "Script(s) for deleting a stopped instance.")
+	a.Describe(&m.Backup, "Settings for backing up AEM instance files.")
+	a.Describe(&m.RestoreFrom, "Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.")
+	a.Describe(&m.Readiness, "Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.")
+	a.Describe(&m.AdminPassword, "Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.")
+}
+
+type ComposeInstance struct {
+	ID         string   `pulumi:"id"`
+	HTTPURL    string   `pulumi:"http_url"`
+	User       string   `pulumi:"user,optional"`
+	Password   string   `pulumi:"password,optional" provider:"secret"`
+	RunModes   []string `pulumi:"run_modes,optional"`
+	JvmOpts    []string `pulumi:"jvm_opts,optional"`
+	StartOpts  []string `pulumi:"start_opts,optional"`
+	EnvVars    []string `pulumi:"env_vars,optional"`
+	SecretVars []string `pulumi:"secret_vars,optional"`
+	SlingProps []string `pulumi:"sling_props,optional"`
+}
+
+func (m *ComposeInstance) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').")
+	a.Describe(&m.HTTPURL, "The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').")
+	a.Describe(&m.User, "User used to communicate with the AEM instance.")
+	a.Describe(&m.Password, "Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.")
+	a.Describe(&m.RunModes, "Run modes of the AEM instance.")
+	a.Describe(&m.JvmOpts, "JVM options passed to the AEM instance process.")
+	a.Describe(&m.StartOpts, "Options passed to the AEM instance start script.")
+	a.Describe(&m.EnvVars, "Environment variables set for the AEM instance process (in format 'NAME=value').")
+	a.Describe(&m.SecretVars, "Secret variables set for the AEM instance process (in format 'NAME=value').")
+	a.Describe(&m.SlingProps, "Sling properties set for the AEM instance (in format 'name=value').")
+}
+
+type Diagnostics struct {
+	LogLines     int    `pulumi:"log_lines,optional"`
+	SummaryLines int    `pulumi:"summary_lines,optional"`
+	ThreadDump   bool   `pulumi:"thread_dump,optional"`
+	OutputDir    string `pulumi:"output_dir,optional"`
+}
+
+func (m *Diagnostics) Annotate(a infer.Annotator) {
+	a.Describe(&m.LogLines, "Number of the last lines of the AEM logs and system journal to be collected.")
+	a.Describe(&m.SummaryLines, "Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.")
+	a.Describe(&m.ThreadDump, "Toggle collecting thread dumps of the running AEM instances.")
+	a.Describe(&m.OutputDir, "Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.")
+}
+
+type Preflight struct {
+	MinDiskSpace int    `pulumi:"min_disk_space,optional"`
+	JavaVersion  string `pulumi:"java_version,optional"`
+	Ports        bool   `pulumi:"ports,optional"`
+}
+
+func (m *Preflight) Annotate(a infer.Annotator) {
+	a.Describe(&m.MinDiskSpace, "Minimum free disk space in gigabytes required in the data directory.")
+	a.Describe(&m.JavaVersion, "Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.")
+	a.Describe(&m.Ports, "Toggle checking if the ports of the local AEM instances defined in the configuration are free.")
+}
+
+type Backup struct {
+	Target       string `pulumi:"target"`
+	Retention    int    `pulumi:"retention,optional"`
+	Compression  string `pulumi:"compression,optional"`
+	BeforeUpdate bool   `pulumi:"before_update,optional"`
+	BeforeDelete bool   `pulumi:"before_delete,optional"`
+}
+
+func (m *Backup) Annotate(a infer.Annotator) {
+	a.Describe(&m.Target, "Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).")
+	a.Describe(&m.Retention, "Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.")
+	a.Describe(&m.Compression, "Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.")
+	a.Describe(&m.BeforeUpdate, "Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.")
+	a.Describe(&m.BeforeDelete, "Toggle making a backup before deleting the instance.")
 }
 
 type InstanceScript struct {
@@ -145,12 +267,19 @@ func (m *InstanceScript) Annotate(a infer.Annotator) {
 }
 
 type InstanceModel struct {
-	ID         string   `pulumi:"id"`
-	URL        string   `pulumi:"url"`
-	AemVersion string   `pulumi:"aem_version"`
-	Dir        string   `pulumi:"dir"`
-	Attributes []string `pulumi:"attributes"`
-	RunModes   []string `pulumi:"run_modes"`
+	ID           string   `pulumi:"id"`
+	URL          string   `pulumi:"url"`
+	AemVersion   string   `pulumi:"aem_version"`
+	Dir          string   `pulumi:"dir"`
+	Attributes   []string `pulumi:"attributes"`
+	RunModes     []string `pulumi:"run_modes"`
+	HealthChecks []string `pulumi:"health_checks"`
+	Created      bool     `pulumi:"created"`
+	Running      bool     `pulumi:"running"`
+	Reachable    bool     `pulumi:"reachable"`
+	UpToDate     bool     `pulumi:"up_to_date"`
+	HTTPPort     int      `pulumi:"http_port"`
+	DebugPort    int      `pulumi:"debug_port"`
 }
 
 func (m *InstanceModel) Annotate(a infer.Annotator) {
@@ -160,15 +289,68 @@ func (m *InstanceModel) Annotate(a infer.Annotator) {
 	a.Describe(&m.Dir, "Remote path in which AEM instance is stored.")
 	a.Describe(&m.Attributes, "A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.")
 	a.Describe(&m.RunModes, "A list of run modes for a specific AEM instance.")
+	a.Describe(&m.HealthChecks, "A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.")
+	a.Describe(&m.Created, "Indicates if the AEM instance files are created on the machine.")
+	a.Describe(&m.Running, "Indicates if the AEM instance process is running.")
+	a.Describe(&m.Reachable, "Indicates if the AEM instance is running and responds to HTTP requests.")
+	a.Describe(&m.UpToDate, "Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).")
+	a.Describe(&m.HTTPPort, "Port on which the AEM instance accepts HTTP requests.")
+	a.Describe(&m.DebugPort, "Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.")
+}
+
+type Readiness struct {
+	Attributes []string         `pulumi:"attributes,optional"`
+	Probes     []ReadinessProbe `pulumi:"probes,optional"`
+	Timeout    string           `pulumi:"timeout,optional"`
+	Interval   string           `pulumi:"interval,optional"`
+	Policy     string           `pulumi:"policy,optional"`
+}
+
+func (m *Readiness) Annotate(a infer.Annotator) {
+	a.Describe(&m.Attributes, "Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).")
+	a.Describe(&m.Probes, "HTTP requests performed against each instance which need to respond with the expected status.")
+	a.Describe(&m.Timeout, "Maximum time to wait for the instances to become ready.")
+	a.Describe(&m.Interval, "Time between subsequent readiness checks.")
+	a.Describe(&m.Policy, "Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).")
+}
+
+type ReadinessProbe struct {
+	Path   string `pulumi:"path"`
+	Status int    `pulumi:"status,optional"`
+}
+
+func (m *ReadinessProbe) Annotate(a infer.Annotator) {
+	a.Describe(&m.Path, "Path requested on the instance (e.g. '/libs/granite/core/content/login.html').")
+	a.Describe(&m.Status, "Expected HTTP status of the response.")
+}
+
+type BackupModel struct {
+	Name      string `pulumi:"name"`
+	Timestamp string `pulumi:"timestamp"`
+	Size      int    `pulumi:"size"`
+	Checksum  string `pulumi:"checksum"`
+	Location  string `pulumi:"location"`
+}
+
+func (m *BackupModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.Name, "Name of the backup file.")
+	a.Describe(&m.Timestamp, "Time at which the backup was made (RFC 3339, UTC).")
+	a.Describe(&m.Size, "Size of the backup file in bytes.")
+	a.Describe(&m.Checksum, "SHA-256 checksum of the backup file.")
+	a.Describe(&m.Location, "Location of the uploaded backup file. Could be used as 'restore_from' value.")
 }
 
 type InstanceState struct {
 	InstanceArgs
-	Instances []InstanceModel `pulumi:"instances"`
+	Instances       []InstanceModel `pulumi:"instances"`
+	Backups         []BackupModel   `pulumi:"backups"`
+	EffectiveConfig string          `pulumi:"effective_config" provider:"secret"`
 }
 
 func (m *InstanceState) Annotate(a infer.Annotator) {
 	a.Describe(&m.Instances, "Current state of the configured AEM instances.")
+	a.Describe(&m.Backups, "Backups of the AEM instance files kept in the target location.")
+	a.Describe(&m.EffectiveConfig, "Contents of the AEM Compose YML configuration file actually written to the machine (after applying overrides).")
 }
 
 func (Instance) Create(ctx p.Context, name string, input InstanceArgs, preview bool) (string, InstanceState, error) {
@@ -178,23 +360,20 @@ func (Instance) Create(ctx p.Context, name string, input InstanceArgs, preview b
 	}
 
 	instanceResource := NewInstanceResource()
-	status, err := instanceResource.Create(ctx, input)
+	status, backups, err := instanceResource.Create(ctx, input)
 	if err != nil {
 		return name, state, err
 	}
 
-	var instances []InstanceModel
-	for _, item := range status.Data.Instances {
-		instances = append(instances, InstanceModel{
-			ID:         item.ID,
-			URL:        item.URL,
-			AemVersion: item.AemVersion,
-			Dir:        item.Dir,
-			Attributes: item.Attributes,
-			RunModes:   item.RunModes,
-		})
+	state.Backups = backups
+	state.EffectiveConfig, err = composeConfigYAML(input.Compose)
+	if err != nil {
+		return name, state, err
+	}
+	state.Instances, err = status.instanceModels(state.EffectiveConfig)
+	if err != nil {
+		return name, state, err
 	}
-	state.Instances = instances
 
 	return name, state, nil
 }
@@ -206,23 +385,20 @@ func (Instance) Update(ctx p.Context, id string, oldState InstanceState, input I
 
 	state := InstanceState{InstanceArgs: input}
 	instanceResource := NewInstanceResource()
-	status, err := instanceResource.Update(ctx, input)
+	status, backups, err := instanceResource.Update(ctx, oldState.InstanceArgs, input)
 	if err != nil {
 		return state, err
 	}
 
-	var instances []InstanceModel
-	for _, item := range status.Data.Instances {
-		instances = append(instances, InstanceModel{
-			ID:         item.ID,
-			URL:        item.URL,
-			AemVersion: item.AemVersion,
-			Dir:        item.Dir,
-			Attributes: item.Attributes,
-			RunModes:   item.RunModes,
-		})
+	state.Backups = backups
+	state.EffectiveConfig, err = composeConfigYAML(input.Compose)
+	if err != nil {
+		return state, err
+	}
+	state.Instances, err = status.instanceModels(state.EffectiveConfig)
+	if err != nil {
+		return state, err
 	}
-	state.Instances = instances
 
 	return state, nil
 }
@@ -236,36 +412,136 @@ func (Instance) Delete(ctx p.Context, id string, props InstanceState) error {
 	return nil
 }
 
+func (Instance) Read(ctx p.Context, id string, inputs InstanceArgs, state InstanceState) (string, InstanceArgs, InstanceState, error) {
+	instanceResource := NewInstanceResource()
+	importing := state.Client.Type == ""
+	if importing {
+		args, err := parseInstanceImportID(ctx, id)
+		if err != nil {
+			return id, inputs, state, err
+		}
+		state.InstanceArgs = args
+	}
+	args, status, backups, err := instanceResource.Read(ctx, state.InstanceArgs, importing)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	state.InstanceArgs = args
+	state.Backups = backups
+	state.EffectiveConfig, err = composeConfigYAML(args.Compose)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	state.Instances, err = status.instanceModels(state.EffectiveConfig)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	if importing {
+		inputs = args
+	}
+	return id, inputs, state, nil
+}
+
 func (Instance) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (InstanceArgs, []p.CheckFailure, error) {
-	inputs := determineInputs(newInputs, "client")
+	setClientDefaults(newInputs)
+
+	_ = determineInputs(newInputs, "files")
+
+	setSystemDefaults(newInputs)
+	setComposeDefaults(newInputs)
+	setDiagnosticsDefaults(newInputs)
+	setPreflightDefaults(newInputs)
+
+	args, failures, err := infer.DefaultCheck[InstanceArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	return args, validateInstanceArgs(newInputs, args), nil
+}
+
+func setClientDefaults(allInputs resource.PropertyMap) {
+	inputs := determineInputs(allInputs, "client")
 	setDefaultValue(inputs, "credentials", resource.NewObjectProperty(resource.PropertyMap{}))
 	setDefaultValue(inputs, "action_timeout", resource.NewStringProperty("10m"))
 	setDefaultValue(inputs, "state_timeout", resource.NewStringProperty("30s"))
+}
 
-	_ = determineInputs(newInputs, "files")
+func setDiagnosticsDefaults(allInputs resource.PropertyMap) {
+	if !allInputs.HasValue("diagnostics") {
+		return
+	}
+	inputs := determineInputs(allInputs, "diagnostics")
+	setDefaultValue(inputs, "log_lines", resource.NewNumberProperty(500))
+	setDefaultValue(inputs, "summary_lines", resource.NewNumberProperty(20))
+	setDefaultValue(inputs, "thread_dump", resource.NewBoolProperty(true))
+}
 
-	inputs = determineInputs(newInputs, "system")
+func setPreflightDefaults(allInputs resource.PropertyMap) {
+	if !allInputs.HasValue("preflight") {
+		return
+	}
+	inputs := determineInputs(allInputs, "preflight")
+	setDefaultValue(inputs, "min_disk_space", resource.NewNumberProperty(20))
+	setDefaultValue(inputs, "ports", resource.NewBoolProperty(true))
+}
+
+func setSystemDefaults(allInputs resource.PropertyMap) {
+	inputs := determineInputs(allInputs, "system")
 	setDefaultInlineScripts(inputs, "bootstrap", []string{})
 	setDefaultValue(inputs, "data_dir", resource.NewStringProperty("/mnt/aemc"))
 	setDefaultValue(inputs, "work_dir", resource.NewStringProperty("/tmp/aemc"))
 	setDefaultValue(inputs, "service_config", resource.NewStringProperty(instance.ServiceConf))
 	setDefaultValue(inputs, "user", resource.NewStringProperty(""))
 	setDefaultValue(inputs, "env", resource.NewObjectProperty(resource.PropertyMap{}))
+}
 
-	inputs = determineInputs(newInputs, "compose")
+func setComposeDefaults(allInputs resource.PropertyMap) {
+	inputs := determineInputs(allInputs, "compose")
 	setDefaultValue(inputs, "download", resource.NewBoolProperty(true))
 	setDefaultValue(inputs, "version", resource.NewStringProperty("1.6.12"))
 	setDefaultValue(inputs, "config", resource.NewStringProperty(instance.ConfigYML))
+	setDefaultValue(inputs, "config_lists_strategy", resource.NewStringProperty(ConfigListsReplace))
 	setDefaultInlineScripts(inputs, "create", instance.CreateScriptInline)
 	setDefaultInlineScripts(inputs, "configure", instance.LaunchScriptInline)
 	setDefaultInlineScripts(inputs, "delete", instance.DeleteScriptInline)
-
-	return infer.DefaultCheck[InstanceArgs](newInputs)
+	setDefaultValue(inputs, "restore_from", resource.NewStringProperty(""))
+	if inputs.HasValue("readiness") {
+		readinessInputs := determineInputs(inputs, "readiness")
+		setDefaultValue(readinessInputs, "attributes", resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty(ReadinessRunning),
+			resource.NewStringProperty(ReadinessReachable),
+			resource.NewStringProperty(ReadinessUpToDate),
+		}))
+		setDefaultValue(readinessInputs, "probes", resource.NewArrayProperty([]resource.PropertyValue{}))
+		setDefaultValue(readinessInputs, "timeout", resource.NewStringProperty("10m"))
+		setDefaultValue(readinessInputs, "interval", resource.NewStringProperty("10s"))
+		setDefaultValue(readinessInputs, "policy", resource.NewStringProperty(ReadinessFail))
+		if probes, ok := readinessInputs["probes"]; ok && probes.IsArray() {
+			for _, probe := range probes.ArrayValue() {
+				if probe.IsObject() {
+					setDefaultValue(probe.ObjectValue(), "status", resource.NewNumberProperty(200))
+				}
+			}
+		}
+	}
+	if inputs.HasValue("backup") {
+		inputs = determineInputs(inputs, "backup")
+		setDefaultValue(inputs, "retention", resource.NewNumberProperty(7))
+		setDefaultValue(inputs, "compression", resource.NewStringProperty("gzip"))
+		setDefaultValue(inputs, "before_update", resource.NewBoolProperty(false))
+		setDefaultValue(inputs, "before_delete", resource.NewBoolProperty(false))
+	}
 }
 
 func determineInputs(allInputs resource.PropertyMap, key resource.PropertyKey) resource.PropertyMap {
 	if inputs, ok := allInputs[key]; ok {
-		return inputs.V.(resource.PropertyMap)
+		if inputs.IsSecret() {
+			inputs = inputs.SecretValue().Element
+		}
+		if !inputs.IsObject() { // unknown yet (e.g. output of other resource during preview)
+			return resource.PropertyMap{}
+		}
+		return inputs.ObjectValue()
 	} else {
 		inputs = resource.NewObjectProperty(resource.PropertyMap{})
 		allInputs[key] = inputs
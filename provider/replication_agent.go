@@ -0,0 +1,190 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	ReplicationAgentPublish = "publish"
+	ReplicationAgentFlush   = "flush"
+	ReplicationAgentReverse = "reverse"
+)
+
+var ReplicationAgentTypes = []string{ReplicationAgentPublish, ReplicationAgentFlush, ReplicationAgentReverse}
+
+type ReplicationAgent struct{}
+
+type ReplicationAgentArgs struct {
+	TargetArgs
+	Location          string         `pulumi:"location,optional" provider:"replaceOnChanges"`
+	Name              string         `pulumi:"name,optional" provider:"replaceOnChanges"`
+	Type              string         `pulumi:"type,optional"`
+	Enabled           bool           `pulumi:"enabled,optional"`
+	TransportURI      string         `pulumi:"transport_uri"`
+	TransportUser     string         `pulumi:"transport_user,optional" provider:"secret"`
+	TransportPassword string         `pulumi:"transport_password,optional" provider:"secret"`
+	UserID            string         `pulumi:"user_id,optional"`
+	Properties        map[string]any `pulumi:"properties,optional"`
+}
+
+func (m *ReplicationAgentArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Location, "Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').")
+	a.Describe(&m.Name, "Name of the replication agent. By default, the resource name is used.")
+	a.Describe(&m.Type, "Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).")
+	a.Describe(&m.Enabled, "Toggle the replication agent.")
+	a.Describe(&m.TransportURI, "URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').")
+	a.Describe(&m.TransportUser, "User used to authenticate on the replication target.")
+	a.Describe(&m.TransportPassword, "Password used to authenticate on the replication target.")
+	a.Describe(&m.UserID, "User whose permissions are used when replicating content. By default, the system user is used.")
+	a.Describe(&m.Properties, "Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').")
+}
+
+type ReplicationAgentInstanceModel struct {
+	ID           string `pulumi:"id"`
+	Enabled      bool   `pulumi:"enabled"`
+	TransportURI string `pulumi:"transport_uri"`
+}
+
+func (m *ReplicationAgentInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Enabled, "Indicates if the replication agent is enabled on the AEM instance.")
+	a.Describe(&m.TransportURI, "URI of the replication target read from the AEM instance.")
+}
+
+type ReplicationAgentState struct {
+	ReplicationAgentArgs
+	Path      string                          `pulumi:"path"`
+	Instances []ReplicationAgentInstanceModel `pulumi:"instances"`
+}
+
+func (m *ReplicationAgentState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Path, "Repository path of the replication agent.")
+	a.Describe(&m.Instances, "Current state of the replication agent on the target AEM instances.")
+}
+
+func (m *ReplicationAgent) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Replication agent set up on the AEM instances. Deleted from the instances when the resource is deleted.")
+}
+
+func (ReplicationAgent) Create(ctx p.Context, name string, input ReplicationAgentArgs, preview bool) (string, ReplicationAgentState, error) {
+	state := ReplicationAgentState{ReplicationAgentArgs: input, Path: replicationAgentPath(input)}
+	if preview {
+		return name, state, nil
+	}
+	rc, err := connectReplicationAgent(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer rc.Close()
+
+	if err := rc.setup(); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = rc.readInstances()
+	return name, state, err
+}
+
+func (ReplicationAgent) Update(ctx p.Context, id string, olds ReplicationAgentState, news ReplicationAgentArgs, preview bool) (ReplicationAgentState, error) {
+	state := ReplicationAgentState{ReplicationAgentArgs: news, Path: olds.Path, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	rc, err := connectReplicationAgent(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer rc.Close()
+
+	if err := rc.deleteFromRemovedInstances(olds.TargetArgs); err != nil {
+		return state, err
+	}
+	if stale := replicationAgentStaleProperties(olds.ReplicationAgentArgs, news); len(stale) > 0 {
+		ctx.Logf(diag.Info, "Recreating replication agent '%s' to remove properties %v", state.Path, stale)
+		err = rc.recreate()
+	} else {
+		err = rc.setup()
+	}
+	if err != nil {
+		return state, err
+	}
+	state.Instances, err = rc.readInstances()
+	return state, err
+}
+
+func (ReplicationAgent) Delete(ctx p.Context, id string, props ReplicationAgentState) error {
+	rc, err := connectReplicationAgent(ctx, props.ReplicationAgentArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	instances, err := rc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := rc.delete(instance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ReplicationAgent) Read(ctx p.Context, id string, inputs ReplicationAgentArgs, state ReplicationAgentState) (string, ReplicationAgentArgs, ReplicationAgentState, error) {
+	rc, err := connectReplicationAgent(ctx, state.ReplicationAgentArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer rc.Close()
+
+	state.Instances, err = rc.readInstances()
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores the desired agent
+	for _, instance := range state.Instances {
+		if instance.Enabled != state.Enabled || instance.TransportURI != state.TransportURI {
+			ctx.Logf(diag.Warning, "Replication agent '%s' on instance '%s' differs from the desired one", state.Path, instance.ID)
+			state.Enabled = instance.Enabled
+			state.TransportURI = instance.TransportURI
+			break
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (ReplicationAgent) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (ReplicationAgentArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "location", resource.NewStringProperty("author"))
+	setDefaultValue(newInputs, "name", resource.NewStringProperty(name))
+	setDefaultValue(newInputs, "type", resource.NewStringProperty(ReplicationAgentPublish))
+	setDefaultValue(newInputs, "enabled", resource.NewBoolProperty(true))
+
+	args, failures, err := infer.DefaultCheck[ReplicationAgentArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs["type"].IsString() && !slices.Contains(ReplicationAgentTypes, args.Type) {
+		failures = append(failures, p.CheckFailure{Property: "type", Reason: "unknown type '" + args.Type + "' (expected 'publish', 'flush' or 'reverse')"})
+	}
+	return args, failures, nil
+}
+
+func replicationAgentPath(model ReplicationAgentArgs) string {
+	return "/etc/replication/agents." + model.Location + "/" + model.Name
+}
+
+func connectReplicationAgent(ctx p.Context, model ReplicationAgentArgs, timeout string) (*ReplicationAgentClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &ReplicationAgentClient{tc.cl, ctx, model}, nil
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+	"sort"
+)
+
+type ReplicationAgentClient ClientContext[ReplicationAgentArgs]
+
+func (rc *ReplicationAgentClient) Close() error {
+	return rc.cl.Disconnect()
+}
+
+func (rc *ReplicationAgentClient) target() *TargetClient {
+	return &TargetClient{rc.cl, rc.ctx, rc.data.TargetArgs}
+}
+
+// replicationAgentProperties maps the agent settings to the properties of the agent page content node.
+func replicationAgentProperties(model ReplicationAgentArgs) map[string]any {
+	props := map[string]any{
+		"enabled":           model.Enabled,
+		"transportUri":      model.TransportURI,
+		"transportUser":     model.TransportUser,
+		"transportPassword": model.TransportPassword,
+		"serializationType": "durbo",
+	}
+	if model.UserID != "" {
+		props["userId"] = model.UserID
+	}
+	switch model.Type {
+	case ReplicationAgentFlush:
+		props["serializationType"] = "flush"
+		props["protocolHTTPMethod"] = "GET"
+		props["protocolHTTPHeaders"] = []string{"CQ-Action:{action}", "CQ-Handle:{path}", "CQ-Path:{path}"}
+		props["triggerReceive"] = true
+		props["triggerSpecific"] = true
+		props["noVersioning"] = true
+	case ReplicationAgentReverse:
+		props["reverseReplication"] = true
+		props["protocolHTTPMethod"] = "GET"
+	}
+	maps.Copy(props, model.Properties)
+	return props
+}
+
+// replicationAgentStaleProperties determines the properties set previously but not anymore (e.g. specific to the
+// previous agent type). Agent setup only saves the given properties, so these would be left on the agent.
+func replicationAgentStaleProperties(olds ReplicationAgentArgs, news ReplicationAgentArgs) []string {
+	newProps := replicationAgentProperties(news)
+	var result []string
+	for name := range replicationAgentProperties(olds) {
+		if _, ok := newProps[name]; !ok {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (rc *ReplicationAgentClient) setup() error {
+	instances, err := rc.target().instances()
+	if err != nil {
+		return err
+	}
+	propsYAML, err := yaml.Marshal(replicationAgentProperties(rc.data))
+	if err != nil {
+		return fmt.Errorf("unable to serialize properties of replication agent '%s': %w", rc.data.Name, err)
+	}
+	for _, instance := range instances {
+		rc.ctx.Logf(diag.Info, "Setting up replication agent '%s' on instance '%s'", replicationAgentPath(rc.data), instance.ID)
+		out, err := rc.target().runAemw(instance.ID, "repl", "agent", "setup", "--location", rc.data.Location, "--name", rc.data.Name, "--input-string", string(propsYAML))
+		if err != nil {
+			return fmt.Errorf("unable to set up replication agent '%s' on instance '%s': %w", replicationAgentPath(rc.data), instance.ID, err)
+		}
+		rc.ctx.Log(diag.Info, string(out))
+	}
+	return nil
+}
+
+func (rc *ReplicationAgentClient) delete(instance InstanceConfig) error {
+	rc.ctx.Logf(diag.Info, "Deleting replication agent '%s' on instance '%s'", replicationAgentPath(rc.data), instance.ID)
+	out, err := rc.target().runAemw(instance.ID, "repl", "agent", "delete", "--location", rc.data.Location, "--name", rc.data.Name)
+	if err != nil {
+		return fmt.Errorf("unable to delete replication agent '%s' on instance '%s': %w", replicationAgentPath(rc.data), instance.ID, err)
+	}
+	rc.ctx.Log(diag.Info, string(out))
+	return nil
+}
+
+// recreate deletes the agent from the target instances before setting it up again, so no stale properties are left.
+func (rc *ReplicationAgentClient) recreate() error {
+	instances, err := rc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := rc.delete(instance); err != nil {
+			return err
+		}
+	}
+	return rc.setup()
+}
+
+func (rc *ReplicationAgentClient) deleteFromRemovedInstances(olds TargetArgs) error {
+	removedInstances, err := rc.target().removedInstances(olds)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := rc.delete(instance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rc *ReplicationAgentClient) readInstances() ([]ReplicationAgentInstanceModel, error) {
+	instances, err := rc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	path := replicationAgentPath(rc.data)
+	var result []ReplicationAgentInstanceModel
+	for _, instance := range instances {
+		model := ReplicationAgentInstanceModel{ID: instance.ID}
+		response, err := rc.target().request(instance, "GET", path+"/jcr:content.json", nil)
+		if err != nil {
+			return nil, err
+		}
+		switch response.Status {
+		case 200:
+			var props map[string]any
+			if err := json.Unmarshal(response.Body, &props); err != nil {
+				return nil, fmt.Errorf("unable to parse replication agent '%s' on instance '%s': %w", path, instance.ID, err)
+			}
+			model.Enabled = cast.ToBool(props["enabled"])
+			model.TransportURI = cast.ToString(props["transportUri"])
+		case 404:
+			rc.ctx.Logf(diag.Warning, "Replication agent '%s' does not exist on instance '%s'", path, instance.ID)
+		default:
+			return nil, fmt.Errorf("unable to read replication agent '%s' on instance '%s' (HTTP status %d)", path, instance.ID, response.Status)
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationAgentStaleProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		olds     ReplicationAgentArgs
+		news     ReplicationAgentArgs
+		expected []string
+	}{
+		{
+			name:     "same type",
+			olds:     ReplicationAgentArgs{Type: ReplicationAgentFlush, Enabled: true},
+			news:     ReplicationAgentArgs{Type: ReplicationAgentFlush, Enabled: false},
+			expected: nil,
+		},
+		{
+			name:     "flush changed to publish",
+			olds:     ReplicationAgentArgs{Type: ReplicationAgentFlush},
+			news:     ReplicationAgentArgs{Type: ReplicationAgentPublish},
+			expected: []string{"noVersioning", "protocolHTTPHeaders", "protocolHTTPMethod", "triggerReceive", "triggerSpecific"},
+		},
+		{
+			name:     "reverse changed to flush",
+			olds:     ReplicationAgentArgs{Type: ReplicationAgentReverse},
+			news:     ReplicationAgentArgs{Type: ReplicationAgentFlush},
+			expected: []string{"reverseReplication"},
+		},
+		{
+			name:     "additional property and user removed",
+			olds:     ReplicationAgentArgs{Type: ReplicationAgentPublish, UserID: "replication-service", Properties: map[string]any{"logLevel": "debug"}},
+			news:     ReplicationAgentArgs{Type: ReplicationAgentPublish},
+			expected: []string{"logLevel", "userId"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, replicationAgentStaleProperties(test.olds, test.news))
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"strings"
+)
+
+const (
+	RepoNodeMerge   = "merge"
+	RepoNodeReplace = "replace"
+)
+
+var RepoNodeModes = []string{RepoNodeMerge, RepoNodeReplace}
+
+type RepoNode struct{}
+
+type RepoNodeArgs struct {
+	TargetArgs
+	Path       string         `pulumi:"path" provider:"replaceOnChanges"`
+	Mode       string         `pulumi:"mode,optional"`
+	Properties map[string]any `pulumi:"properties"`
+	Children   map[string]any `pulumi:"children,optional"`
+}
+
+func (m *RepoNodeArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Path, "Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').")
+	a.Describe(&m.Mode, "Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).")
+	a.Describe(&m.Properties, "Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').")
+	a.Describe(&m.Children, "Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.")
+}
+
+type RepoNodeInstanceModel struct {
+	ID         string         `pulumi:"id"`
+	Exists     bool           `pulumi:"exists"`
+	Properties map[string]any `pulumi:"properties"`
+}
+
+func (m *RepoNodeInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Exists, "Indicates if the repository node exists on the AEM instance.")
+	a.Describe(&m.Properties, "Properties of the repository node read from the AEM instance.")
+}
+
+type RepoNodeState struct {
+	RepoNodeArgs
+	Instances []RepoNodeInstanceModel `pulumi:"instances"`
+}
+
+func (m *RepoNodeState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Instances, "Current state of the repository node on the target AEM instances.")
+}
+
+func (m *RepoNode) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Repository node with properties and child nodes saved on the AEM instances (e.g. cloud service or context-aware configuration). Removed from the instances when the resource is deleted (in merge mode, only the managed properties and child nodes are removed).")
+}
+
+func (RepoNode) Create(ctx p.Context, name string, input RepoNodeArgs, preview bool) (string, RepoNodeState, error) {
+	state := RepoNodeState{RepoNodeArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	rc, err := connectRepoNode(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer rc.Close()
+
+	if err := rc.save(nil); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = rc.readInstances()
+	return name, state, err
+}
+
+func (RepoNode) Update(ctx p.Context, id string, olds RepoNodeState, news RepoNodeArgs, preview bool) (RepoNodeState, error) {
+	state := RepoNodeState{RepoNodeArgs: news, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	rc, err := connectRepoNode(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer rc.Close()
+
+	if err := rc.deleteFromRemovedInstances(olds); err != nil {
+		return state, err
+	}
+	if err := rc.save(&olds.RepoNodeArgs); err != nil {
+		return state, err
+	}
+	state.Instances, err = rc.readInstances()
+	return state, err
+}
+
+func (RepoNode) Delete(ctx p.Context, id string, props RepoNodeState) error {
+	rc, err := connectRepoNode(ctx, props.RepoNodeArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	instances, err := rc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := rc.delete(instance, props.RepoNodeArgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (RepoNode) Read(ctx p.Context, id string, inputs RepoNodeArgs, state RepoNodeState) (string, RepoNodeArgs, RepoNodeState, error) {
+	rc, err := connectRepoNode(ctx, state.RepoNodeArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer rc.Close()
+
+	state.Instances, err = rc.readInstances()
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores desired properties
+	for _, instance := range state.Instances {
+		if !repoNodePropertiesInSync(state.Mode, state.Properties, instance.Properties) {
+			ctx.Logf(diag.Warning, "Repository node '%s' on instance '%s' differs from the desired one", state.Path, instance.ID)
+			state.Properties = repoNodeDriftedProperties(state.Mode, state.Properties, instance.Properties)
+			break
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (RepoNode) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (RepoNodeArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "mode", resource.NewStringProperty(RepoNodeMerge))
+
+	args, failures, err := infer.DefaultCheck[RepoNodeArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs["path"].IsString() && (!strings.HasPrefix(args.Path, "/") || strings.HasSuffix(args.Path, "/")) {
+		failures = append(failures, p.CheckFailure{Property: "path", Reason: "path must be absolute and must not end with a slash"})
+	}
+	if newInputs["mode"].IsString() && !slices.Contains(RepoNodeModes, args.Mode) {
+		failures = append(failures, p.CheckFailure{Property: "mode", Reason: "unknown mode '" + args.Mode + "' (expected 'merge' or 'replace')"})
+	}
+	return args, failures, nil
+}
+
+// repoNodePropertiesInSync compares the desired properties with the ones read from the instance.
+// In merge mode, properties not managed by the resource are not taken into account.
+func repoNodePropertiesInSync(mode string, desired map[string]any, actual map[string]any) bool {
+	if mode == RepoNodeReplace {
+		if _, ok := desired["jcr:primaryType"]; !ok {
+			actual = maps.Clone(actual)
+			delete(actual, "jcr:primaryType")
+		}
+		return propertiesEqual(desired, actual)
+	}
+	for key, value := range desired {
+		actualValue, ok := actual[key]
+		if !ok || !propertiesEqual(map[string]any{key: value}, map[string]any{key: actualValue}) {
+			return false
+		}
+	}
+	return true
+}
+
+// repoNodeDriftedProperties determines the properties recorded in the state when the drift is detected.
+// In merge mode, only the managed properties are recorded, so the ones not managed are never removed by the next update.
+func repoNodeDriftedProperties(mode string, desired map[string]any, actual map[string]any) map[string]any {
+	result := map[string]any{}
+	if mode == RepoNodeReplace {
+		maps.Copy(result, actual)
+		if _, ok := desired["jcr:primaryType"]; !ok {
+			delete(result, "jcr:primaryType")
+		}
+		return result
+	}
+	for key := range desired {
+		if value, ok := actual[key]; ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func connectRepoNode(ctx p.Context, model RepoNodeArgs, timeout string) (*RepoNodeClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &RepoNodeClient{tc.cl, ctx, model}, nil
+}
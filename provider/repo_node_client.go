@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+	"net/url"
+	"sort"
+)
+
+type RepoNodeClient ClientContext[RepoNodeArgs]
+
+func (rc *RepoNodeClient) Close() error {
+	return rc.cl.Disconnect()
+}
+
+func (rc *RepoNodeClient) target() *TargetClient {
+	return &TargetClient{rc.cl, rc.ctx, rc.data.TargetArgs}
+}
+
+// save writes the node and its children on all target instances.
+// When previous arguments are given in merge mode, the properties and children no longer managed are removed.
+func (rc *RepoNodeClient) save(olds *RepoNodeArgs) error {
+	instances, err := rc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if rc.data.Mode == RepoNodeReplace {
+			if err := rc.deleteNode(instance, rc.data.Path); err != nil {
+				return err
+			}
+		} else if olds != nil {
+			if err := rc.deleteProperties(instance, rc.data.Path, repoNodeKeysRemoved(olds.Properties, rc.data.Properties)); err != nil {
+				return err
+			}
+			for _, child := range repoNodeKeysRemoved(olds.Children, rc.data.Children) {
+				if err := rc.deleteNode(instance, rc.data.Path+"/"+child); err != nil {
+					return err
+				}
+			}
+		}
+		if err := rc.saveNode(instance, rc.data.Path, rc.data.Properties); err != nil {
+			return err
+		}
+		if err := rc.saveChildren(instance, rc.data.Path, rc.data.Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rc *RepoNodeClient) saveNode(instance InstanceConfig, path string, properties map[string]any) error {
+	propertiesYAML, err := yaml.Marshal(properties)
+	if err != nil {
+		return fmt.Errorf("unable to serialize properties of repository node '%s': %w", path, err)
+	}
+	rc.ctx.Logf(diag.Info, "Saving repository node '%s' on instance '%s'", path, instance.ID)
+	out, err := rc.target().runAemw(instance.ID, "repo", "node", "save", "--path", path, "--input-string", string(propertiesYAML))
+	if err != nil {
+		return fmt.Errorf("unable to save repository node '%s' on instance '%s': %w", path, instance.ID, err)
+	}
+	rc.ctx.Log(diag.Info, string(out))
+	return nil
+}
+
+// saveChildren saves child nodes in a stable order; nested objects are treated as deeper child nodes.
+func (rc *RepoNodeClient) saveChildren(instance InstanceConfig, path string, children map[string]any) error {
+	names := maps.Keys(children)
+	sort.Strings(names)
+	for _, name := range names {
+		childPath := path + "/" + name
+		childData, ok := children[name].(map[string]any)
+		if !ok {
+			return fmt.Errorf("child node '%s' must be an object", childPath)
+		}
+		properties := map[string]any{}
+		grandchildren := map[string]any{}
+		for key, value := range childData {
+			if _, ok := value.(map[string]any); ok {
+				grandchildren[key] = value
+			} else {
+				properties[key] = value
+			}
+		}
+		if err := rc.saveNode(instance, childPath, properties); err != nil {
+			return err
+		}
+		if err := rc.saveChildren(instance, childPath, grandchildren); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rc *RepoNodeClient) deleteNode(instance InstanceConfig, path string) error {
+	rc.ctx.Logf(diag.Info, "Deleting repository node '%s' on instance '%s'", path, instance.ID)
+	out, err := rc.target().runAemw(instance.ID, "repo", "node", "delete", "--path", path)
+	if err != nil {
+		return fmt.Errorf("unable to delete repository node '%s' on instance '%s': %w", path, instance.ID, err)
+	}
+	rc.ctx.Log(diag.Info, string(out))
+	return nil
+}
+
+// deleteProperties removes node properties using the Sling POST servlet.
+func (rc *RepoNodeClient) deleteProperties(instance InstanceConfig, path string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	rc.ctx.Logf(diag.Info, "Deleting properties %v of repository node '%s' on instance '%s'", names, path, instance.ID)
+	form := url.Values{}
+	for _, name := range names {
+		form.Set(name+"@Delete", "")
+	}
+	response, err := rc.target().request(instance, "POST", path, form)
+	if err != nil {
+		return err
+	}
+	if response.Status != 200 && response.Status != 201 {
+		return fmt.Errorf("unable to delete properties of repository node '%s' on instance '%s' (HTTP status %d)", path, instance.ID, response.Status)
+	}
+	return nil
+}
+
+// delete removes the node in replace mode, otherwise only the managed properties and child nodes.
+func (rc *RepoNodeClient) delete(instance InstanceConfig, model RepoNodeArgs) error {
+	if model.Mode == RepoNodeReplace {
+		return rc.deleteNode(instance, model.Path)
+	}
+	for _, child := range repoNodeKeysRemoved(model.Children, nil) {
+		if err := rc.deleteNode(instance, model.Path+"/"+child); err != nil {
+			return err
+		}
+	}
+	return rc.deleteProperties(instance, model.Path, repoNodeKeysRemoved(model.Properties, nil))
+}
+
+func (rc *RepoNodeClient) deleteFromRemovedInstances(olds RepoNodeState) error {
+	removedInstances, err := rc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := rc.delete(instance, olds.RepoNodeArgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repoNodePropertiesIgnored are maintained by the repository itself, so they are not a part of the desired state.
+var repoNodePropertiesIgnored = []string{
+	"jcr:created", "jcr:createdBy", "jcr:lastModified", "jcr:lastModifiedBy", "jcr:uuid",
+	"jcr:baseVersion", "jcr:isCheckedOut", "jcr:predecessors", "jcr:versionHistory",
+}
+
+func (rc *RepoNodeClient) readInstances() ([]RepoNodeInstanceModel, error) {
+	instances, err := rc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []RepoNodeInstanceModel
+	for _, instance := range instances {
+		out, err := rc.target().runAemw(instance.ID, "repo", "node", "read", "--path", rc.data.Path, "--output-format", "yaml")
+		if err != nil {
+			return nil, fmt.Errorf("unable to read repository node '%s' on instance '%s': %w", rc.data.Path, instance.ID, err)
+		}
+		var data any
+		if err := yaml.Unmarshal(out, &data); err != nil {
+			return nil, fmt.Errorf("unable to parse repository node '%s' on instance '%s': %w", rc.data.Path, instance.ID, err)
+		}
+		model := RepoNodeInstanceModel{ID: instance.ID, Properties: map[string]any{}}
+		if found, ok := findYAMLKey(data, "properties").(map[string]any); ok {
+			model.Exists = true
+			for key, value := range found {
+				if !slices.Contains(repoNodePropertiesIgnored, key) {
+					model.Properties[key] = value
+				}
+			}
+		} else {
+			rc.ctx.Logf(diag.Warning, "Repository node '%s' does not exist on instance '%s'", rc.data.Path, instance.ID)
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
+
+// repoNodeKeysRemoved returns sorted keys present in the old map but missing in the new one.
+func repoNodeKeysRemoved(olds map[string]any, news map[string]any) []string {
+	var result []string
+	for key := range olds {
+		if _, ok := news[key]; !ok {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoNodeDriftedProperties(t *testing.T) {
+	desired := map[string]any{"jcr:title": "Feature", "enabled": true}
+	actual := map[string]any{"jcr:primaryType": "nt:unstructured", "jcr:title": "Changed", "owner": "ops"}
+	tests := []struct {
+		name     string
+		mode     string
+		desired  map[string]any
+		expected map[string]any
+	}{
+		{
+			name:     "merge records managed properties only",
+			mode:     RepoNodeMerge,
+			desired:  desired,
+			expected: map[string]any{"jcr:title": "Changed"},
+		},
+		{
+			name:     "replace records all properties except primary type",
+			mode:     RepoNodeReplace,
+			desired:  desired,
+			expected: map[string]any{"jcr:title": "Changed", "owner": "ops"},
+		},
+		{
+			name:     "replace records primary type when managed",
+			mode:     RepoNodeReplace,
+			desired:  map[string]any{"jcr:primaryType": "sling:Folder"},
+			expected: actual,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			drifted := repoNodeDriftedProperties(test.mode, test.desired, actual)
+
+			assert.Equal(t, test.expected, drifted)
+			if test.mode == RepoNodeMerge {
+				assert.Empty(t, repoNodeKeysRemoved(drifted, test.desired))
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/spf13/cast"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"strconv"
+	"strings"
+)
+
+// runExitCodeMarker separates the standard output from the exit code and the standard error in the combined output,
+// because connections return only the combined output of the command.
+const runExitCodeMarker = "__PULUMI_AEM_EXIT_CODE__="
+
+type Run struct{}
+
+type RunArgs struct {
+	Client  Client          `pulumi:"client"`
+	System  *System         `pulumi:"system,optional"`
+	Command string          `pulumi:"command,optional"`
+	Script  *InstanceScript `pulumi:"script,optional"`
+	Dir     string          `pulumi:"dir,optional"`
+	Sudo    bool            `pulumi:"sudo,optional"`
+	Timeout string          `pulumi:"timeout,optional"`
+}
+
+func (m *RunArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Client, "Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.System, "Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.Command, "Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.")
+	a.Describe(&m.Script, "Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.")
+	a.Describe(&m.Dir, "Remote directory in which the command is executed. By default, the data directory is used.")
+	a.Describe(&m.Sudo, "Execute the command as a superuser.")
+	a.Describe(&m.Timeout, "Maximum time of the command execution. By default, the client action timeout is used.")
+}
+
+type RunResult struct {
+	Stdout   string `pulumi:"stdout"`
+	Stderr   string `pulumi:"stderr"`
+	ExitCode int    `pulumi:"exit_code"`
+}
+
+func (m *RunResult) Annotate(a infer.Annotator) {
+	a.Describe(&m.Stdout, "Standard output of the command.")
+	a.Describe(&m.Stderr, "Standard error of the command.")
+	a.Describe(&m.ExitCode, "Exit code of the command. Equals 124 when the command timed out.")
+}
+
+func (m *Run) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).")
+}
+
+func (Run) Call(ctx p.Context, input RunArgs) (RunResult, error) {
+	var result RunResult
+	script, err := runScript(input)
+	if err != nil {
+		return result, err
+	}
+	if input.Timeout == "" {
+		input.Timeout = input.Client.ActionTimeout
+	}
+	if failures := validateDuration("timeout", input.Timeout); len(failures) > 0 {
+		return result, fmt.Errorf("%s", failures[0].Reason)
+	}
+	tc, err := connectTargetFunction(ctx, TargetArgs{Client: input.Client, System: input.System})
+	if err != nil {
+		return result, err
+	}
+	defer tc.Close()
+
+	dir := input.Dir
+	if dir == "" {
+		dir = tc.dataDir()
+	}
+	name := scriptName("run")
+	scriptPath := fmt.Sprintf("%s/%s-cmd.sh", tc.cl.WorkDir, name)
+	if err := tc.cl.FileWrite(scriptPath, script); err != nil {
+		return result, fmt.Errorf("unable to write command script: %w", err)
+	}
+	defer func() { _ = tc.cl.PathDelete(scriptPath) }()
+
+	timeout := "0"
+	if input.Timeout != "" {
+		timeout = strconv.Itoa(int(cast.ToDuration(input.Timeout).Seconds()))
+	}
+	stderrPath := scriptPath + ".err"
+	wrapper := strings.Join([]string{
+		fmt.Sprintf("timeout %s sh %s 2> %s", timeout, utils.ShellQuote(scriptPath), utils.ShellQuote(stderrPath)),
+		"code=$?",
+		fmt.Sprintf("printf '\\n%s%%d\\n' $code", runExitCodeMarker),
+		fmt.Sprintf("cat %s", utils.ShellQuote(stderrPath)),
+		fmt.Sprintf("rm -f %s", utils.ShellQuote(stderrPath)),
+		"exit 0",
+	}, "\n")
+
+	if input.Sudo {
+		tc.cl.Sudo = true
+		defer func() { tc.cl.Sudo = false }()
+	}
+	ctx.Logf(diag.Info, "Executing command on AEM instance machine")
+	out, err := tc.cl.RunShellScript(name, wrapper, dir)
+	if err != nil {
+		return result, fmt.Errorf("unable to execute command: %w", err)
+	}
+	return parseRunOutput(string(out))
+}
+
+func runScript(input RunArgs) (string, error) {
+	switch {
+	case input.Command != "" && input.Script == nil:
+		return input.Command, nil
+	case input.Command == "" && input.Script != nil && input.Script.Script != "":
+		return input.Script.Script, nil
+	case input.Command == "" && input.Script != nil && len(input.Script.Inline) > 0:
+		return "set -e\n" + strings.Join(input.Script.Inline, "\n"), nil
+	}
+	return "", fmt.Errorf("exactly one of 'command' or 'script' is required")
+}
+
+func parseRunOutput(out string) (RunResult, error) {
+	idx := strings.LastIndex(out, "\n"+runExitCodeMarker)
+	if idx < 0 {
+		return RunResult{}, fmt.Errorf("unable to determine exit code of command from output:\n%s", out)
+	}
+	rest := out[idx+len(runExitCodeMarker)+1:]
+	codeText, stderr, _ := strings.Cut(rest, "\n")
+	code, err := strconv.Atoi(strings.TrimSpace(codeText))
+	if err != nil {
+		return RunResult{}, fmt.Errorf("unable to parse exit code of command '%s': %w", codeText, err)
+	}
+	return RunResult{Stdout: out[:idx], Stderr: stderr, ExitCode: code}, nil
+}
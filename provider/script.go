@@ -0,0 +1,128 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+type Script struct{}
+
+type ScriptArgs struct {
+	TargetArgs
+	Groovy   string            `pulumi:"groovy,optional"`
+	Data     map[string]any    `pulumi:"data,optional"`
+	Command  []string          `pulumi:"command,optional"`
+	Triggers map[string]string `pulumi:"triggers,optional"`
+}
+
+func (m *ScriptArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Groovy, "Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.")
+	a.Describe(&m.Data, "Data passed to the Groovy script (available as 'data' variable).")
+	a.Describe(&m.Command, "Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.")
+	a.Describe(&m.Triggers, "Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.")
+}
+
+type ScriptInstanceModel struct {
+	ID     string `pulumi:"id"`
+	Output string `pulumi:"output"`
+	Result string `pulumi:"result"`
+}
+
+func (m *ScriptInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Output, "Standard output of the script executed on the AEM instance.")
+	a.Describe(&m.Result, "Result returned by the Groovy script executed on the AEM instance.")
+}
+
+type ScriptState struct {
+	ScriptArgs
+	Instances []ScriptInstanceModel `pulumi:"instances"`
+}
+
+func (m *ScriptState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Instances, "Outputs recorded during the last execution of the script on the target AEM instances.")
+}
+
+func (m *Script) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Groovy script or AEM Compose CLI command executed on the AEM instances once per trigger values (e.g. content migration). Deleting the resource does not revert the effects of the script.")
+}
+
+func (Script) Create(ctx p.Context, name string, input ScriptArgs, preview bool) (string, ScriptState, error) {
+	state := ScriptState{ScriptArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	sc, err := connectScript(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer sc.Close()
+
+	state.Instances, err = sc.run(nil)
+	if err != nil && len(state.Instances) > 0 {
+		return name, state, partialStateError(err)
+	}
+	return name, state, err
+}
+
+func (Script) Update(ctx p.Context, id string, olds ScriptState, news ScriptArgs, preview bool) (ScriptState, error) {
+	state := ScriptState{ScriptArgs: news, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	sc, err := connectScript(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer sc.Close()
+
+	// keep outputs of the instances on which the script was already executed for the same triggers
+	var executed []ScriptInstanceModel
+	if maps.Equal(olds.Triggers, news.Triggers) {
+		executed = olds.Instances
+	}
+	state.Instances, err = sc.run(executed)
+	if err != nil && len(state.Instances) > 0 {
+		return state, partialStateError(err)
+	}
+	return state, err
+}
+
+func (Script) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (ScriptArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+
+	args, failures, err := infer.DefaultCheck[ScriptArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if newInputs.HasValue("groovy") == newInputs.HasValue("command") && !newInputs.ContainsUnknowns() {
+		failures = append(failures, p.CheckFailure{Property: "groovy", Reason: "exactly one of 'groovy' or 'command' is required"})
+	}
+	if len(args.Data) > 0 && args.Groovy == "" {
+		failures = append(failures, p.CheckFailure{Property: "data", Reason: "data could be set only for Groovy scripts"})
+	}
+	return args, failures, nil
+}
+
+func connectScript(ctx p.Context, model ScriptArgs, timeout string) (*ScriptClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &ScriptClient{tc.cl, ctx, model}, nil
+}
+
+func scriptExecuted(executed []ScriptInstanceModel, id string) (ScriptInstanceModel, bool) {
+	i := slices.IndexFunc(executed, func(m ScriptInstanceModel) bool { return m.ID == id })
+	if i < 0 {
+		return ScriptInstanceModel{}, false
+	}
+	return executed[i], true
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"net/url"
+)
+
+type ScriptClient ClientContext[ScriptArgs]
+
+func (sc *ScriptClient) Close() error {
+	return sc.cl.Disconnect()
+}
+
+func (sc *ScriptClient) target() *TargetClient {
+	return &TargetClient{sc.cl, sc.ctx, sc.data.TargetArgs}
+}
+
+// run executes the script on the target instances except the ones on which it was already executed.
+func (sc *ScriptClient) run(executed []ScriptInstanceModel) ([]ScriptInstanceModel, error) {
+	instances, err := sc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []ScriptInstanceModel
+	for _, instance := range instances {
+		if model, ok := scriptExecuted(executed, instance.ID); ok {
+			sc.ctx.Logf(diag.Info, "Skipping script execution on instance '%s' (already executed)", instance.ID)
+			result = append(result, model)
+			continue
+		}
+		var model ScriptInstanceModel
+		if sc.data.Groovy != "" {
+			model, err = sc.runGroovy(instance)
+		} else {
+			model, err = sc.runCommand(instance)
+		}
+		if err != nil {
+			return result, err
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
+
+func (sc *ScriptClient) runCommand(instance InstanceConfig) (ScriptInstanceModel, error) {
+	sc.ctx.Logf(diag.Info, "Executing AEM Compose CLI command on instance '%s'", instance.ID)
+	out, err := sc.target().runAemw(instance.ID, sc.data.Command...)
+	if err != nil {
+		return ScriptInstanceModel{}, err
+	}
+	sc.ctx.Log(diag.Info, string(out))
+	return ScriptInstanceModel{ID: instance.ID, Output: string(out)}, nil
+}
+
+type groovyConsoleResponse struct {
+	Output              string `json:"output"`
+	Result              any    `json:"result"`
+	ExceptionStackTrace string `json:"exceptionStackTrace"`
+}
+
+func (sc *ScriptClient) runGroovy(instance InstanceConfig) (ScriptInstanceModel, error) {
+	sc.ctx.Logf(diag.Info, "Executing Groovy script on instance '%s'", instance.ID)
+	form := url.Values{}
+	form.Set("script", sc.data.Groovy)
+	if len(sc.data.Data) > 0 {
+		data, err := json.Marshal(sc.data.Data)
+		if err != nil {
+			return ScriptInstanceModel{}, fmt.Errorf("unable to serialize Groovy script data: %w", err)
+		}
+		form.Set("data", string(data))
+	}
+	response, err := sc.target().request(instance, "POST", "/bin/groovyconsole/post.json", form)
+	if err != nil {
+		return ScriptInstanceModel{}, err
+	}
+	if response.Status != 200 {
+		return ScriptInstanceModel{}, fmt.Errorf("unable to execute Groovy script on instance '%s' (HTTP status %d), ensure AEM Groovy Console is installed", instance.ID, response.Status)
+	}
+	var output groovyConsoleResponse
+	if err := json.Unmarshal(response.Body, &output); err != nil {
+		return ScriptInstanceModel{}, fmt.Errorf("unable to parse Groovy script output on instance '%s': %w", instance.ID, err)
+	}
+	sc.ctx.Log(diag.Info, output.Output)
+	if output.ExceptionStackTrace != "" {
+		return ScriptInstanceModel{}, fmt.Errorf("Groovy script failed on instance '%s':\n%s", instance.ID, output.ExceptionStackTrace)
+	}
+	result := ""
+	if output.Result != nil {
+		result = fmt.Sprint(output.Result)
+	}
+	return ScriptInstanceModel{ID: instance.ID, Output: output.Output, Result: result}, nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+func TestScriptRunPartialFailure(t *testing.T) {
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		if strings.Contains(script, "--instance-id 'local_publish'") {
+			return "", errors.New("exit status 1")
+		}
+		return "migrated", nil
+	})
+	sc := &ScriptClient{cl, newTestContext(t), ScriptArgs{
+		TargetArgs: TargetArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: instance.ConfigYML}},
+		Command:    []string{"repo", "node", "save"},
+	}}
+
+	instances, err := sc.run(nil)
+
+	assert.Error(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, ScriptInstanceModel{ID: "local_author", Output: "migrated"}, instances[0])
+}
+
+func TestScriptRunSkipsExecuted(t *testing.T) {
+	var scripts []string
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		scripts = append(scripts, script)
+		return "migrated", nil
+	})
+	sc := &ScriptClient{cl, newTestContext(t), ScriptArgs{
+		TargetArgs: TargetArgs{System: &System{DataDir: "/mnt/aemc"}, Compose: &Compose{Config: instance.ConfigYML}},
+		Command:    []string{"repo", "node", "save"},
+	}}
+	executed := []ScriptInstanceModel{{ID: "local_author", Output: "previous"}}
+
+	instances, err := sc.run(executed)
+
+	require.NoError(t, err)
+	assert.Equal(t, []ScriptInstanceModel{{ID: "local_author", Output: "previous"}, {ID: "local_publish", Output: "migrated"}}, instances)
+	require.Len(t, scripts, 1)
+	assert.Contains(t, scripts[0], "--instance-id 'local_publish'")
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"golang.org/x/exp/slices"
+	"regexp"
+	"strings"
+)
+
+var SmokeTestMethods = []string{"GET", "HEAD", "POST"}
+
+type SmokeTest struct{}
+
+type SmokeTestArgs struct {
+	TargetArgs
+	Checks   []SmokeTestCheck  `pulumi:"checks"`
+	Triggers map[string]string `pulumi:"triggers,optional"`
+}
+
+func (m *SmokeTestArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Checks, "HTTP checks performed against each target AEM instance.")
+	a.Describe(&m.Triggers, "Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.")
+}
+
+type SmokeTestCheck struct {
+	Name           string         `pulumi:"name,optional"`
+	Path           string         `pulumi:"path"`
+	Method         string         `pulumi:"method,optional"`
+	Auth           *SmokeTestAuth `pulumi:"auth,optional"`
+	ExpectedStatus int            `pulumi:"expected_status,optional"`
+	ExpectedBody   string         `pulumi:"expected_body,optional"`
+	JSONPath       string         `pulumi:"json_path,optional"`
+	JSONValue      string         `pulumi:"json_value,optional"`
+}
+
+func (m *SmokeTestCheck) Annotate(a infer.Annotator) {
+	a.Describe(&m.Name, "Name of the check used in the results. By default, the method and path are used.")
+	a.Describe(&m.Path, "Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').")
+	a.Describe(&m.Method, "HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.")
+	a.Describe(&m.Auth, "Credentials used to perform the request. By default, the credentials of the instance are used.")
+	a.Describe(&m.ExpectedStatus, "Expected HTTP status of the response.")
+	a.Describe(&m.ExpectedBody, "Regular expression which the response body needs to match.")
+	a.Describe(&m.JSONPath, "Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').")
+	a.Describe(&m.JSONValue, "Expected value found at the JSON path.")
+}
+
+type SmokeTestAuth struct {
+	User     string `pulumi:"user,optional"`
+	Password string `pulumi:"password,optional" provider:"secret"`
+}
+
+func (m *SmokeTestAuth) Annotate(a infer.Annotator) {
+	a.Describe(&m.User, "Name of the user. When empty, the request is performed anonymously.")
+	a.Describe(&m.Password, "Password of the user.")
+}
+
+type SmokeTestResult struct {
+	InstanceID string `pulumi:"instance_id"`
+	Check      string `pulumi:"check"`
+	Passed     bool   `pulumi:"passed"`
+	Status     int    `pulumi:"status"`
+	Message    string `pulumi:"message"`
+}
+
+func (m *SmokeTestResult) Annotate(a infer.Annotator) {
+	a.Describe(&m.InstanceID, "Unique identifier of AEM instance on which the check was performed.")
+	a.Describe(&m.Check, "Name of the check.")
+	a.Describe(&m.Passed, "Indicates if the response met the expectations.")
+	a.Describe(&m.Status, "HTTP status of the response.")
+	a.Describe(&m.Message, "Reason of the failure.")
+}
+
+type SmokeTestState struct {
+	SmokeTestArgs
+	Passed  bool              `pulumi:"passed"`
+	Results []SmokeTestResult `pulumi:"results"`
+}
+
+func (m *SmokeTestState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Passed, "Indicates if all checks passed on all target instances.")
+	a.Describe(&m.Results, "Results of the checks recorded during the last run per instance.")
+}
+
+func (m *SmokeTest) Annotate(a infer.Annotator) {
+	a.Describe(&m, "HTTP checks performed against the AEM instances after provisioning. Fails the deployment when any of the checks does not pass.")
+}
+
+func (SmokeTest) Create(ctx p.Context, name string, input SmokeTestArgs, preview bool) (string, SmokeTestState, error) {
+	state := SmokeTestState{SmokeTestArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	err := runSmokeTest(ctx, &state)
+	return name, state, err
+}
+
+func (SmokeTest) Update(ctx p.Context, id string, olds SmokeTestState, news SmokeTestArgs, preview bool) (SmokeTestState, error) {
+	state := SmokeTestState{SmokeTestArgs: news, Passed: olds.Passed, Results: olds.Results}
+	if preview {
+		return state, nil
+	}
+	err := runSmokeTest(ctx, &state)
+	return state, err
+}
+
+func (SmokeTest) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (SmokeTestArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	if checks, ok := newInputs["checks"]; ok && checks.IsArray() {
+		for _, check := range checks.ArrayValue() {
+			if check.IsObject() {
+				setDefaultValue(check.ObjectValue(), "method", resource.NewStringProperty("GET"))
+				setDefaultValue(check.ObjectValue(), "expected_status", resource.NewNumberProperty(200))
+			}
+		}
+	}
+
+	args, failures, err := infer.DefaultCheck[SmokeTestArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if len(args.Checks) == 0 && !newInputs.ContainsUnknowns() {
+		failures = append(failures, p.CheckFailure{Property: "checks", Reason: "at least one check is required"})
+	}
+	for _, check := range args.Checks {
+		if !strings.HasPrefix(check.Path, "/") {
+			failures = append(failures, p.CheckFailure{Property: "checks", Reason: fmt.Sprintf("check path '%s' must start with a slash", check.Path)})
+		}
+		if !slices.Contains(SmokeTestMethods, check.Method) {
+			failures = append(failures, p.CheckFailure{Property: "checks", Reason: fmt.Sprintf("unsupported method '%s' (expected one of %v)", check.Method, SmokeTestMethods)})
+		}
+		if _, err := regexp.Compile(check.ExpectedBody); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "checks", Reason: fmt.Sprintf("invalid expected body regular expression '%s': %s", check.ExpectedBody, err)})
+		}
+		if check.JSONValue != "" && check.JSONPath == "" {
+			failures = append(failures, p.CheckFailure{Property: "checks", Reason: fmt.Sprintf("JSON value of check '%s' requires JSON path to be set", check.Path)})
+		}
+	}
+	return args, failures, nil
+}
+
+// runSmokeTest performs the checks and records the results in the state. Failed checks fail the operation,
+// but the results already collected are kept in the state, so they could be inspected.
+func runSmokeTest(ctx p.Context, state *SmokeTestState) error {
+	sc, err := connectSmokeTest(ctx, state.SmokeTestArgs, state.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer sc.Close()
+
+	results, err := sc.run()
+	return recordSmokeTestResults(state, results, err)
+}
+
+// recordSmokeTestResults updates the state with the results of the checks and reports the failure of the smoke test (if any).
+func recordSmokeTestResults(state *SmokeTestState, results []SmokeTestResult, err error) error {
+	if err != nil && len(results) == 0 {
+		return err
+	}
+	state.Results = results
+	state.Passed = err == nil && !slices.ContainsFunc(results, func(r SmokeTestResult) bool { return !r.Passed })
+	if err != nil {
+		return partialStateError(err)
+	}
+	if !state.Passed {
+		var messages []string
+		for _, result := range results {
+			if !result.Passed {
+				messages = append(messages, fmt.Sprintf("instance '%s', check '%s': %s", result.InstanceID, result.Check, result.Message))
+			}
+		}
+		return partialStateError(fmt.Errorf("smoke test failed:\n%s", strings.Join(messages, "\n")))
+	}
+	return nil
+}
+
+func connectSmokeTest(ctx p.Context, model SmokeTestArgs, timeout string) (*SmokeTestClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &SmokeTestClient{tc.cl, ctx, model}, nil
+}
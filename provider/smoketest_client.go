@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type SmokeTestClient ClientContext[SmokeTestArgs]
+
+func (sc *SmokeTestClient) Close() error {
+	return sc.cl.Disconnect()
+}
+
+func (sc *SmokeTestClient) target() *TargetClient {
+	return &TargetClient{sc.cl, sc.ctx, sc.data.TargetArgs}
+}
+
+// run performs all checks on the target instances. Failed checks are reported in the results, errors are returned only
+// when the checks could not be performed at all.
+func (sc *SmokeTestClient) run() ([]SmokeTestResult, error) {
+	instances, err := sc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var results []SmokeTestResult
+	for _, instance := range instances {
+		for _, check := range sc.data.Checks {
+			result, err := sc.check(instance, check)
+			if err != nil {
+				return results, err
+			}
+			if result.Passed {
+				sc.ctx.Logf(diag.Info, "Smoke test check '%s' passed on instance '%s'", result.Check, instance.ID)
+			} else {
+				sc.ctx.Logf(diag.Warning, "Smoke test check '%s' failed on instance '%s': %s", result.Check, instance.ID, result.Message)
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func (sc *SmokeTestClient) check(instance InstanceConfig, check SmokeTestCheck) (SmokeTestResult, error) {
+	name := check.Name
+	if name == "" {
+		name = check.Method + " " + check.Path
+	}
+	result := SmokeTestResult{InstanceID: instance.ID, Check: name}
+	if check.Auth != nil {
+		instance.User = check.Auth.User
+		instance.Password = check.Auth.Password
+	}
+	response, err := sc.target().request(instance, check.Method, check.Path, nil)
+	if err != nil {
+		return result, err
+	}
+	result.Status = response.Status
+	result.Message = smokeTestMismatch(check, response)
+	result.Passed = result.Message == ""
+	return result, nil
+}
+
+// smokeTestMismatch describes the first expectation not met by the response or returns empty string.
+func smokeTestMismatch(check SmokeTestCheck, response *HTTPResponse) string {
+	if response.Status != check.ExpectedStatus {
+		return fmt.Sprintf("unexpected HTTP status %d (expected %d)", response.Status, check.ExpectedStatus)
+	}
+	if check.ExpectedBody != "" {
+		matched, err := regexp.Match(check.ExpectedBody, response.Body)
+		if err != nil {
+			return fmt.Sprintf("invalid expected body regular expression: %s", err)
+		}
+		if !matched {
+			return fmt.Sprintf("response body does not match '%s'", check.ExpectedBody)
+		}
+	}
+	if check.JSONPath != "" {
+		var data any
+		if err := json.Unmarshal(response.Body, &data); err != nil {
+			return fmt.Sprintf("response body is not a valid JSON: %s", err)
+		}
+		value, ok := jsonPathValue(data, check.JSONPath)
+		if !ok {
+			return fmt.Sprintf("JSON path '%s' not found in response body", check.JSONPath)
+		}
+		if check.JSONValue != "" && fmt.Sprint(value) != check.JSONValue {
+			return fmt.Sprintf("unexpected value '%v' at JSON path '%s' (expected '%s')", value, check.JSONPath, check.JSONValue)
+		}
+	}
+	return ""
+}
+
+// jsonPathValue finds the value at the dot-separated path with optional array indexes (e.g. 'items[0].title').
+func jsonPathValue(data any, path string) (any, bool) {
+	current := data
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "$."), ".") {
+		key := segment
+		var indexes []string
+		if i := strings.Index(segment, "["); i >= 0 {
+			key = segment[:i]
+			indexes = strings.Split(strings.TrimSuffix(segment[i+1:], "]"), "][")
+		}
+		if key != "" {
+			object, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if current, ok = object[key]; !ok {
+				return nil, false
+			}
+		}
+		for _, index := range indexes {
+			array, ok := current.([]any)
+			if !ok {
+				return nil, false
+			}
+			i, err := strconv.Atoi(index)
+			if err != nil || i < 0 || i >= len(array) {
+				return nil, false
+			}
+			current = array[i]
+		}
+	}
+	return current, true
+}
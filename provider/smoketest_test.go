@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPathValue(t *testing.T) {
+	data := map[string]any{
+		"status": "ok",
+		"items": []any{
+			map[string]any{"title": "first", "tags": []any{"a", "b"}},
+			map[string]any{"title": "second"},
+		},
+		"count": float64(2),
+	}
+	tests := []struct {
+		path     string
+		expected any
+		found    bool
+	}{
+		{"status", "ok", true},
+		{"$.status", "ok", true},
+		{"count", float64(2), true},
+		{"items[1].title", "second", true},
+		{"items[0].tags[1]", "b", true},
+		{"items[2].title", nil, false},
+		{"items[-1]", nil, false},
+		{"items[x]", nil, false},
+		{"status.value", nil, false},
+		{"missing", nil, false},
+		{"status[0]", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			value, found := jsonPathValue(data, test.path)
+
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestSmokeTestMismatch(t *testing.T) {
+	body := []byte(`{"status":"ok","items":[{"title":"Home"}],"count":1}`)
+	tests := []struct {
+		name     string
+		check    SmokeTestCheck
+		response HTTPResponse
+		expected string
+	}{
+		{"passed", SmokeTestCheck{ExpectedStatus: http.StatusOK}, HTTPResponse{http.StatusOK, body}, ""},
+		{"status", SmokeTestCheck{ExpectedStatus: http.StatusOK}, HTTPResponse{http.StatusNotFound, body}, "unexpected HTTP status 404 (expected 200)"},
+		{"body matched", SmokeTestCheck{ExpectedStatus: http.StatusOK, ExpectedBody: `"status":\s*"ok"`}, HTTPResponse{http.StatusOK, body}, ""},
+		{"body not matched", SmokeTestCheck{ExpectedStatus: http.StatusOK, ExpectedBody: "error"}, HTTPResponse{http.StatusOK, body}, "response body does not match 'error'"},
+		{"body invalid pattern", SmokeTestCheck{ExpectedStatus: http.StatusOK, ExpectedBody: "("}, HTTPResponse{http.StatusOK, body}, "invalid expected body regular expression: error parsing regexp: missing closing ): `(`"},
+		{"JSON invalid", SmokeTestCheck{ExpectedStatus: http.StatusOK, JSONPath: "status"}, HTTPResponse{http.StatusOK, []byte("<html>")}, "response body is not a valid JSON: invalid character '<' looking for beginning of value"},
+		{"JSON path found", SmokeTestCheck{ExpectedStatus: http.StatusOK, JSONPath: "items[0].title"}, HTTPResponse{http.StatusOK, body}, ""},
+		{"JSON path not found", SmokeTestCheck{ExpectedStatus: http.StatusOK, JSONPath: "items[1].title"}, HTTPResponse{http.StatusOK, body}, "JSON path 'items[1].title' not found in response body"},
+		{"JSON value matched", SmokeTestCheck{ExpectedStatus: http.StatusOK, JSONPath: "count", JSONValue: "1"}, HTTPResponse{http.StatusOK, body}, ""},
+		{"JSON value not matched", SmokeTestCheck{ExpectedStatus: http.StatusOK, JSONPath: "status", JSONValue: "failed"}, HTTPResponse{http.StatusOK, body}, "unexpected value 'ok' at JSON path 'status' (expected 'failed')"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, smokeTestMismatch(test.check, &test.response))
+		})
+	}
+}
+
+func TestRecordSmokeTestResults(t *testing.T) {
+	passed := SmokeTestResult{InstanceID: "local_author", Check: "GET /", Passed: true, Status: http.StatusOK}
+	failed := SmokeTestResult{InstanceID: "local_publish", Check: "GET /", Status: http.StatusNotFound, Message: "unexpected HTTP status 404 (expected 200)"}
+
+	t.Run("passed", func(t *testing.T) {
+		state := SmokeTestState{}
+
+		err := recordSmokeTestResults(&state, []SmokeTestResult{passed}, nil)
+
+		require.NoError(t, err)
+		assert.True(t, state.Passed)
+		assert.Equal(t, []SmokeTestResult{passed}, state.Results)
+	})
+	t.Run("check failed", func(t *testing.T) {
+		state := SmokeTestState{}
+
+		err := recordSmokeTestResults(&state, []SmokeTestResult{passed, failed}, nil)
+
+		var initErr infer.ResourceInitFailedError
+		require.ErrorAs(t, err, &initErr)
+		assert.Equal(t, []string{"smoke test failed:\ninstance 'local_publish', check 'GET /': unexpected HTTP status 404 (expected 200)"}, initErr.Reasons)
+		assert.False(t, state.Passed)
+		assert.Equal(t, []SmokeTestResult{passed, failed}, state.Results)
+	})
+	t.Run("interrupted", func(t *testing.T) {
+		state := SmokeTestState{}
+
+		err := recordSmokeTestResults(&state, []SmokeTestResult{passed}, errors.New("connection lost"))
+
+		var initErr infer.ResourceInitFailedError
+		require.ErrorAs(t, err, &initErr)
+		assert.Equal(t, []string{"connection lost"}, initErr.Reasons)
+		assert.False(t, state.Passed)
+		assert.Equal(t, []SmokeTestResult{passed}, state.Results)
+	})
+	t.Run("not performed", func(t *testing.T) {
+		state := SmokeTestState{Passed: true, Results: []SmokeTestResult{passed}}
+
+		err := recordSmokeTestResults(&state, nil, errors.New("connection lost"))
+
+		assert.EqualError(t, err, "connection lost")
+		assert.True(t, state.Passed)
+		assert.Equal(t, []SmokeTestResult{passed}, state.Results)
+	})
+}
@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+	"github.com/wttech/pulumi-aem/provider/client"
+	"github.com/wttech/pulumi-aem/provider/utils"
+	"golang.org/x/exp/slices"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TargetArgs points to the machine and AEM instances set up by the 'Instance' resource.
+type TargetArgs struct {
+	Client      Client   `pulumi:"client"`
+	System      *System  `pulumi:"system,optional"`
+	Compose     *Compose `pulumi:"compose,optional"`
+	InstanceIDs []string `pulumi:"instance_ids,optional"`
+}
+
+func (m *TargetArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Client, "Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.System, "Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.Compose, "AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.InstanceIDs, "Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.")
+}
+
+func setTargetDefaults(allInputs resource.PropertyMap) {
+	setClientDefaults(allInputs)
+	setSystemDefaults(allInputs)
+	setComposeDefaults(allInputs)
+	setDefaultValue(allInputs, "instance_ids", resource.NewArrayProperty([]resource.PropertyValue{}))
+}
+
+func validateTarget(inputs resource.PropertyMap, model TargetArgs) []p.CheckFailure {
+	if inputs["client"].ContainsUnknowns() {
+		return nil
+	}
+	return validateClient(model.Client)
+}
+
+// partialStateError reports the failure while keeping the state returned along with it (e.g. outputs of the instances
+// already processed), so that it is not lost and the next update could continue from it.
+func partialStateError(err error) error {
+	return infer.ResourceInitFailedError{Reasons: []string{err.Error()}}
+}
+
+// connectTargetFunction connects to the target of the provider function.
+func connectTargetFunction(ctx p.Context, model TargetArgs) (*TargetClient, error) {
+	model, err := targetFunctionArgs(model)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := connectTarget(ctx, model, cast.ToDuration(model.Client.ActionTimeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return tc, nil
+}
+
+// targetFunctionArgs applies the same defaults and validation as for the resource inputs, because functions do not have Check phase.
+func targetFunctionArgs(model TargetArgs) (TargetArgs, error) {
+	inputs := resource.NewPropertyMap(model)
+	// unset inputs of functions are passed as zero values, so they need to be removed for the defaults to be applied
+	deleteEmptyStrings(inputs)
+	setTargetDefaults(inputs)
+	model, failures, err := infer.DefaultCheck[TargetArgs](inputs)
+	if err != nil {
+		return model, err
+	}
+	failures = append(failures, validateTarget(inputs, model)...)
+	if len(failures) > 0 {
+		return model, fmt.Errorf("invalid '%s': %s", failures[0].Property, failures[0].Reason)
+	}
+	return model, nil
+}
+
+func deleteEmptyStrings(inputs resource.PropertyMap) {
+	for key, value := range inputs {
+		switch {
+		case value.IsString() && value.StringValue() == "":
+			delete(inputs, key)
+		case value.IsObject():
+			deleteEmptyStrings(value.ObjectValue())
+		}
+	}
+}
+
+type TargetClient ClientContext[TargetArgs]
+
+func connectTarget(ctx p.Context, model TargetArgs, timeout time.Duration) (*TargetClient, error) {
+	cl, err := connectClient(ctx, client.ClientManagerDefault, model.Client, model.System, model.Compose, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetClient{cl, ctx, model}, nil
+}
+
+func (tc *TargetClient) Close() error {
+	return tc.cl.Disconnect()
+}
+
+// removedInstances determines the instances targeted previously but not anymore. Previous instances are resolved against
+// the previous configuration, because they could be removed from the current one or deactivated.
+func (tc *TargetClient) removedInstances(olds TargetArgs) ([]InstanceConfig, error) {
+	if olds.Compose == nil {
+		olds.Compose = tc.data.Compose
+	}
+	oldInstances, err := (&TargetClient{tc.cl, tc.ctx, olds}).instances()
+	if err != nil {
+		return nil, err
+	}
+	newInstances, err := tc.instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []InstanceConfig
+	for _, instance := range oldInstances {
+		if !slices.ContainsFunc(newInstances, func(c InstanceConfig) bool { return c.ID == instance.ID }) {
+			result = append(result, instance)
+		}
+	}
+	return result, nil
+}
+
+func (tc *TargetClient) dataDir() string {
+	return tc.data.System.DataDir
+}
+
+// serviceUser is the user running the AEM instances, by default the one used to connect to the machine.
+func (tc *TargetClient) serviceUser() string {
+	user := tc.data.System.User
+	if user == "" {
+		user = tc.cl.Connection().User()
+	}
+	return user
+}
+
+// unpackDir is the directory in which AEM Compose CLI unpacks the instances. Relative directory configured
+// in AEM Compose YML is resolved against the data directory like in the CLI.
+func (tc *TargetClient) unpackDir() (string, error) {
+	configYAML, err := composeConfigYAML(tc.data.Compose)
+	if err != nil {
+		return "", err
+	}
+	unpackDir, err := parseInstanceUnpackDir(configYAML)
+	if err != nil {
+		return "", err
+	}
+	if !path.IsAbs(unpackDir) {
+		unpackDir = path.Join(tc.dataDir(), unpackDir)
+	}
+	return unpackDir, nil
+}
+
+// instanceDir is the directory in which AEM Compose CLI unpacks the instance (containing 'crx-quickstart').
+func (tc *TargetClient) instanceDir(instanceID string) (string, error) {
+	unpackDir, err := tc.unpackDir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(unpackDir, instanceID), nil
+}
+
+// instances determines the active AEM instances matching the target instance IDs.
+func (tc *TargetClient) instances() ([]InstanceConfig, error) {
+	configYAML, err := composeConfigYAML(tc.data.Compose)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := parseInstanceConfigs(configYAML)
+	if err != nil {
+		return nil, err
+	}
+	var result []InstanceConfig
+	for _, config := range configs {
+		if config.IsActive() && (len(tc.data.InstanceIDs) == 0 || slices.Contains(tc.data.InstanceIDs, config.ID)) {
+			result = append(result, config)
+		}
+	}
+	for _, id := range tc.data.InstanceIDs {
+		if !slices.ContainsFunc(result, func(c InstanceConfig) bool { return c.ID == id }) {
+			return nil, fmt.Errorf("AEM instance '%s' is not defined in the configuration or is inactive", id)
+		}
+	}
+	return result, nil
+}
+
+// runAemw executes the AEM Compose CLI command against the given instance.
+func (tc *TargetClient) runAemw(instanceID string, args ...string) ([]byte, error) {
+	var quoted []string
+	for _, arg := range args {
+		quoted = append(quoted, utils.ShellQuote(arg))
+	}
+	cmd := fmt.Sprintf("sh aemw %s --instance-id %s", strings.Join(quoted, " "), utils.ShellQuote(instanceID))
+	out, err := tc.cl.RunShellScript(scriptName("aemw"), cmd, tc.dataDir())
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute AEM Compose CLI command '%s': %w", cmd, err)
+	}
+	return out, nil
+}
+
+// download fetches the file from the URL directly on the machine.
+func (tc *TargetClient) download(fileURL string, remotePath string) error {
+	tc.ctx.Logf(diag.Info, "Downloading file '%s'", fileURL)
+	if err := tc.cl.DirEnsure(path.Dir(remotePath)); err != nil {
+		return err
+	}
+	cmd := fmt.Sprintf("curl -sfL %s -o %s", utils.ShellQuote(fileURL), utils.ShellQuote(remotePath))
+	if _, err := tc.cl.RunShellScript(scriptName("download"), cmd, "."); err != nil {
+		return fmt.Errorf("unable to download file '%s': %w", fileURL, err)
+	}
+	return nil
+}
+
+// verifyChecksum compares SHA-256 checksum of the file on the machine, skipped when the expected one is not set.
+func (tc *TargetClient) verifyChecksum(remotePath string, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+	out, err := tc.cl.RunShellPurely(fmt.Sprintf("sha256sum %s", utils.ShellQuote(remotePath)))
+	if err != nil {
+		return fmt.Errorf("unable to compute checksum of file '%s': %w", remotePath, err)
+	}
+	actual := strings.Fields(string(out))
+	if len(actual) == 0 || !strings.EqualFold(actual[0], checksum) {
+		return fmt.Errorf("checksum mismatch of file '%s' (expected '%s', actual '%s')", remotePath, checksum, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+type HTTPResponse struct {
+	Status int
+	Body   []byte
+}
+
+// request performs HTTP request to the AEM instance using curl executed on the machine or directly through the forwarded port.
+// Credentials of the instance are used for authentication unless the user is empty (anonymous request).
+func (tc *TargetClient) request(instance InstanceConfig, method string, path string, form url.Values) (*HTTPResponse, error) {
+	if tc.data.Client.PortForward {
+		return tc.requestForwarded(instance, method, path, form)
+	}
+	var sb strings.Builder
+	if method == http.MethodHead {
+		// curl waits for the body when HEAD is only set as the method, so it needs to be requested explicitly
+		sb.WriteString("curl -s -S -I -o /dev/null -w '\\n%{http_code}'")
+	} else {
+		sb.WriteString(fmt.Sprintf("curl -s -S -X %s -w '\\n%%{http_code}'", method))
+	}
+	if instance.User != "" {
+		sb.WriteString(fmt.Sprintf(" -u %s", utils.ShellQuote(instance.User+":"+instance.Password)))
+	}
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range form[key] {
+			sb.WriteString(fmt.Sprintf(" --data-urlencode %s", utils.ShellQuote(key+"="+value)))
+		}
+	}
+	sb.WriteString(" " + utils.ShellQuote(strings.TrimSuffix(instance.HTTPURL, "/")+path))
+	out, err := tc.cl.RunShellScript(scriptName("curl"), sb.String(), ".")
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform HTTP request '%s %s' on AEM instance '%s': %w", method, path, instance.ID, err)
+	}
+	text := strings.TrimRight(string(out), "\n")
+	idx := strings.LastIndex(text, "\n")
+	status, err := strconv.Atoi(strings.TrimSpace(text[idx+1:]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read HTTP status of request '%s %s' on AEM instance '%s': %s", method, path, instance.ID, text)
+	}
+	body := ""
+	if idx >= 0 {
+		body = text[:idx]
+	}
+	return &HTTPResponse{Status: status, Body: []byte(body)}, nil
+}
+
+// requestForwarded performs HTTP request to the AEM instance from the provider process through the tunnel opened by the connection.
+// Redirects are not followed, so the responses are the same as when the request is performed using curl on the machine.
+func (tc *TargetClient) requestForwarded(instance InstanceConfig, method string, path string, form url.Values) (*HTTPResponse, error) {
+	requestURL, err := url.Parse(strings.TrimSuffix(instance.HTTPURL, "/") + path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse HTTP URL of AEM instance '%s': %w", instance.ID, err)
+	}
+	remoteAddr := requestURL.Host
+	if requestURL.Port() == "" {
+		port := "80"
+		if requestURL.Scheme == "https" {
+			port = "443"
+		}
+		remoteAddr = net.JoinHostPort(requestURL.Hostname(), port)
+	}
+	localAddr, err := tc.cl.Tunnel(remoteAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to forward port of AEM instance '%s': %w", instance.ID, err)
+	}
+	host, hostname := requestURL.Host, requestURL.Hostname()
+	requestURL.Host = localAddr
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), forwardedServerNameKey{}, hostname), cast.ToDuration(tc.data.Client.ActionTimeout))
+	defer cancel()
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, requestURL.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare HTTP request '%s %s' on AEM instance '%s': %w", method, path, instance.ID, err)
+	}
+	req.Host = host
+	if instance.User != "" {
+		req.SetBasicAuth(instance.User, instance.Password)
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	res, err := forwardedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform HTTP request '%s %s' on AEM instance '%s': %w", method, path, instance.ID, err)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read HTTP response of request '%s %s' on AEM instance '%s': %w", method, path, instance.ID, err)
+	}
+	return &HTTPResponse{Status: res.StatusCode, Body: data}, nil
+}
+
+type forwardedServerNameKey struct{}
+
+// forwardedHTTPClient is shared by all forwarded requests, so the connections are reused. They are pooled per local tunnel address,
+// while TLS is verified against the original host name of the instance passed in the request context.
+var forwardedHTTPClient = &http.Client{
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+	Transport: &http.Transport{
+		DialTLSContext: func(ctx context.Context, network string, addr string) (net.Conn, error) {
+			conn, err := (&net.Dialer{}).DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			serverName, _ := ctx.Value(forwardedServerNameKey{}).(string)
+			tlsConn := tls.Client(conn, &tls.Config{ServerName: serverName})
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return tlsConn, nil
+		},
+		MaxIdleConnsPerHost: 4,
+		IdleConnTimeout:     90 * time.Second,
+	},
+}
+
+// scriptName generates unique name of temporary script so resources operating on the same machine do not clash.
+func scriptName(prefix string) string {
+	return fmt.Sprintf("%s-%d", prefix, time.Now().UnixNano())
+}
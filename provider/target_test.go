@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemovedInstances(t *testing.T) {
+	oldConfig := `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+    local_publish:
+      http_url: http://127.0.0.1:4503
+`
+	newConfig := `instance:
+  config:
+    local_author:
+      http_url: http://127.0.0.1:4502
+    local_publish:
+      active: false
+      http_url: http://127.0.0.1:4503
+`
+	tests := []struct {
+		name     string
+		olds     TargetArgs
+		news     TargetArgs
+		expected []string
+	}{
+		{
+			name:     "instance deactivated",
+			olds:     TargetArgs{Compose: &Compose{Config: oldConfig}},
+			news:     TargetArgs{Compose: &Compose{Config: newConfig}},
+			expected: []string{"local_publish"},
+		},
+		{
+			name:     "instance removed from configuration and target",
+			olds:     TargetArgs{Compose: &Compose{Config: oldConfig}, InstanceIDs: []string{"local_publish"}},
+			news:     TargetArgs{Compose: &Compose{Config: "instance:\n  config:\n    local_author:\n      http_url: http://127.0.0.1:4502\n"}, InstanceIDs: []string{"local_author"}},
+			expected: []string{"local_publish"},
+		},
+		{
+			name:     "instance removed from target only",
+			olds:     TargetArgs{Compose: &Compose{Config: oldConfig}},
+			news:     TargetArgs{Compose: &Compose{Config: oldConfig}, InstanceIDs: []string{"local_author"}},
+			expected: []string{"local_publish"},
+		},
+		{
+			name:     "previous configuration not recorded",
+			olds:     TargetArgs{InstanceIDs: []string{"local_author"}},
+			news:     TargetArgs{Compose: &Compose{Config: oldConfig}},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := &TargetClient{nil, newTestContext(t), test.news}
+
+			removed, err := tc.removedInstances(test.olds)
+
+			require.NoError(t, err)
+			var ids []string
+			for _, instance := range removed {
+				ids = append(ids, instance.ID)
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func TestTargetFunctionArgs(t *testing.T) {
+	model, err := targetFunctionArgs(TargetArgs{
+		Client:      Client{Type: "aws-ssm", Settings: map[string]string{"instance_id": "i-0123456789"}},
+		System:      &System{DataDir: "/data/aemc"},
+		InstanceIDs: []string{"local_author"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "10m", model.Client.ActionTimeout)
+	assert.Equal(t, "/data/aemc", model.System.DataDir)
+	assert.Equal(t, "/tmp/aemc", model.System.WorkDir)
+	require.NotNil(t, model.Compose)
+	assert.Equal(t, "1.6.12", model.Compose.Version)
+	assert.Equal(t, ConfigListsReplace, model.Compose.ConfigListsStrategy)
+	assert.NotEmpty(t, model.Compose.Config)
+	assert.Equal(t, []string{"local_author"}, model.InstanceIDs)
+}
+
+func TestTargetFunctionArgsInvalidClient(t *testing.T) {
+	_, err := targetFunctionArgs(TargetArgs{Client: Client{Type: "ssh", Settings: map[string]string{"host": "x.x.x.x"}}})
+
+	assert.ErrorContains(t, err, "invalid 'client.settings.")
+}
+
+func TestRequestForwarded(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		requests = append(requests, r)
+		if r.URL.Path == "/" {
+			http.Redirect(w, r, "/libs/granite/core/content/login.html", http.StatusFound)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer server.Close()
+	cl, conn := newFakeClient(nil)
+	conn.tunnels = map[string]string{"aem.internal:4502": server.Listener.Addr().String()}
+	tc := &TargetClient{cl, newTestContext(t), TargetArgs{Client: Client{PortForward: true, ActionTimeout: "10s"}}}
+	instance := InstanceConfig{ID: "remote_author", HTTPURL: "http://aem.internal:4502", User: "admin", Password: "secret"}
+
+	response, err := tc.request(instance, http.MethodGet, "/", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusFound, response.Status)
+	require.Len(t, requests, 1, "redirect should not be followed")
+	assert.Equal(t, "aem.internal:4502", requests[0].Host)
+	user, password, _ := requests[0].BasicAuth()
+	assert.Equal(t, "admin", user)
+	assert.Equal(t, "secret", password)
+
+	response, err = tc.request(instance, http.MethodPost, "/bin/test", url.Values{"key": {"value"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Status)
+	assert.Equal(t, "OK", string(response.Body))
+	assert.Equal(t, "value", requests[1].PostForm.Get("key"))
+}
+
+func TestRequestHead(t *testing.T) {
+	var scripts []string
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		scripts = append(scripts, script)
+		return "\n200", nil
+	})
+	tc := &TargetClient{cl, newTestContext(t), TargetArgs{}}
+	instance := InstanceConfig{ID: "local_author", HTTPURL: "http://127.0.0.1:4502", User: "admin", Password: "admin"}
+
+	response, err := tc.request(instance, http.MethodHead, "/libs/granite/core/content/login.html", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Status)
+	assert.Empty(t, response.Body)
+	require.Len(t, scripts, 1)
+	assert.Contains(t, scripts[0], "curl -s -S -I -o /dev/null")
+	assert.NotContains(t, scripts[0], "-X HEAD", "curl would wait for a body which is never sent")
+}
+
+func TestRequestForwardedTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+	}))
+	defer server.Close()
+	cl, conn := newFakeClient(nil)
+	conn.tunnels = map[string]string{"aem.internal:4502": server.Listener.Addr().String()}
+	tc := &TargetClient{cl, newTestContext(t), TargetArgs{Client: Client{PortForward: true, ActionTimeout: "100ms"}}}
+
+	_, err := tc.request(InstanceConfig{ID: "remote_author", HTTPURL: "http://aem.internal:4502"}, http.MethodGet, "/", nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInstanceDir(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+	}{
+		{"default", "instance:\n  config: {}\n", "/data/aemc/aem/home/var/instance/local_author"},
+		{"relative unpack directory", "instance:\n  local:\n    unpack_dir: aem/runtime\n", "/data/aemc/aem/runtime/local_author"},
+		{"absolute unpack directory", "instance:\n  local:\n    unpack_dir: /mnt/aem/\n", "/mnt/aem/local_author"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := &TargetClient{nil, newTestContext(t), TargetArgs{System: &System{DataDir: "/data/aemc"}, Compose: &Compose{Config: test.config}}}
+
+			dir, err := tc.instanceDir("local_author")
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, dir)
+		})
+	}
+}
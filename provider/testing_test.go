@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/wttech/pulumi-aem/provider/client"
+)
+
+// fakeConnection simulates the machine by keeping the copied files in memory and answering the commands using the handler.
+type fakeConnection struct {
+	files    map[string]string
+	commands []string
+	tunnels  map[string]string
+	handler  func(cmd string, script string) (string, error)
+}
+
+func (f *fakeConnection) Info() string      { return "fake" }
+func (f *fakeConnection) User() string      { return "ec2-user" }
+func (f *fakeConnection) Connect() error    { return nil }
+func (f *fakeConnection) Disconnect() error { return nil }
+
+func (f *fakeConnection) Command(cmdLine []string) ([]byte, error) {
+	cmd := strings.Trim(cmdLine[len(cmdLine)-1], `"`)
+	f.commands = append(f.commands, cmd)
+	if strings.HasPrefix(cmd, "mv ") {
+		paths := strings.Fields(cmd)
+		f.files[paths[2]] = f.files[paths[1]]
+		delete(f.files, paths[1])
+		return nil, nil
+	}
+	if strings.HasPrefix(cmd, "rm -rf ") {
+		for _, path := range strings.Fields(cmd)[2:] {
+			delete(f.files, path)
+		}
+		return nil, nil
+	}
+	if strings.HasPrefix(cmd, "mkdir -p ") {
+		return nil, nil
+	}
+	script := ""
+	if i := strings.LastIndex(cmd, "sh "); i >= 0 {
+		script = f.files[strings.TrimSpace(cmd[i+3:])]
+	}
+	if f.handler == nil {
+		return nil, nil
+	}
+	out, err := f.handler(cmd, script)
+	return []byte(out), err
+}
+
+func (f *fakeConnection) CopyFile(localPath string, remotePath string) error {
+	content, err := os.ReadFile(localPath)
+	if err != nil {
+		return err
+	}
+	f.files[remotePath] = string(content)
+	return nil
+}
+
+func (f *fakeConnection) Tunnel(remoteAddr string) (string, error) {
+	localAddr, ok := f.tunnels[remoteAddr]
+	if !ok {
+		return "", fmt.Errorf("fake: cannot forward address '%s'", remoteAddr)
+	}
+	return localAddr, nil
+}
+
+// executed checks if any command containing the given text was run.
+func (f *fakeConnection) executed(text string) bool {
+	for _, cmd := range f.commands {
+		if strings.Contains(cmd, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func newFakeClient(handler func(cmd string, script string) (string, error)) (*client.Client, *fakeConnection) {
+	conn := &fakeConnection{files: map[string]string{}, handler: handler}
+	cl := client.NewClient("fake", map[string]string{}, conn)
+	cl.WorkDir = "/tmp/aemc"
+	return cl, conn
+}
+
+// testContext forwards the logs to the test output.
+type testContext struct {
+	context.Context
+	t *testing.T
+}
+
+func newTestContext(t *testing.T) p.Context {
+	return testContext{context.Background(), t}
+}
+
+func (c testContext) Log(severity diag.Severity, msg string) {
+	c.t.Logf("%s: %s", severity, msg)
+}
+
+func (c testContext) Logf(severity diag.Severity, msg string, args ...any) {
+	c.t.Logf("%s: "+msg, append([]any{severity}, args...)...)
+}
+
+func (c testContext) LogStatus(severity diag.Severity, msg string) {
+	c.Log(severity, msg)
+}
+
+func (c testContext) LogStatusf(severity diag.Severity, msg string, args ...any) {
+	c.Logf(severity, msg, args...)
+}
+
+func (c testContext) RuntimeInformation() p.RunInfo {
+	return p.RunInfo{}
+}
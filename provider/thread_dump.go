@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type ThreadDump struct{}
+
+type ThreadDumpArgs struct {
+	Client     Client   `pulumi:"client"`
+	System     *System  `pulumi:"system,optional"`
+	Compose    *Compose `pulumi:"compose,optional"`
+	InstanceID string   `pulumi:"instance_id"`
+}
+
+func (m *ThreadDumpArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Client, "Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.System, "Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.Compose, "AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.")
+	a.Describe(&m.InstanceID, "Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').")
+}
+
+type ThreadDumpResult struct {
+	Content string `pulumi:"content"`
+}
+
+func (m *ThreadDumpResult) Annotate(a infer.Annotator) {
+	a.Describe(&m.Content, "Thread dump of the AEM instance JVM.")
+}
+
+func (m *ThreadDump) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.")
+}
+
+func (ThreadDump) Call(ctx p.Context, input ThreadDumpArgs) (ThreadDumpResult, error) {
+	var result ThreadDumpResult
+	tc, err := connectTargetFunction(ctx, TargetArgs{Client: input.Client, System: input.System, Compose: input.Compose, InstanceIDs: []string{input.InstanceID}})
+	if err != nil {
+		return result, err
+	}
+	defer tc.Close()
+
+	if _, err := tc.instances(); err != nil {
+		return result, err
+	}
+	result.Content, err = tc.threadDump(input.InstanceID)
+	return result, err
+}
+
+func (tc *TargetClient) threadDump(instanceID string) (string, error) {
+	dir, err := tc.instanceDir(instanceID)
+	if err != nil {
+		return "", err
+	}
+	out, err := tc.cl.RunShellScript(scriptName("thread-dump"), threadDumpScript(dir, tc.serviceUser()), ".")
+	if err != nil {
+		return "", fmt.Errorf("unable to take thread dump of AEM instance '%s': %w", instanceID, err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wttech/pulumi-aem/provider/instance"
+)
+
+func TestThreadDump(t *testing.T) {
+	var scripts []string
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		scripts = append(scripts, script)
+		return "Full thread dump\n", nil
+	})
+	tc := &TargetClient{cl, newTestContext(t), TargetArgs{System: &System{DataDir: "/data/aemc"}, Compose: &Compose{Config: instance.ConfigYML}}}
+
+	content, err := tc.threadDump("local_publish")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Full thread dump\n", content)
+	require.Len(t, scripts, 1)
+	assert.Contains(t, scripts[0], "pid=$(cat '/data/aemc/aem/home/var/instance/local_publish/crx-quickstart/conf/cq.pid' 2>/dev/null)")
+	assert.Contains(t, scripts[0], "sudo -u 'ec2-user' jcmd \"$pid\" Thread.print")
+	assert.Contains(t, scripts[0], "sudo -u 'ec2-user' jstack \"$pid\"")
+}
+
+func TestThreadDumpServiceUser(t *testing.T) {
+	var scripts []string
+	cl, _ := newFakeClient(func(cmd string, script string) (string, error) {
+		scripts = append(scripts, script)
+		return "Full thread dump\n", nil
+	})
+	tc := &TargetClient{cl, newTestContext(t), TargetArgs{System: &System{DataDir: "/data/aemc", User: "aem"}, Compose: &Compose{Config: instance.ConfigYML}}}
+
+	_, err := tc.threadDump("local_author")
+
+	require.NoError(t, err)
+	require.Len(t, scripts, 1)
+	assert.Contains(t, scripts[0], "sudo -u 'aem' jcmd \"$pid\" Thread.print")
+	assert.NotContains(t, scripts[0], "ec2-user")
+}
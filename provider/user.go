@@ -0,0 +1,166 @@
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/cast"
+)
+
+type User struct{}
+
+type UserArgs struct {
+	TargetArgs
+	UserID           string   `pulumi:"user_id,optional" provider:"replaceOnChanges"`
+	Password         string   `pulumi:"password,optional" provider:"secret"`
+	System           bool     `pulumi:"system,optional" provider:"replaceOnChanges"`
+	IntermediatePath string   `pulumi:"intermediate_path,optional" provider:"replaceOnChanges"`
+	GivenName        string   `pulumi:"given_name,optional"`
+	FamilyName       string   `pulumi:"family_name,optional"`
+	Email            string   `pulumi:"email,optional"`
+	Groups           []string `pulumi:"groups,optional"`
+}
+
+func (m *UserArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.UserID, "Identifier of the user. By default, the resource name is used.")
+	a.Describe(&m.Password, "Password of the user. When empty, the user is not able to log in with a password.")
+	a.Describe(&m.System, "Creates the system (service) user, which has no password and is used by the code through service user mappings.")
+	a.Describe(&m.IntermediatePath, "Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.")
+	a.Describe(&m.GivenName, "Given name stored in the user profile.")
+	a.Describe(&m.FamilyName, "Family name stored in the user profile.")
+	a.Describe(&m.Email, "Email address stored in the user profile.")
+	a.Describe(&m.Groups, "Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.")
+}
+
+type UserInstanceModel struct {
+	ID     string   `pulumi:"id"`
+	Exists bool     `pulumi:"exists"`
+	Path   string   `pulumi:"path"`
+	Groups []string `pulumi:"groups"`
+}
+
+func (m *UserInstanceModel) Annotate(a infer.Annotator) {
+	a.Describe(&m.ID, "Unique identifier of AEM instance defined in the configuration.")
+	a.Describe(&m.Exists, "Indicates if the user exists on the AEM instance.")
+	a.Describe(&m.Path, "Repository path of the user on the AEM instance.")
+	a.Describe(&m.Groups, "Identifiers of the groups to which the user directly belongs on the AEM instance.")
+}
+
+type UserState struct {
+	UserArgs
+	Instances []UserInstanceModel `pulumi:"instances"`
+}
+
+func (m *UserState) Annotate(a infer.Annotator) {
+	a.Describe(&m.Instances, "Current state of the user on the target AEM instances.")
+}
+
+func (m *User) Annotate(a infer.Annotator) {
+	a.Describe(&m, "User created on the AEM instances along with its group membership. Deleted from the instances when the resource is deleted.")
+}
+
+func (User) Create(ctx p.Context, name string, input UserArgs, preview bool) (string, UserState, error) {
+	state := UserState{UserArgs: input}
+	if preview {
+		return name, state, nil
+	}
+	uc, err := connectUser(ctx, input, input.Client.ActionTimeout)
+	if err != nil {
+		return name, state, err
+	}
+	defer uc.Close()
+
+	if err := uc.save(nil); err != nil {
+		return name, state, err
+	}
+	state.Instances, err = uc.readInstances()
+	return name, state, err
+}
+
+func (User) Update(ctx p.Context, id string, olds UserState, news UserArgs, preview bool) (UserState, error) {
+	state := UserState{UserArgs: news, Instances: olds.Instances}
+	if preview {
+		return state, nil
+	}
+	uc, err := connectUser(ctx, news, news.Client.ActionTimeout)
+	if err != nil {
+		return state, err
+	}
+	defer uc.Close()
+
+	if err := uc.deleteFromRemovedInstances(olds); err != nil {
+		return state, err
+	}
+	if err := uc.save(&olds.UserArgs); err != nil {
+		return state, err
+	}
+	state.Instances, err = uc.readInstances()
+	return state, err
+}
+
+func (User) Delete(ctx p.Context, id string, props UserState) error {
+	uc, err := connectUser(ctx, props.UserArgs, props.Client.ActionTimeout)
+	if err != nil {
+		return err
+	}
+	defer uc.Close()
+
+	instances, err := uc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := uc.target().deleteAuthorizable(instance, props.UserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (User) Read(ctx p.Context, id string, inputs UserArgs, state UserState) (string, UserArgs, UserState, error) {
+	uc, err := connectUser(ctx, state.UserArgs, state.Client.StateTimeout)
+	if err != nil {
+		return id, inputs, state, err
+	}
+	defer uc.Close()
+
+	state.Instances, err = uc.readInstances()
+	if err != nil {
+		return id, inputs, state, err
+	}
+	// report drift so that the next update restores the desired membership
+	for _, instance := range state.Instances {
+		if !instance.Exists || len(authorizableIDsRemoved(state.Groups, instance.Groups)) > 0 {
+			ctx.Logf(diag.Warning, "User '%s' on instance '%s' differs from the desired one", state.UserID, instance.ID)
+			state.Groups = authorizableIDsKept(state.Groups, instance.Groups)
+			break
+		}
+	}
+	return id, inputs, state, nil
+}
+
+func (User) Check(ctx p.Context, name string, oldInputs, newInputs resource.PropertyMap) (UserArgs, []p.CheckFailure, error) {
+	setTargetDefaults(newInputs)
+	setDefaultValue(newInputs, "user_id", resource.NewStringProperty(name))
+	setDefaultValue(newInputs, "groups", resource.NewArrayProperty([]resource.PropertyValue{}))
+
+	args, failures, err := infer.DefaultCheck[UserArgs](newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	failures = validateTarget(newInputs, args.TargetArgs)
+	if args.System && args.Password != "" {
+		failures = append(failures, p.CheckFailure{Property: "password", Reason: "password cannot be set for system user"})
+	}
+	return args, failures, nil
+}
+
+func connectUser(ctx p.Context, model UserArgs, timeout string) (*UserClient, error) {
+	tc, err := connectTarget(ctx, model.TargetArgs, cast.ToDuration(timeout))
+	if err != nil {
+		ctx.Logf(diag.Error, "Unable to connect to AEM instance %s", err)
+		return nil, err
+	}
+	return &UserClient{tc.cl, ctx, model}, nil
+}
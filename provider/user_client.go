@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type UserClient ClientContext[UserArgs]
+
+func (uc *UserClient) Close() error {
+	return uc.cl.Disconnect()
+}
+
+func (uc *UserClient) target() *TargetClient {
+	return &TargetClient{uc.cl, uc.ctx, uc.data.TargetArgs}
+}
+
+func (uc *UserClient) profileForm() url.Values {
+	form := url.Values{}
+	form.Set("profile/givenName", uc.data.GivenName)
+	form.Set("profile/familyName", uc.data.FamilyName)
+	form.Set("profile/email", uc.data.Email)
+	return form
+}
+
+func (uc *UserClient) createForm() url.Values {
+	form := uc.profileForm()
+	if uc.data.System {
+		form.Set("createSystemUser", "")
+	} else {
+		form.Set("createUser", "")
+	}
+	form.Set("authorizableId", uc.data.UserID)
+	if uc.data.Password != "" {
+		form.Set("rep:password", uc.data.Password)
+	}
+	if uc.data.IntermediatePath != "" {
+		form.Set("intermediatePath", uc.data.IntermediatePath)
+	}
+	return form
+}
+
+func (uc *UserClient) updateForm(olds *UserArgs) url.Values {
+	form := uc.profileForm()
+	if uc.data.Password != "" && (olds == nil || olds.Password != uc.data.Password) {
+		form.Set("rep:password", uc.data.Password)
+	}
+	return form
+}
+
+// save creates the user or updates the existing one, then aligns its group membership.
+// Password is set only on creation or when it changed, so that it is not rewritten on each update.
+func (uc *UserClient) save(olds *UserArgs) error {
+	instances, err := uc.target().instances()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		path, err := uc.target().findAuthorizable(instance, uc.data.UserID)
+		if err != nil {
+			return err
+		}
+		if path == "" {
+			if err := uc.target().postForm(instance, authorizablesPath, uc.createForm(), fmt.Sprintf("Creating user '%s'", uc.data.UserID)); err != nil {
+				return err
+			}
+		} else {
+			if err := uc.target().postForm(instance, path+".rw.html", uc.updateForm(olds), fmt.Sprintf("Updating user '%s'", uc.data.UserID)); err != nil {
+				return err
+			}
+		}
+
+		var groupsRemoved []string
+		if olds != nil {
+			groupsRemoved = authorizableIDsRemoved(olds.Groups, uc.data.Groups)
+		}
+		for _, group := range uc.data.Groups {
+			if err := uc.target().updateMembership(instance, group, []string{uc.data.UserID}, nil); err != nil {
+				return err
+			}
+		}
+		for _, group := range groupsRemoved {
+			if err := uc.target().updateMembership(instance, group, nil, []string{uc.data.UserID}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (uc *UserClient) deleteFromRemovedInstances(olds UserState) error {
+	removedInstances, err := uc.target().removedInstances(olds.TargetArgs)
+	if err != nil {
+		return err
+	}
+	for _, instance := range removedInstances {
+		if err := uc.target().deleteAuthorizable(instance, olds.UserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *UserClient) readInstances() ([]UserInstanceModel, error) {
+	instances, err := uc.target().instances()
+	if err != nil {
+		return nil, err
+	}
+	var result []UserInstanceModel
+	for _, instance := range instances {
+		path, err := uc.target().findAuthorizable(instance, uc.data.UserID)
+		if err != nil {
+			return nil, err
+		}
+		model := UserInstanceModel{ID: instance.ID, Exists: path != "", Path: path}
+		if model.Exists {
+			model.Groups, err = uc.target().readAuthorizableIDs(instance, path, "declaredMemberOf")
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, model)
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserCreateForm(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     UserArgs
+		expected map[string]string
+		missing  []string
+	}{
+		{
+			name:     "regular user",
+			data:     UserArgs{UserID: "author", Password: "s3cret", IntermediatePath: "/home/users/acme"},
+			expected: map[string]string{"createUser": "", "authorizableId": "author", "rep:password": "s3cret", "intermediatePath": "/home/users/acme"},
+			missing:  []string{"createSystemUser"},
+		},
+		{
+			name:     "regular user without password",
+			data:     UserArgs{UserID: "author"},
+			expected: map[string]string{"createUser": "", "authorizableId": "author"},
+			missing:  []string{"rep:password", "intermediatePath"},
+		},
+		{
+			name:     "system user",
+			data:     UserArgs{UserID: "acme-service", System: true, IntermediatePath: "system/acme"},
+			expected: map[string]string{"createSystemUser": "", "authorizableId": "acme-service", "intermediatePath": "system/acme"},
+			missing:  []string{"createUser", "rep:password"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			form := (&UserClient{data: test.data}).createForm()
+
+			for key, value := range test.expected {
+				assert.Contains(t, form, key)
+				assert.Equal(t, value, form.Get(key))
+			}
+			for _, key := range test.missing {
+				assert.NotContains(t, form, key)
+			}
+		})
+	}
+}
+
+func TestUserUpdateForm(t *testing.T) {
+	tests := []struct {
+		name     string
+		olds     *UserArgs
+		news     UserArgs
+		password bool
+	}{
+		{"password unchanged", &UserArgs{Password: "s3cret"}, UserArgs{Password: "s3cret"}, false},
+		{"password changed", &UserArgs{Password: "s3cret"}, UserArgs{Password: "n3w"}, true},
+		{"password not recorded", nil, UserArgs{Password: "s3cret"}, true},
+		{"password removed", &UserArgs{Password: "s3cret"}, UserArgs{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			form := (&UserClient{data: test.news}).updateForm(test.olds)
+
+			assert.Equal(t, test.password, form.Has("rep:password"))
+		})
+	}
+}
@@ -9,9 +9,38 @@ func EnvToScript(env map[string]string) string {
 	var sb strings.Builder
 	sb.WriteString("#!/bin/sh\n")
 	for name, value := range env {
-		escapedValue := strings.ReplaceAll(value, "\"", "\\\"")
-		escapedValue = strings.ReplaceAll(escapedValue, "$", "\\$")
-		sb.WriteString(fmt.Sprintf("export %s=\"%s\"\n", name, escapedValue))
+		sb.WriteString(fmt.Sprintf("export %s=\"%s\"\n", name, envValueEscaper.Replace(value)))
+	}
+	return sb.String()
+}
+
+// envValueEscaper escapes the characters which are special inside double quotes in shell.
+var envValueEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "$", "\\$", "`", "\\`")
+
+// ScriptToEnv parses environment variables from the script generated by EnvToScript.
+func ScriptToEnv(script string) map[string]string {
+	env := map[string]string{}
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			continue
+		}
+		env[name] = envValueUnescape(strings.TrimSuffix(strings.TrimPrefix(value, "\""), "\""))
+	}
+	return env
+}
+
+func envValueUnescape(value string) string {
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) && strings.ContainsRune("\\\"$`", rune(value[i+1])) {
+			i++
+		}
+		sb.WriteByte(value[i])
 	}
 	return sb.String()
 }
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptToEnv(t *testing.T) {
+	env := map[string]string{
+		"JAVA_HOME": "/usr/lib/jvm/java-11",
+		"PROMPT":    `"$USER"`,
+		"WINDOWS":   `C:\aem\home\`,
+		"REGEX":     `^\d+\.\$`,
+		"COMMAND":   "`hostname`",
+		"EMPTY":     "",
+	}
+
+	assert.Equal(t, env, ScriptToEnv(EnvToScript(env)))
+}
+
+func TestScriptToEnvSkipsComments(t *testing.T) {
+	script := "#!/bin/sh\n# managed by provider\nexport AEM_ENV=\"dev\"\n\n"
+
+	assert.Equal(t, map[string]string{"AEM_ENV": "dev"}, ScriptToEnv(script))
+}
@@ -0,0 +1,8 @@
+package utils
+
+import "strings"
+
+// ShellQuote wraps the value in single quotes so it is passed to the shell literally.
+func ShellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
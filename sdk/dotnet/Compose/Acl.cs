@@ -0,0 +1,195 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Access control entry of the principal applied to the repository path on the AEM instances. Removed from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:Acl")]
+    public partial class Acl : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+        /// </summary>
+        [Output("allow")]
+        public Output<ImmutableArray<string>> Allow { get; private set; } = null!;
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Privileges denied to the principal.
+        /// </summary>
+        [Output("deny")]
+        public Output<ImmutableArray<string>> Deny { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the access control entry on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.AclInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifier of the user or group to which the access control entry applies.
+        /// </summary>
+        [Output("principal")]
+        public Output<string> Principal { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Acl resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Acl(string name, AclArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:Acl", name, args ?? new AclArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Acl(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:Acl", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                ReplaceOnChanges =
+                {
+                    "path",
+                    "principal",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Acl resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Acl Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Acl(name, id, options);
+        }
+    }
+
+    public sealed class AclArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("allow")]
+        private InputList<string>? _allow;
+
+        /// <summary>
+        /// Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+        /// </summary>
+        public InputList<string> Allow
+        {
+            get => _allow ?? (_allow = new InputList<string>());
+            set => _allow = value;
+        }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("deny")]
+        private InputList<string>? _deny;
+
+        /// <summary>
+        /// Privileges denied to the principal.
+        /// </summary>
+        public InputList<string> Deny
+        {
+            get => _deny ?? (_deny = new InputList<string>());
+            set => _deny = value;
+        }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        /// <summary>
+        /// Identifier of the user or group to which the access control entry applies.
+        /// </summary>
+        [Input("principal", required: true)]
+        public Input<string> Principal { get; set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public AclArgs()
+        {
+        }
+        public static new AclArgs Empty => new AclArgs();
+    }
+}
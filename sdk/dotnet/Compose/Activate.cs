@@ -0,0 +1,168 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    public static class Activate
+    {
+        /// <summary>
+        /// Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.
+        /// </summary>
+        public static Task<ActivateResult> InvokeAsync(ActivateArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ActivateResult>("aem:compose:activate", args ?? new ActivateArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.
+        /// </summary>
+        public static Output<ActivateResult> Invoke(ActivateInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ActivateResult>("aem:compose:activate", args ?? new ActivateInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ActivateArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.
+        /// </summary>
+        [Input("agent")]
+        public string? Agent { get; set; }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Inputs.Client Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Inputs.Compose? Compose { get; set; }
+
+        [Input("instance_ids")]
+        private List<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public List<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new List<string>());
+            set => _instance_ids = value;
+        }
+
+        [Input("paths", required: true)]
+        private List<string>? _paths;
+
+        /// <summary>
+        /// Repository paths of the content to be replicated (e.g. '/content/acme').
+        /// </summary>
+        public List<string> Paths
+        {
+            get => _paths ?? (_paths = new List<string>());
+            set => _paths = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Inputs.System? System { get; set; }
+
+        /// <summary>
+        /// Replicate the whole content trees under the paths instead of the single nodes.
+        /// </summary>
+        [Input("tree")]
+        public bool? Tree { get; set; }
+
+        public ActivateArgs()
+        {
+        }
+        public static new ActivateArgs Empty => new ActivateArgs();
+    }
+
+    public sealed class ActivateInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.
+        /// </summary>
+        [Input("agent")]
+        public Input<string>? Agent { get; set; }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        [Input("paths", required: true)]
+        private InputList<string>? _paths;
+
+        /// <summary>
+        /// Repository paths of the content to be replicated (e.g. '/content/acme').
+        /// </summary>
+        public InputList<string> Paths
+        {
+            get => _paths ?? (_paths = new InputList<string>());
+            set => _paths = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        /// <summary>
+        /// Replicate the whole content trees under the paths instead of the single nodes.
+        /// </summary>
+        [Input("tree")]
+        public Input<bool>? Tree { get; set; }
+
+        public ActivateInvokeArgs()
+        {
+        }
+        public static new ActivateInvokeArgs Empty => new ActivateInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ActivateResult
+    {
+        /// <summary>
+        /// Replication results per instance and path.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ActivateResultItem> Results;
+
+        [OutputConstructor]
+        private ActivateResult(ImmutableArray<Outputs.ActivateResultItem> results)
+        {
+            Results = results;
+        }
+    }
+}
@@ -0,0 +1,196 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// OSGi bundle installed on the AEM instances in the desired state (e.g. hotfix). Uninstalled from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:Bundle")]
+    public partial class Bundle : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// SHA-256 checksum of the bundle file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force reinstallation.
+        /// </summary>
+        [Output("checksum")]
+        public Output<string?> Checksum { get; private set; } = null!;
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Local path of the OSGi bundle JAR file to be installed.
+        /// </summary>
+        [Output("file")]
+        public Output<string?> File { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the bundle on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.BundleInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Remote path of the bundle file on the machine.
+        /// </summary>
+        [Output("remote_path")]
+        public Output<string> Remote_path { get; private set; } = null!;
+
+        /// <summary>
+        /// Desired state of the bundle. Possible values are 'active', 'stopped' and 'uninstalled'.
+        /// </summary>
+        [Output("state")]
+        public Output<string?> State { get; private set; } = null!;
+
+        /// <summary>
+        /// Symbolic name of the bundle read from its manifest.
+        /// </summary>
+        [Output("symbolic_name")]
+        public Output<string> Symbolic_name { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// URL of the OSGi bundle JAR file to be downloaded on the machine and installed.
+        /// </summary>
+        [Output("url")]
+        public Output<string?> Url { get; private set; } = null!;
+
+        /// <summary>
+        /// Version of the bundle read from its manifest.
+        /// </summary>
+        [Output("version")]
+        public Output<string> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Bundle resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Bundle(string name, BundleArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:Bundle", name, args ?? new BundleArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Bundle(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:Bundle", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Bundle resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Bundle Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Bundle(name, id, options);
+        }
+    }
+
+    public sealed class BundleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// SHA-256 checksum of the bundle file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force reinstallation.
+        /// </summary>
+        [Input("checksum")]
+        public Input<string>? Checksum { get; set; }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Local path of the OSGi bundle JAR file to be installed.
+        /// </summary>
+        [Input("file")]
+        public Input<string>? File { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Desired state of the bundle. Possible values are 'active', 'stopped' and 'uninstalled'.
+        /// </summary>
+        [Input("state")]
+        public Input<string>? State { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        /// <summary>
+        /// URL of the OSGi bundle JAR file to be downloaded on the machine and installed.
+        /// </summary>
+        [Input("url")]
+        public Input<string>? Url { get; set; }
+
+        public BundleArgs()
+        {
+        }
+        public static new BundleArgs Empty => new BundleArgs();
+    }
+}
@@ -0,0 +1,120 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    public static class GetInstanceStatus
+    {
+        /// <summary>
+        /// Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+        /// </summary>
+        public static Task<GetInstanceStatusResult> InvokeAsync(GetInstanceStatusArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetInstanceStatusResult>("aem:compose:getInstanceStatus", args ?? new GetInstanceStatusArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+        /// </summary>
+        public static Output<GetInstanceStatusResult> Invoke(GetInstanceStatusInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstanceStatusResult>("aem:compose:getInstanceStatus", args ?? new GetInstanceStatusInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetInstanceStatusArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Inputs.Client Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Inputs.Compose? Compose { get; set; }
+
+        [Input("instance_ids")]
+        private List<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public List<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new List<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Inputs.System? System { get; set; }
+
+        public GetInstanceStatusArgs()
+        {
+        }
+        public static new GetInstanceStatusArgs Empty => new GetInstanceStatusArgs();
+    }
+
+    public sealed class GetInstanceStatusInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public GetInstanceStatusInvokeArgs()
+        {
+        }
+        public static new GetInstanceStatusInvokeArgs Empty => new GetInstanceStatusInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetInstanceStatusResult
+    {
+        /// <summary>
+        /// Current state of the AEM instances running on the machine.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.InstanceModel> Instances;
+
+        [OutputConstructor]
+        private GetInstanceStatusResult(ImmutableArray<Outputs.InstanceModel> instances)
+        {
+            Instances = instances;
+        }
+    }
+}
@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    public static class GetLogs
+    {
+        /// <summary>
+        /// Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+        /// </summary>
+        public static Task<GetLogsResult> InvokeAsync(GetLogsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetLogsResult>("aem:compose:getLogs", args ?? new GetLogsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+        /// </summary>
+        public static Output<GetLogsResult> Invoke(GetLogsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetLogsResult>("aem:compose:getLogs", args ?? new GetLogsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetLogsArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Inputs.Client Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Inputs.Compose? Compose { get; set; }
+
+        /// <summary>
+        /// Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+        /// </summary>
+        [Input("file")]
+        public string? File { get; set; }
+
+        /// <summary>
+        /// Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+        /// </summary>
+        [Input("filter")]
+        public string? Filter { get; set; }
+
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+        /// </summary>
+        [Input("instance_id", required: true)]
+        public string Instance_id { get; set; } = null!;
+
+        /// <summary>
+        /// Number of the last lines to be returned. By default, 100 lines are returned.
+        /// </summary>
+        [Input("lines")]
+        public int? Lines { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Inputs.System? System { get; set; }
+
+        public GetLogsArgs()
+        {
+        }
+        public static new GetLogsArgs Empty => new GetLogsArgs();
+    }
+
+    public sealed class GetLogsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+        /// </summary>
+        [Input("file")]
+        public Input<string>? File { get; set; }
+
+        /// <summary>
+        /// Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+        /// </summary>
+        [Input("filter")]
+        public Input<string>? Filter { get; set; }
+
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+        /// </summary>
+        [Input("instance_id", required: true)]
+        public Input<string> Instance_id { get; set; } = null!;
+
+        /// <summary>
+        /// Number of the last lines to be returned. By default, 100 lines are returned.
+        /// </summary>
+        [Input("lines")]
+        public Input<int>? Lines { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public GetLogsInvokeArgs()
+        {
+        }
+        public static new GetLogsInvokeArgs Empty => new GetLogsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetLogsResult
+    {
+        /// <summary>
+        /// Last lines of the log file.
+        /// </summary>
+        public readonly string Content;
+
+        [OutputConstructor]
+        private GetLogsResult(string content)
+        {
+            Content = content;
+        }
+    }
+}
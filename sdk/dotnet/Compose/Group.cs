@@ -0,0 +1,201 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Group created on the AEM instances along with its members. Deleted from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:Group")]
+    public partial class Group : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Description stored in the group profile.
+        /// </summary>
+        [Output("description")]
+        public Output<string?> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// Display name stored in the group profile.
+        /// </summary>
+        [Output("display_name")]
+        public Output<string?> Display_name { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifier of the group. By default, the resource name is used.
+        /// </summary>
+        [Output("group_id")]
+        public Output<string?> Group_id { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the group on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.GroupInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+        /// </summary>
+        [Output("intermediate_path")]
+        public Output<string?> Intermediate_path { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+        /// </summary>
+        [Output("members")]
+        public Output<ImmutableArray<string>> Members { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Group resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Group(string name, GroupArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:Group", name, args ?? new GroupArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Group(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:Group", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                ReplaceOnChanges =
+                {
+                    "group_id",
+                    "intermediate_path",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Group resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Group Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Group(name, id, options);
+        }
+    }
+
+    public sealed class GroupArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Description stored in the group profile.
+        /// </summary>
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        /// <summary>
+        /// Display name stored in the group profile.
+        /// </summary>
+        [Input("display_name")]
+        public Input<string>? Display_name { get; set; }
+
+        /// <summary>
+        /// Identifier of the group. By default, the resource name is used.
+        /// </summary>
+        [Input("group_id")]
+        public Input<string>? Group_id { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+        /// </summary>
+        [Input("intermediate_path")]
+        public Input<string>? Intermediate_path { get; set; }
+
+        [Input("members")]
+        private InputList<string>? _members;
+
+        /// <summary>
+        /// Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+        /// </summary>
+        public InputList<string> Members
+        {
+            get => _members ?? (_members = new InputList<string>());
+            set => _members = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public GroupArgs()
+        {
+        }
+        public static new GroupArgs Empty => new GroupArgs();
+    }
+}
@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class Backup : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Toggle making a backup before deleting the instance.
+        /// </summary>
+        [Input("before_delete")]
+        public bool? Before_delete { get; set; }
+
+        /// <summary>
+        /// Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        /// </summary>
+        [Input("before_update")]
+        public bool? Before_update { get; set; }
+
+        /// <summary>
+        /// Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        /// </summary>
+        [Input("compression")]
+        public string? Compression { get; set; }
+
+        /// <summary>
+        /// Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        /// </summary>
+        [Input("retention")]
+        public int? Retention { get; set; }
+
+        /// <summary>
+        /// Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        /// </summary>
+        [Input("target", required: true)]
+        public string Target { get; set; } = null!;
+
+        public Backup()
+        {
+        }
+        public static new Backup Empty => new Backup();
+    }
+}
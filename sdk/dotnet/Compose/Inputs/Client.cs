@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class Client : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+        /// </summary>
+        [Input("action_timeout")]
+        public string? Action_timeout { get; set; }
+
+        [Input("credentials")]
+        private Dictionary<string, string>? _credentials;
+
+        /// <summary>
+        /// Credentials for the connection type
+        /// </summary>
+        public Dictionary<string, string> Credentials
+        {
+            get => _credentials ?? (_credentials = new Dictionary<string, string>());
+            set => _credentials = value;
+        }
+
+        /// <summary>
+        /// Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        /// </summary>
+        [Input("port_forward")]
+        public bool? Port_forward { get; set; }
+
+        [Input("settings", required: true)]
+        private Dictionary<string, string>? _settings;
+
+        /// <summary>
+        /// Settings for the connection type
+        /// </summary>
+        public Dictionary<string, string> Settings
+        {
+            get => _settings ?? (_settings = new Dictionary<string, string>());
+            set => _settings = value;
+        }
+
+        /// <summary>
+        /// Used when reading the AEM instance state when determining the plan.
+        /// </summary>
+        [Input("state_timeout")]
+        public string? State_timeout { get; set; }
+
+        /// <summary>
+        /// Type of connection to use to connect to the machine on which AEM instance will be running.
+        /// </summary>
+        [Input("type", required: true)]
+        public string Type { get; set; } = null!;
+
+        public Client()
+        {
+        }
+        public static new Client Empty => new Client();
+    }
+}
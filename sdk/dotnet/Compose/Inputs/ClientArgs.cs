@@ -31,6 +31,12 @@ namespace WTTech.Aem.Compose.Inputs
             set => _credentials = value;
         }
 
+        /// <summary>
+        /// Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        /// </summary>
+        [Input("port_forward")]
+        public Input<bool>? Port_forward { get; set; }
+
         [Input("settings", required: true)]
         private InputMap<string>? _settings;
 
@@ -0,0 +1,111 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class Compose : global::Pulumi.InvokeArgs
+    {
+        [Input("admin_password")]
+        private string? _admin_password;
+
+        /// <summary>
+        /// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        /// </summary>
+        public string? Admin_password
+        {
+            get => _admin_password;
+            set => _admin_password = value;
+        }
+
+        /// <summary>
+        /// Settings for backing up AEM instance files.
+        /// </summary>
+        [Input("backup")]
+        public Inputs.Backup? Backup { get; set; }
+
+        /// <summary>
+        /// Contents of the AEM Compose YML configuration file.
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        /// </summary>
+        [Input("config_lists_strategy")]
+        public string? Config_lists_strategy { get; set; }
+
+        /// <summary>
+        /// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        /// </summary>
+        [Input("config_overrides")]
+        public object? Config_overrides { get; set; }
+
+        /// <summary>
+        /// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+        /// </summary>
+        [Input("configure")]
+        public Inputs.InstanceScript? Configure { get; set; }
+
+        /// <summary>
+        /// Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        /// </summary>
+        [Input("create")]
+        public Inputs.InstanceScript? Create { get; set; }
+
+        /// <summary>
+        /// Script(s) for deleting a stopped instance.
+        /// </summary>
+        [Input("delete")]
+        public Inputs.InstanceScript? Delete { get; set; }
+
+        /// <summary>
+        /// Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        /// </summary>
+        [Input("download")]
+        public bool? Download { get; set; }
+
+        [Input("instances")]
+        private List<Inputs.ComposeInstance>? _instances;
+
+        /// <summary>
+        /// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        /// </summary>
+        public List<Inputs.ComposeInstance> Instances
+        {
+            get => _instances ?? (_instances = new List<Inputs.ComposeInstance>());
+            set => _instances = value;
+        }
+
+        /// <summary>
+        /// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        /// </summary>
+        [Input("readiness")]
+        public Inputs.Readiness? Readiness { get; set; }
+
+        /// <summary>
+        /// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        /// </summary>
+        [Input("restore_from")]
+        public string? Restore_from { get; set; }
+
+        /// <summary>
+        /// Version of AEM Compose tool to use on remote machine.
+        /// </summary>
+        [Input("version")]
+        public string? Version { get; set; }
+
+        public Compose()
+        {
+        }
+        public static new Compose Empty => new Compose();
+    }
+}
@@ -13,6 +13,28 @@ namespace WTTech.Aem.Compose.Inputs
 
     public sealed class ComposeArgs : global::Pulumi.ResourceArgs
     {
+        [Input("admin_password")]
+        private Input<string>? _admin_password;
+
+        /// <summary>
+        /// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        /// </summary>
+        public Input<string>? Admin_password
+        {
+            get => _admin_password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _admin_password = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Settings for backing up AEM instance files.
+        /// </summary>
+        [Input("backup")]
+        public Input<Inputs.BackupArgs>? Backup { get; set; }
+
         /// <summary>
         /// Contents of the AEM Compose YML configuration file.
         /// </summary>
@@ -20,7 +42,19 @@ namespace WTTech.Aem.Compose.Inputs
         public Input<string>? Config { get; set; }
 
         /// <summary>
-        /// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+        /// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        /// </summary>
+        [Input("config_lists_strategy")]
+        public Input<string>? Config_lists_strategy { get; set; }
+
+        /// <summary>
+        /// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        /// </summary>
+        [Input("config_overrides")]
+        public Input<object>? Config_overrides { get; set; }
+
+        /// <summary>
+        /// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
         /// </summary>
         [Input("configure")]
         public Input<Inputs.InstanceScriptArgs>? Configure { get; set; }
@@ -43,6 +77,30 @@ namespace WTTech.Aem.Compose.Inputs
         [Input("download")]
         public Input<bool>? Download { get; set; }
 
+        [Input("instances")]
+        private InputList<Inputs.ComposeInstanceArgs>? _instances;
+
+        /// <summary>
+        /// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        /// </summary>
+        public InputList<Inputs.ComposeInstanceArgs> Instances
+        {
+            get => _instances ?? (_instances = new InputList<Inputs.ComposeInstanceArgs>());
+            set => _instances = value;
+        }
+
+        /// <summary>
+        /// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        /// </summary>
+        [Input("readiness")]
+        public Input<Inputs.ReadinessArgs>? Readiness { get; set; }
+
+        /// <summary>
+        /// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        /// </summary>
+        [Input("restore_from")]
+        public Input<string>? Restore_from { get; set; }
+
         /// <summary>
         /// Version of AEM Compose tool to use on remote machine.
         /// </summary>
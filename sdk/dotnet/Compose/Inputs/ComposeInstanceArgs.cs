@@ -0,0 +1,127 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class ComposeInstanceArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("env_vars")]
+        private InputList<string>? _env_vars;
+
+        /// <summary>
+        /// Environment variables set for the AEM instance process (in format 'NAME=value').
+        /// </summary>
+        public InputList<string> Env_vars
+        {
+            get => _env_vars ?? (_env_vars = new InputList<string>());
+            set => _env_vars = value;
+        }
+
+        /// <summary>
+        /// The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        /// </summary>
+        [Input("http_url", required: true)]
+        public Input<string> Http_url { get; set; } = null!;
+
+        /// <summary>
+        /// Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        /// </summary>
+        [Input("id", required: true)]
+        public Input<string> Id { get; set; } = null!;
+
+        [Input("jvm_opts")]
+        private InputList<string>? _jvm_opts;
+
+        /// <summary>
+        /// JVM options passed to the AEM instance process.
+        /// </summary>
+        public InputList<string> Jvm_opts
+        {
+            get => _jvm_opts ?? (_jvm_opts = new InputList<string>());
+            set => _jvm_opts = value;
+        }
+
+        [Input("password")]
+        private Input<string>? _password;
+
+        /// <summary>
+        /// Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        /// </summary>
+        public Input<string>? Password
+        {
+            get => _password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _password = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("run_modes")]
+        private InputList<string>? _run_modes;
+
+        /// <summary>
+        /// Run modes of the AEM instance.
+        /// </summary>
+        public InputList<string> Run_modes
+        {
+            get => _run_modes ?? (_run_modes = new InputList<string>());
+            set => _run_modes = value;
+        }
+
+        [Input("secret_vars")]
+        private InputList<string>? _secret_vars;
+
+        /// <summary>
+        /// Secret variables set for the AEM instance process (in format 'NAME=value').
+        /// </summary>
+        public InputList<string> Secret_vars
+        {
+            get => _secret_vars ?? (_secret_vars = new InputList<string>());
+            set => _secret_vars = value;
+        }
+
+        [Input("sling_props")]
+        private InputList<string>? _sling_props;
+
+        /// <summary>
+        /// Sling properties set for the AEM instance (in format 'name=value').
+        /// </summary>
+        public InputList<string> Sling_props
+        {
+            get => _sling_props ?? (_sling_props = new InputList<string>());
+            set => _sling_props = value;
+        }
+
+        [Input("start_opts")]
+        private InputList<string>? _start_opts;
+
+        /// <summary>
+        /// Options passed to the AEM instance start script.
+        /// </summary>
+        public InputList<string> Start_opts
+        {
+            get => _start_opts ?? (_start_opts = new InputList<string>());
+            set => _start_opts = value;
+        }
+
+        /// <summary>
+        /// User used to communicate with the AEM instance.
+        /// </summary>
+        [Input("user")]
+        public Input<string>? User { get; set; }
+
+        public ComposeInstanceArgs()
+        {
+        }
+        public static new ComposeInstanceArgs Empty => new ComposeInstanceArgs();
+    }
+}
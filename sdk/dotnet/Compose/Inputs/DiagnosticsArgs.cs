@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class DiagnosticsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Number of the last lines of the AEM logs and system journal to be collected.
+        /// </summary>
+        [Input("log_lines")]
+        public Input<int>? Log_lines { get; set; }
+
+        /// <summary>
+        /// Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+        /// </summary>
+        [Input("output_dir")]
+        public Input<string>? Output_dir { get; set; }
+
+        /// <summary>
+        /// Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+        /// </summary>
+        [Input("summary_lines")]
+        public Input<int>? Summary_lines { get; set; }
+
+        /// <summary>
+        /// Toggle collecting thread dumps of the running AEM instances.
+        /// </summary>
+        [Input("thread_dump")]
+        public Input<bool>? Thread_dump { get; set; }
+
+        public DiagnosticsArgs()
+        {
+        }
+        public static new DiagnosticsArgs Empty => new DiagnosticsArgs();
+    }
+}
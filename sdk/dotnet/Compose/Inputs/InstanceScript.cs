@@ -0,0 +1,39 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class InstanceScript : global::Pulumi.InvokeArgs
+    {
+        [Input("inline")]
+        private List<string>? _inline;
+
+        /// <summary>
+        /// Inline shell commands to be executed
+        /// </summary>
+        public List<string> Inline
+        {
+            get => _inline ?? (_inline = new List<string>());
+            set => _inline = value;
+        }
+
+        /// <summary>
+        /// Multiline shell script to be executed
+        /// </summary>
+        [Input("script")]
+        public string? Script { get; set; }
+
+        public InstanceScript()
+        {
+        }
+        public static new InstanceScript Empty => new InstanceScript();
+    }
+}
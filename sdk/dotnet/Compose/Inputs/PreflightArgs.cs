@@ -0,0 +1,39 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class PreflightArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+        /// </summary>
+        [Input("java_version")]
+        public Input<string>? Java_version { get; set; }
+
+        /// <summary>
+        /// Minimum free disk space in gigabytes required in the data directory.
+        /// </summary>
+        [Input("min_disk_space")]
+        public Input<int>? Min_disk_space { get; set; }
+
+        /// <summary>
+        /// Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+        /// </summary>
+        [Input("ports")]
+        public Input<bool>? Ports { get; set; }
+
+        public PreflightArgs()
+        {
+        }
+        public static new PreflightArgs Empty => new PreflightArgs();
+    }
+}
@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class Readiness : global::Pulumi.InvokeArgs
+    {
+        [Input("attributes")]
+        private List<string>? _attributes;
+
+        /// <summary>
+        /// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        /// </summary>
+        public List<string> Attributes
+        {
+            get => _attributes ?? (_attributes = new List<string>());
+            set => _attributes = value;
+        }
+
+        /// <summary>
+        /// Time between subsequent readiness checks.
+        /// </summary>
+        [Input("interval")]
+        public string? Interval { get; set; }
+
+        /// <summary>
+        /// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        /// </summary>
+        [Input("policy")]
+        public string? Policy { get; set; }
+
+        [Input("probes")]
+        private List<Inputs.ReadinessProbe>? _probes;
+
+        /// <summary>
+        /// HTTP requests performed against each instance which need to respond with the expected status.
+        /// </summary>
+        public List<Inputs.ReadinessProbe> Probes
+        {
+            get => _probes ?? (_probes = new List<Inputs.ReadinessProbe>());
+            set => _probes = value;
+        }
+
+        /// <summary>
+        /// Maximum time to wait for the instances to become ready.
+        /// </summary>
+        [Input("timeout")]
+        public string? Timeout { get; set; }
+
+        public Readiness()
+        {
+        }
+        public static new Readiness Empty => new Readiness();
+    }
+}
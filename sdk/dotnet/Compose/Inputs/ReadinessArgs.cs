@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class ReadinessArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("attributes")]
+        private InputList<string>? _attributes;
+
+        /// <summary>
+        /// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        /// </summary>
+        public InputList<string> Attributes
+        {
+            get => _attributes ?? (_attributes = new InputList<string>());
+            set => _attributes = value;
+        }
+
+        /// <summary>
+        /// Time between subsequent readiness checks.
+        /// </summary>
+        [Input("interval")]
+        public Input<string>? Interval { get; set; }
+
+        /// <summary>
+        /// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        /// </summary>
+        [Input("policy")]
+        public Input<string>? Policy { get; set; }
+
+        [Input("probes")]
+        private InputList<Inputs.ReadinessProbeArgs>? _probes;
+
+        /// <summary>
+        /// HTTP requests performed against each instance which need to respond with the expected status.
+        /// </summary>
+        public InputList<Inputs.ReadinessProbeArgs> Probes
+        {
+            get => _probes ?? (_probes = new InputList<Inputs.ReadinessProbeArgs>());
+            set => _probes = value;
+        }
+
+        /// <summary>
+        /// Maximum time to wait for the instances to become ready.
+        /// </summary>
+        [Input("timeout")]
+        public Input<string>? Timeout { get; set; }
+
+        public ReadinessArgs()
+        {
+        }
+        public static new ReadinessArgs Empty => new ReadinessArgs();
+    }
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class ReadinessProbe : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        /// </summary>
+        [Input("path", required: true)]
+        public string Path { get; set; } = null!;
+
+        /// <summary>
+        /// Expected HTTP status of the response.
+        /// </summary>
+        [Input("status")]
+        public int? Status { get; set; }
+
+        public ReadinessProbe()
+        {
+        }
+        public static new ReadinessProbe Empty => new ReadinessProbe();
+    }
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class ReadinessProbeArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        /// <summary>
+        /// Expected HTTP status of the response.
+        /// </summary>
+        [Input("status")]
+        public Input<int>? Status { get; set; }
+
+        public ReadinessProbeArgs()
+        {
+        }
+        public static new ReadinessProbeArgs Empty => new ReadinessProbeArgs();
+    }
+}
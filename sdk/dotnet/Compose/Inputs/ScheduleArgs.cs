@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class ScheduleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        /// </summary>
+        [Input("builtin")]
+        public Input<string>? Builtin { get; set; }
+
+        /// <summary>
+        /// Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        /// </summary>
+        [Input("on_calendar", required: true)]
+        public Input<string> On_calendar { get; set; } = null!;
+
+        /// <summary>
+        /// Script(s) to be executed. Mutually exclusive with 'builtin'.
+        /// </summary>
+        [Input("script")]
+        public Input<Inputs.InstanceScriptArgs>? Script { get; set; }
+
+        public ScheduleArgs()
+        {
+        }
+        public static new ScheduleArgs Empty => new ScheduleArgs();
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class SmokeTestAuthArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("password")]
+        private Input<string>? _password;
+
+        /// <summary>
+        /// Password of the user.
+        /// </summary>
+        public Input<string>? Password
+        {
+            get => _password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _password = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Name of the user. When empty, the request is performed anonymously.
+        /// </summary>
+        [Input("user")]
+        public Input<string>? User { get; set; }
+
+        public SmokeTestAuthArgs()
+        {
+        }
+        public static new SmokeTestAuthArgs Empty => new SmokeTestAuthArgs();
+    }
+}
@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class SmokeTestCheckArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Credentials used to perform the request. By default, the credentials of the instance are used.
+        /// </summary>
+        [Input("auth")]
+        public Input<Inputs.SmokeTestAuthArgs>? Auth { get; set; }
+
+        /// <summary>
+        /// Regular expression which the response body needs to match.
+        /// </summary>
+        [Input("expected_body")]
+        public Input<string>? Expected_body { get; set; }
+
+        /// <summary>
+        /// Expected HTTP status of the response.
+        /// </summary>
+        [Input("expected_status")]
+        public Input<int>? Expected_status { get; set; }
+
+        /// <summary>
+        /// Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+        /// </summary>
+        [Input("json_path")]
+        public Input<string>? Json_path { get; set; }
+
+        /// <summary>
+        /// Expected value found at the JSON path.
+        /// </summary>
+        [Input("json_value")]
+        public Input<string>? Json_value { get; set; }
+
+        /// <summary>
+        /// HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+        /// </summary>
+        [Input("method")]
+        public Input<string>? Method { get; set; }
+
+        /// <summary>
+        /// Name of the check used in the results. By default, the method and path are used.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        public SmokeTestCheckArgs()
+        {
+        }
+        public static new SmokeTestCheckArgs Empty => new SmokeTestCheckArgs();
+    }
+}
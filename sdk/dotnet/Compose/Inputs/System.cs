@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Inputs
+{
+
+    public sealed class System : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
+        /// </summary>
+        [Input("bootstrap")]
+        public Inputs.InstanceScript? Bootstrap { get; set; }
+
+        /// <summary>
+        /// Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
+        /// </summary>
+        [Input("data_dir")]
+        public string? Data_dir { get; set; }
+
+        [Input("env")]
+        private Dictionary<string, string>? _env;
+
+        /// <summary>
+        /// Environment variables for AEM instances.
+        /// </summary>
+        public Dictionary<string, string> Env
+        {
+            get => _env ?? (_env = new Dictionary<string, string>());
+            set => _env = value;
+        }
+
+        [Input("schedules")]
+        private List<Inputs.Schedule>? _schedules;
+
+        /// <summary>
+        /// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        /// </summary>
+        public List<Inputs.Schedule> Schedules
+        {
+            get => _schedules ?? (_schedules = new List<Inputs.Schedule>());
+            set => _schedules = value;
+        }
+
+        /// <summary>
+        /// Contents of the AEM system service definition file (systemd).
+        /// </summary>
+        [Input("service_config")]
+        public string? Service_config { get; set; }
+
+        /// <summary>
+        /// System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
+        /// </summary>
+        [Input("user")]
+        public string? User { get; set; }
+
+        /// <summary>
+        /// Remote root path where provider-related files will be stored.
+        /// </summary>
+        [Input("work_dir")]
+        public string? Work_dir { get; set; }
+
+        public System()
+        {
+        }
+        public static new System Empty => new System();
+    }
+}
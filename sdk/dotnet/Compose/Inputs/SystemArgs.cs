@@ -37,6 +37,18 @@ namespace WTTech.Aem.Compose.Inputs
             set => _env = value;
         }
 
+        [Input("schedules")]
+        private InputList<Inputs.ScheduleArgs>? _schedules;
+
+        /// <summary>
+        /// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        /// </summary>
+        public InputList<Inputs.ScheduleArgs> Schedules
+        {
+            get => _schedules ?? (_schedules = new InputList<Inputs.ScheduleArgs>());
+            set => _schedules = value;
+        }
+
         /// <summary>
         /// Contents of the AEM system service definition file (systemd).
         /// </summary>
@@ -13,6 +13,12 @@ namespace WTTech.Aem.Compose
     [AemResourceType("aem:compose:Instance")]
     public partial class Instance : global::Pulumi.CustomResource
     {
+        /// <summary>
+        /// Backups of the AEM instance files kept in the target location.
+        /// </summary>
+        [Output("backups")]
+        public Output<ImmutableArray<Outputs.BackupModel>> Backups { get; private set; } = null!;
+
         /// <summary>
         /// Connection settings used to access the machine on which the AEM instance will be running.
         /// </summary>
@@ -25,6 +31,18 @@ namespace WTTech.Aem.Compose
         [Output("compose")]
         public Output<Outputs.Compose?> Compose { get; private set; } = null!;
 
+        /// <summary>
+        /// Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+        /// </summary>
+        [Output("diagnostics")]
+        public Output<Outputs.Diagnostics?> Diagnostics { get; private set; } = null!;
+
+        /// <summary>
+        /// Contents of the AEM Compose YML configuration file actually written to the machine (after applying overrides).
+        /// </summary>
+        [Output("effective_config")]
+        public Output<string> Effective_config { get; private set; } = null!;
+
         /// <summary>
         /// Files or directories to be copied into the machine.
         /// </summary>
@@ -37,6 +55,12 @@ namespace WTTech.Aem.Compose
         [Output("instances")]
         public Output<ImmutableArray<Outputs.InstanceModel>> Instances { get; private set; } = null!;
 
+        /// <summary>
+        /// Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+        /// </summary>
+        [Output("preflight")]
+        public Output<Outputs.Preflight?> Preflight { get; private set; } = null!;
+
         /// <summary>
         /// Operating system configuration for the machine on which AEM instance will be running.
         /// </summary>
@@ -67,6 +91,10 @@ namespace WTTech.Aem.Compose
             {
                 Version = Utilities.Version,
                 PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                AdditionalSecretOutputs =
+                {
+                    "effective_config",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -101,6 +129,12 @@ namespace WTTech.Aem.Compose
         [Input("compose")]
         public Input<Inputs.ComposeArgs>? Compose { get; set; }
 
+        /// <summary>
+        /// Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+        /// </summary>
+        [Input("diagnostics")]
+        public Input<Inputs.DiagnosticsArgs>? Diagnostics { get; set; }
+
         [Input("files")]
         private InputMap<string>? _files;
 
@@ -113,6 +147,12 @@ namespace WTTech.Aem.Compose
             set => _files = value;
         }
 
+        /// <summary>
+        /// Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+        /// </summary>
+        [Input("preflight")]
+        public Input<Inputs.PreflightArgs>? Preflight { get; set; }
+
         /// <summary>
         /// Operating system configuration for the machine on which AEM instance will be running.
         /// </summary>
@@ -0,0 +1,248 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Oak index definition saved on the AEM instances. Reindexing is triggered when the definition changes and awaited before the operation completes. Removed from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:OakIndex")]
+    public partial class OakIndex : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+        /// </summary>
+        [Output("async")]
+        public Output<ImmutableArray<string>> Async { get; private set; } = null!;
+
+        /// <summary>
+        /// Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+        /// </summary>
+        [Output("children")]
+        public Output<ImmutableDictionary<string, object>?> Children { get; private set; } = null!;
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Repository paths covered by the index.
+        /// </summary>
+        [Output("included_paths")]
+        public Output<ImmutableArray<string>> Included_paths { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the index on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.OakIndexInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Name of the index definition node under '/oak:index'. By default, the resource name is used.
+        /// </summary>
+        [Output("name")]
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Repository path of the index definition.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+        /// </summary>
+        [Output("properties")]
+        public Output<ImmutableDictionary<string, object>?> Properties { get; private set; } = null!;
+
+        /// <summary>
+        /// Maximum time to wait for reindexing to complete after the definition is changed.
+        /// </summary>
+        [Output("reindex_timeout")]
+        public Output<string?> Reindex_timeout { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// Type of the index. Possible values are 'lucene' and 'property'.
+        /// </summary>
+        [Output("type")]
+        public Output<string?> Type { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a OakIndex resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public OakIndex(string name, OakIndexArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:OakIndex", name, args ?? new OakIndexArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private OakIndex(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:OakIndex", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                ReplaceOnChanges =
+                {
+                    "name",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing OakIndex resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static OakIndex Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new OakIndex(name, id, options);
+        }
+    }
+
+    public sealed class OakIndexArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("async")]
+        private InputList<string>? _async;
+
+        /// <summary>
+        /// Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+        /// </summary>
+        public InputList<string> Async
+        {
+            get => _async ?? (_async = new InputList<string>());
+            set => _async = value;
+        }
+
+        [Input("children")]
+        private InputMap<object>? _children;
+
+        /// <summary>
+        /// Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+        /// </summary>
+        public InputMap<object> Children
+        {
+            get => _children ?? (_children = new InputMap<object>());
+            set => _children = value;
+        }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("included_paths")]
+        private InputList<string>? _included_paths;
+
+        /// <summary>
+        /// Repository paths covered by the index.
+        /// </summary>
+        public InputList<string> Included_paths
+        {
+            get => _included_paths ?? (_included_paths = new InputList<string>());
+            set => _included_paths = value;
+        }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Name of the index definition node under '/oak:index'. By default, the resource name is used.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        [Input("properties")]
+        private InputMap<object>? _properties;
+
+        /// <summary>
+        /// Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+        /// </summary>
+        public InputMap<object> Properties
+        {
+            get => _properties ?? (_properties = new InputMap<object>());
+            set => _properties = value;
+        }
+
+        /// <summary>
+        /// Maximum time to wait for reindexing to complete after the definition is changed.
+        /// </summary>
+        [Input("reindex_timeout")]
+        public Input<string>? Reindex_timeout { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        /// <summary>
+        /// Type of the index. Possible values are 'lucene' and 'property'.
+        /// </summary>
+        [Input("type")]
+        public Input<string>? Type { get; set; }
+
+        public OakIndexArgs()
+        {
+        }
+        public static new OakIndexArgs Empty => new OakIndexArgs();
+    }
+}
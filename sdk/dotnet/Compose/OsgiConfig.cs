@@ -0,0 +1,196 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// OSGi configuration saved on the AEM instances. Deleted from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:OsgiConfig")]
+    public partial class OsgiConfig : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Persistent identifier under which the OSGi configuration is saved (for factory configurations in format 'factory_pid~name').
+        /// </summary>
+        [Output("effective_pid")]
+        public Output<string> Effective_pid { get; private set; } = null!;
+
+        /// <summary>
+        /// Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+        /// </summary>
+        [Output("factory_pid")]
+        public Output<string?> Factory_pid { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the OSGi configuration on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.OsgiConfigInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Name of the factory OSGi configuration instance. By default, the resource name is used.
+        /// </summary>
+        [Output("name")]
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+        /// </summary>
+        [Output("pid")]
+        public Output<string?> Pid { get; private set; } = null!;
+
+        /// <summary>
+        /// Properties of the OSGi configuration.
+        /// </summary>
+        [Output("properties")]
+        public Output<ImmutableDictionary<string, object>> Properties { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a OsgiConfig resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public OsgiConfig(string name, OsgiConfigArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:OsgiConfig", name, args ?? new OsgiConfigArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private OsgiConfig(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:OsgiConfig", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                ReplaceOnChanges =
+                {
+                    "factory_pid",
+                    "name",
+                    "pid",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing OsgiConfig resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static OsgiConfig Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new OsgiConfig(name, id, options);
+        }
+    }
+
+    public sealed class OsgiConfigArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+        /// </summary>
+        [Input("factory_pid")]
+        public Input<string>? Factory_pid { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Name of the factory OSGi configuration instance. By default, the resource name is used.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+        /// </summary>
+        [Input("pid")]
+        public Input<string>? Pid { get; set; }
+
+        [Input("properties", required: true)]
+        private InputMap<object>? _properties;
+
+        /// <summary>
+        /// Properties of the OSGi configuration.
+        /// </summary>
+        public InputMap<object> Properties
+        {
+            get => _properties ?? (_properties = new InputMap<object>());
+            set => _properties = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public OsgiConfigArgs()
+        {
+        }
+        public static new OsgiConfigArgs Empty => new OsgiConfigArgs();
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class AclInstanceModel
+    {
+        /// <summary>
+        /// Privileges granted to the principal on the AEM instance.
+        /// </summary>
+        public readonly ImmutableArray<string> Allow;
+        /// <summary>
+        /// Privileges denied to the principal on the AEM instance.
+        /// </summary>
+        public readonly ImmutableArray<string> Deny;
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+
+        [OutputConstructor]
+        private AclInstanceModel(
+            ImmutableArray<string> allow,
+
+            ImmutableArray<string> deny,
+
+            string id)
+        {
+            Allow = allow;
+            Deny = deny;
+            Id = id;
+        }
+    }
+}
@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class ActivateResultItem
+    {
+        /// <summary>
+        /// Unique identifier of AEM instance on which the replication was requested.
+        /// </summary>
+        public readonly string Instance_id;
+        /// <summary>
+        /// Response message of the replication request.
+        /// </summary>
+        public readonly string Message;
+        /// <summary>
+        /// Repository path of the replicated content.
+        /// </summary>
+        public readonly string Path;
+        /// <summary>
+        /// HTTP status of the replication request.
+        /// </summary>
+        public readonly int Status;
+        /// <summary>
+        /// Indicates if the replication request was accepted.
+        /// </summary>
+        public readonly bool Succeeded;
+
+        [OutputConstructor]
+        private ActivateResultItem(
+            string instance_id,
+
+            string message,
+
+            string path,
+
+            int status,
+
+            bool succeeded)
+        {
+            Instance_id = instance_id;
+            Message = message;
+            Path = path;
+            Status = status;
+            Succeeded = succeeded;
+        }
+    }
+}
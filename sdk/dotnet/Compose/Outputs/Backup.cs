@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class Backup
+    {
+        /// <summary>
+        /// Toggle making a backup before deleting the instance.
+        /// </summary>
+        public readonly bool? Before_delete;
+        /// <summary>
+        /// Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        /// </summary>
+        public readonly bool? Before_update;
+        /// <summary>
+        /// Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        /// </summary>
+        public readonly string? Compression;
+        /// <summary>
+        /// Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        /// </summary>
+        public readonly int? Retention;
+        /// <summary>
+        /// Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        /// </summary>
+        public readonly string Target;
+
+        [OutputConstructor]
+        private Backup(
+            bool? before_delete,
+
+            bool? before_update,
+
+            string? compression,
+
+            int? retention,
+
+            string target)
+        {
+            Before_delete = before_delete;
+            Before_update = before_update;
+            Compression = compression;
+            Retention = retention;
+            Target = target;
+        }
+    }
+}
@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class BackupModel
+    {
+        /// <summary>
+        /// SHA-256 checksum of the backup file.
+        /// </summary>
+        public readonly string Checksum;
+        /// <summary>
+        /// Location of the uploaded backup file. Could be used as 'restore_from' value.
+        /// </summary>
+        public readonly string Location;
+        /// <summary>
+        /// Name of the backup file.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// Size of the backup file in bytes.
+        /// </summary>
+        public readonly int Size;
+        /// <summary>
+        /// Time at which the backup was made (RFC 3339, UTC).
+        /// </summary>
+        public readonly string Timestamp;
+
+        [OutputConstructor]
+        private BackupModel(
+            string checksum,
+
+            string location,
+
+            string name,
+
+            int size,
+
+            string timestamp)
+        {
+            Checksum = checksum;
+            Location = location;
+            Name = name;
+            Size = size;
+            Timestamp = timestamp;
+        }
+    }
+}
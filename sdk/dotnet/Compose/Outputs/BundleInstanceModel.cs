@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class BundleInstanceModel
+    {
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').
+        /// </summary>
+        public readonly string State;
+        /// <summary>
+        /// Version of the bundle installed on the AEM instance.
+        /// </summary>
+        public readonly string Version;
+
+        [OutputConstructor]
+        private BundleInstanceModel(
+            string id,
+
+            string state,
+
+            string version)
+        {
+            Id = id;
+            State = state;
+            Version = version;
+        }
+    }
+}
@@ -23,6 +23,10 @@ namespace WTTech.Aem.Compose.Outputs
         /// </summary>
         public readonly ImmutableDictionary<string, string>? Credentials;
         /// <summary>
+        /// Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        /// </summary>
+        public readonly bool? Port_forward;
+        /// <summary>
         /// Settings for the connection type
         /// </summary>
         public readonly ImmutableDictionary<string, string> Settings;
@@ -41,6 +45,8 @@ namespace WTTech.Aem.Compose.Outputs
 
             ImmutableDictionary<string, string>? credentials,
 
+            bool? port_forward,
+
             ImmutableDictionary<string, string> settings,
 
             string? state_timeout,
@@ -49,6 +55,7 @@ namespace WTTech.Aem.Compose.Outputs
         {
             Action_timeout = action_timeout;
             Credentials = credentials;
+            Port_forward = port_forward;
             Settings = settings;
             State_timeout = state_timeout;
             Type = type;
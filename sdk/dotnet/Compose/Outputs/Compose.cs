@@ -14,12 +14,28 @@ namespace WTTech.Aem.Compose.Outputs
     [OutputType]
     public sealed class Compose
     {
+        /// <summary>
+        /// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        /// </summary>
+        public readonly string? Admin_password;
+        /// <summary>
+        /// Settings for backing up AEM instance files.
+        /// </summary>
+        public readonly Outputs.Backup? Backup;
         /// <summary>
         /// Contents of the AEM Compose YML configuration file.
         /// </summary>
         public readonly string? Config;
         /// <summary>
-        /// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+        /// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        /// </summary>
+        public readonly string? Config_lists_strategy;
+        /// <summary>
+        /// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        /// </summary>
+        public readonly object? Config_overrides;
+        /// <summary>
+        /// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
         /// </summary>
         public readonly Outputs.InstanceScript? Configure;
         /// <summary>
@@ -35,14 +51,34 @@ namespace WTTech.Aem.Compose.Outputs
         /// </summary>
         public readonly bool? Download;
         /// <summary>
+        /// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ComposeInstance> Instances;
+        /// <summary>
+        /// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        /// </summary>
+        public readonly Outputs.Readiness? Readiness;
+        /// <summary>
+        /// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        /// </summary>
+        public readonly string? Restore_from;
+        /// <summary>
         /// Version of AEM Compose tool to use on remote machine.
         /// </summary>
         public readonly string? Version;
 
         [OutputConstructor]
         private Compose(
+            string? admin_password,
+
+            Outputs.Backup? backup,
+
             string? config,
 
+            string? config_lists_strategy,
+
+            object? config_overrides,
+
             Outputs.InstanceScript? configure,
 
             Outputs.InstanceScript? create,
@@ -51,13 +87,26 @@ namespace WTTech.Aem.Compose.Outputs
 
             bool? download,
 
+            ImmutableArray<Outputs.ComposeInstance> instances,
+
+            Outputs.Readiness? readiness,
+
+            string? restore_from,
+
             string? version)
         {
+            Admin_password = admin_password;
+            Backup = backup;
             Config = config;
+            Config_lists_strategy = config_lists_strategy;
+            Config_overrides = config_overrides;
             Configure = configure;
             Create = create;
             Delete = delete;
             Download = download;
+            Instances = instances;
+            Readiness = readiness;
+            Restore_from = restore_from;
             Version = version;
         }
     }
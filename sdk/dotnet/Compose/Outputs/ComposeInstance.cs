@@ -0,0 +1,92 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class ComposeInstance
+    {
+        /// <summary>
+        /// Environment variables set for the AEM instance process (in format 'NAME=value').
+        /// </summary>
+        public readonly ImmutableArray<string> Env_vars;
+        /// <summary>
+        /// The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        /// </summary>
+        public readonly string Http_url;
+        /// <summary>
+        /// Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// JVM options passed to the AEM instance process.
+        /// </summary>
+        public readonly ImmutableArray<string> Jvm_opts;
+        /// <summary>
+        /// Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        /// </summary>
+        public readonly string? Password;
+        /// <summary>
+        /// Run modes of the AEM instance.
+        /// </summary>
+        public readonly ImmutableArray<string> Run_modes;
+        /// <summary>
+        /// Secret variables set for the AEM instance process (in format 'NAME=value').
+        /// </summary>
+        public readonly ImmutableArray<string> Secret_vars;
+        /// <summary>
+        /// Sling properties set for the AEM instance (in format 'name=value').
+        /// </summary>
+        public readonly ImmutableArray<string> Sling_props;
+        /// <summary>
+        /// Options passed to the AEM instance start script.
+        /// </summary>
+        public readonly ImmutableArray<string> Start_opts;
+        /// <summary>
+        /// User used to communicate with the AEM instance.
+        /// </summary>
+        public readonly string? User;
+
+        [OutputConstructor]
+        private ComposeInstance(
+            ImmutableArray<string> env_vars,
+
+            string http_url,
+
+            string id,
+
+            ImmutableArray<string> jvm_opts,
+
+            string? password,
+
+            ImmutableArray<string> run_modes,
+
+            ImmutableArray<string> secret_vars,
+
+            ImmutableArray<string> sling_props,
+
+            ImmutableArray<string> start_opts,
+
+            string? user)
+        {
+            Env_vars = env_vars;
+            Http_url = http_url;
+            Id = id;
+            Jvm_opts = jvm_opts;
+            Password = password;
+            Run_modes = run_modes;
+            Secret_vars = secret_vars;
+            Sling_props = sling_props;
+            Start_opts = start_opts;
+            User = user;
+        }
+    }
+}
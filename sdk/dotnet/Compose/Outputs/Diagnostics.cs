@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class Diagnostics
+    {
+        /// <summary>
+        /// Number of the last lines of the AEM logs and system journal to be collected.
+        /// </summary>
+        public readonly int? Log_lines;
+        /// <summary>
+        /// Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+        /// </summary>
+        public readonly string? Output_dir;
+        /// <summary>
+        /// Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+        /// </summary>
+        public readonly int? Summary_lines;
+        /// <summary>
+        /// Toggle collecting thread dumps of the running AEM instances.
+        /// </summary>
+        public readonly bool? Thread_dump;
+
+        [OutputConstructor]
+        private Diagnostics(
+            int? log_lines,
+
+            string? output_dir,
+
+            int? summary_lines,
+
+            bool? thread_dump)
+        {
+            Log_lines = log_lines;
+            Output_dir = output_dir;
+            Summary_lines = summary_lines;
+            Thread_dump = thread_dump;
+        }
+    }
+}
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class GroupInstanceModel
+    {
+        /// <summary>
+        /// Indicates if the group exists on the AEM instance.
+        /// </summary>
+        public readonly bool Exists;
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Identifiers of the direct members of the group on the AEM instance.
+        /// </summary>
+        public readonly ImmutableArray<string> Members;
+        /// <summary>
+        /// Repository path of the group on the AEM instance.
+        /// </summary>
+        public readonly string Path;
+
+        [OutputConstructor]
+        private GroupInstanceModel(
+            bool exists,
+
+            string id,
+
+            ImmutableArray<string> members,
+
+            string path)
+        {
+            Exists = exists;
+            Id = id;
+            Members = members;
+            Path = path;
+        }
+    }
+}
@@ -23,18 +23,46 @@ namespace WTTech.Aem.Compose.Outputs
         /// </summary>
         public readonly ImmutableArray<string> Attributes;
         /// <summary>
+        /// Indicates if the AEM instance files are created on the machine.
+        /// </summary>
+        public readonly bool Created;
+        /// <summary>
+        /// Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.
+        /// </summary>
+        public readonly int Debug_port;
+        /// <summary>
         /// Remote path in which AEM instance is stored.
         /// </summary>
         public readonly string Dir;
         /// <summary>
+        /// A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.
+        /// </summary>
+        public readonly ImmutableArray<string> Health_checks;
+        /// <summary>
+        /// Port on which the AEM instance accepts HTTP requests.
+        /// </summary>
+        public readonly int Http_port;
+        /// <summary>
         /// Unique identifier of AEM instance defined in the configuration.
         /// </summary>
         public readonly string Id;
         /// <summary>
+        /// Indicates if the AEM instance is running and responds to HTTP requests.
+        /// </summary>
+        public readonly bool Reachable;
+        /// <summary>
         /// A list of run modes for a specific AEM instance.
         /// </summary>
         public readonly ImmutableArray<string> Run_modes;
         /// <summary>
+        /// Indicates if the AEM instance process is running.
+        /// </summary>
+        public readonly bool Running;
+        /// <summary>
+        /// Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).
+        /// </summary>
+        public readonly bool Up_to_date;
+        /// <summary>
         /// The machine-internal HTTP URL address used for communication with the AEM instance.
         /// </summary>
         public readonly string Url;
@@ -45,19 +73,40 @@ namespace WTTech.Aem.Compose.Outputs
 
             ImmutableArray<string> attributes,
 
+            bool created,
+
+            int debug_port,
+
             string dir,
 
+            ImmutableArray<string> health_checks,
+
+            int http_port,
+
             string id,
 
+            bool reachable,
+
             ImmutableArray<string> run_modes,
 
+            bool running,
+
+            bool up_to_date,
+
             string url)
         {
             Aem_version = aem_version;
             Attributes = attributes;
+            Created = created;
+            Debug_port = debug_port;
             Dir = dir;
+            Health_checks = health_checks;
+            Http_port = http_port;
             Id = id;
+            Reachable = reachable;
             Run_modes = run_modes;
+            Running = running;
+            Up_to_date = up_to_date;
             Url = url;
         }
     }
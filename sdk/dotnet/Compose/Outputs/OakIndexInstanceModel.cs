@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class OakIndexInstanceModel
+    {
+        /// <summary>
+        /// Indicates if the index definition exists on the AEM instance.
+        /// </summary>
+        public readonly bool Exists;
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Number of times the index was rebuilt on the AEM instance.
+        /// </summary>
+        public readonly int Reindex_count;
+        /// <summary>
+        /// Indicates if the index is being rebuilt on the AEM instance.
+        /// </summary>
+        public readonly bool Reindexing;
+
+        [OutputConstructor]
+        private OakIndexInstanceModel(
+            bool exists,
+
+            string id,
+
+            int reindex_count,
+
+            bool reindexing)
+        {
+            Exists = exists;
+            Id = id;
+            Reindex_count = reindex_count;
+            Reindexing = reindexing;
+        }
+    }
+}
@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class OsgiConfigInstanceModel
+    {
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Properties of the OSGi configuration read from the AEM instance.
+        /// </summary>
+        public readonly ImmutableDictionary<string, object> Properties;
+
+        [OutputConstructor]
+        private OsgiConfigInstanceModel(
+            string id,
+
+            ImmutableDictionary<string, object> properties)
+        {
+            Id = id;
+            Properties = properties;
+        }
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class PackageInstanceModel
+    {
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Indicates if the package is installed on the AEM instance.
+        /// </summary>
+        public readonly bool Installed;
+        /// <summary>
+        /// Time at which the package was installed on the AEM instance.
+        /// </summary>
+        public readonly string Last_unpacked;
+
+        [OutputConstructor]
+        private PackageInstanceModel(
+            string id,
+
+            bool installed,
+
+            string last_unpacked)
+        {
+            Id = id;
+            Installed = installed;
+            Last_unpacked = last_unpacked;
+        }
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class Preflight
+    {
+        /// <summary>
+        /// Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+        /// </summary>
+        public readonly string? Java_version;
+        /// <summary>
+        /// Minimum free disk space in gigabytes required in the data directory.
+        /// </summary>
+        public readonly int? Min_disk_space;
+        /// <summary>
+        /// Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+        /// </summary>
+        public readonly bool? Ports;
+
+        [OutputConstructor]
+        private Preflight(
+            string? java_version,
+
+            int? min_disk_space,
+
+            bool? ports)
+        {
+            Java_version = java_version;
+            Min_disk_space = min_disk_space;
+            Ports = ports;
+        }
+    }
+}
@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class Readiness
+    {
+        /// <summary>
+        /// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        /// </summary>
+        public readonly ImmutableArray<string> Attributes;
+        /// <summary>
+        /// Time between subsequent readiness checks.
+        /// </summary>
+        public readonly string? Interval;
+        /// <summary>
+        /// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        /// </summary>
+        public readonly string? Policy;
+        /// <summary>
+        /// HTTP requests performed against each instance which need to respond with the expected status.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ReadinessProbe> Probes;
+        /// <summary>
+        /// Maximum time to wait for the instances to become ready.
+        /// </summary>
+        public readonly string? Timeout;
+
+        [OutputConstructor]
+        private Readiness(
+            ImmutableArray<string> attributes,
+
+            string? interval,
+
+            string? policy,
+
+            ImmutableArray<Outputs.ReadinessProbe> probes,
+
+            string? timeout)
+        {
+            Attributes = attributes;
+            Interval = interval;
+            Policy = policy;
+            Probes = probes;
+            Timeout = timeout;
+        }
+    }
+}
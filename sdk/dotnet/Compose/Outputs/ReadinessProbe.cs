@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class ReadinessProbe
+    {
+        /// <summary>
+        /// Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        /// </summary>
+        public readonly string Path;
+        /// <summary>
+        /// Expected HTTP status of the response.
+        /// </summary>
+        public readonly int? Status;
+
+        [OutputConstructor]
+        private ReadinessProbe(
+            string path,
+
+            int? status)
+        {
+            Path = path;
+            Status = status;
+        }
+    }
+}
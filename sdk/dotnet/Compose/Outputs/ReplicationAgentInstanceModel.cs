@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class ReplicationAgentInstanceModel
+    {
+        /// <summary>
+        /// Indicates if the replication agent is enabled on the AEM instance.
+        /// </summary>
+        public readonly bool Enabled;
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// URI of the replication target read from the AEM instance.
+        /// </summary>
+        public readonly string Transport_uri;
+
+        [OutputConstructor]
+        private ReplicationAgentInstanceModel(
+            bool enabled,
+
+            string id,
+
+            string transport_uri)
+        {
+            Enabled = enabled;
+            Id = id;
+            Transport_uri = transport_uri;
+        }
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class RepoNodeInstanceModel
+    {
+        /// <summary>
+        /// Indicates if the repository node exists on the AEM instance.
+        /// </summary>
+        public readonly bool Exists;
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Properties of the repository node read from the AEM instance.
+        /// </summary>
+        public readonly ImmutableDictionary<string, object> Properties;
+
+        [OutputConstructor]
+        private RepoNodeInstanceModel(
+            bool exists,
+
+            string id,
+
+            ImmutableDictionary<string, object> properties)
+        {
+            Exists = exists;
+            Id = id;
+            Properties = properties;
+        }
+    }
+}
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class Schedule
+    {
+        /// <summary>
+        /// Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        /// </summary>
+        public readonly string? Builtin;
+        /// <summary>
+        /// Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        /// </summary>
+        public readonly string On_calendar;
+        /// <summary>
+        /// Script(s) to be executed. Mutually exclusive with 'builtin'.
+        /// </summary>
+        public readonly Outputs.InstanceScript? Script;
+
+        [OutputConstructor]
+        private Schedule(
+            string? builtin,
+
+            string name,
+
+            string on_calendar,
+
+            Outputs.InstanceScript? script)
+        {
+            Builtin = builtin;
+            Name = name;
+            On_calendar = on_calendar;
+            Script = script;
+        }
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class ScriptInstanceModel
+    {
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Standard output of the script executed on the AEM instance.
+        /// </summary>
+        public readonly string Output;
+        /// <summary>
+        /// Result returned by the Groovy script executed on the AEM instance.
+        /// </summary>
+        public readonly string Result;
+
+        [OutputConstructor]
+        private ScriptInstanceModel(
+            string id,
+
+            string output,
+
+            string result)
+        {
+            Id = id;
+            Output = output;
+            Result = result;
+        }
+    }
+}
@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class SmokeTestAuth
+    {
+        /// <summary>
+        /// Password of the user.
+        /// </summary>
+        public readonly string? Password;
+        /// <summary>
+        /// Name of the user. When empty, the request is performed anonymously.
+        /// </summary>
+        public readonly string? User;
+
+        [OutputConstructor]
+        private SmokeTestAuth(
+            string? password,
+
+            string? user)
+        {
+            Password = password;
+            User = user;
+        }
+    }
+}
@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class SmokeTestCheck
+    {
+        /// <summary>
+        /// Credentials used to perform the request. By default, the credentials of the instance are used.
+        /// </summary>
+        public readonly Outputs.SmokeTestAuth? Auth;
+        /// <summary>
+        /// Regular expression which the response body needs to match.
+        /// </summary>
+        public readonly string? Expected_body;
+        /// <summary>
+        /// Expected HTTP status of the response.
+        /// </summary>
+        public readonly int? Expected_status;
+        /// <summary>
+        /// Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+        /// </summary>
+        public readonly string? Json_path;
+        /// <summary>
+        /// Expected value found at the JSON path.
+        /// </summary>
+        public readonly string? Json_value;
+        /// <summary>
+        /// HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+        /// </summary>
+        public readonly string? Method;
+        /// <summary>
+        /// Name of the check used in the results. By default, the method and path are used.
+        /// </summary>
+        public readonly string? Name;
+        /// <summary>
+        /// Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+        /// </summary>
+        public readonly string Path;
+
+        [OutputConstructor]
+        private SmokeTestCheck(
+            Outputs.SmokeTestAuth? auth,
+
+            string? expected_body,
+
+            int? expected_status,
+
+            string? json_path,
+
+            string? json_value,
+
+            string? method,
+
+            string? name,
+
+            string path)
+        {
+            Auth = auth;
+            Expected_body = expected_body;
+            Expected_status = expected_status;
+            Json_path = json_path;
+            Json_value = json_value;
+            Method = method;
+            Name = name;
+            Path = path;
+        }
+    }
+}
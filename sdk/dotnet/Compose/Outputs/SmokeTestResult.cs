@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class SmokeTestResult
+    {
+        /// <summary>
+        /// Name of the check.
+        /// </summary>
+        public readonly string Check;
+        /// <summary>
+        /// Unique identifier of AEM instance on which the check was performed.
+        /// </summary>
+        public readonly string Instance_id;
+        /// <summary>
+        /// Reason of the failure.
+        /// </summary>
+        public readonly string Message;
+        /// <summary>
+        /// Indicates if the response met the expectations.
+        /// </summary>
+        public readonly bool Passed;
+        /// <summary>
+        /// HTTP status of the response.
+        /// </summary>
+        public readonly int Status;
+
+        [OutputConstructor]
+        private SmokeTestResult(
+            string check,
+
+            string instance_id,
+
+            string message,
+
+            bool passed,
+
+            int status)
+        {
+            Check = check;
+            Instance_id = instance_id;
+            Message = message;
+            Passed = passed;
+            Status = status;
+        }
+    }
+}
@@ -27,6 +27,10 @@ namespace WTTech.Aem.Compose.Outputs
         /// </summary>
         public readonly ImmutableDictionary<string, string>? Env;
         /// <summary>
+        /// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.Schedule> Schedules;
+        /// <summary>
         /// Contents of the AEM system service definition file (systemd).
         /// </summary>
         public readonly string? Service_config;
@@ -47,6 +51,8 @@ namespace WTTech.Aem.Compose.Outputs
 
             ImmutableDictionary<string, string>? env,
 
+            ImmutableArray<Outputs.Schedule> schedules,
+
             string? service_config,
 
             string? user,
@@ -56,6 +62,7 @@ namespace WTTech.Aem.Compose.Outputs
             Bootstrap = bootstrap;
             Data_dir = data_dir;
             Env = env;
+            Schedules = schedules;
             Service_config = service_config;
             User = user;
             Work_dir = work_dir;
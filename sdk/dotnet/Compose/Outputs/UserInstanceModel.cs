@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose.Outputs
+{
+
+    [OutputType]
+    public sealed class UserInstanceModel
+    {
+        /// <summary>
+        /// Indicates if the user exists on the AEM instance.
+        /// </summary>
+        public readonly bool Exists;
+        /// <summary>
+        /// Identifiers of the groups to which the user directly belongs on the AEM instance.
+        /// </summary>
+        public readonly ImmutableArray<string> Groups;
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// Repository path of the user on the AEM instance.
+        /// </summary>
+        public readonly string Path;
+
+        [OutputConstructor]
+        private UserInstanceModel(
+            bool exists,
+
+            ImmutableArray<string> groups,
+
+            string id,
+
+            string path)
+        {
+            Exists = exists;
+            Groups = groups;
+            Id = id;
+            Path = path;
+        }
+    }
+}
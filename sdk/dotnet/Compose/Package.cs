@@ -0,0 +1,202 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Content package deployed to the AEM instances. Redeployed when the package file changes and uninstalled when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:Package")]
+    public partial class Package : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+        /// </summary>
+        [Output("checksum")]
+        public Output<string?> Checksum { get; private set; } = null!;
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Local path of the AEM package file to be deployed.
+        /// </summary>
+        [Output("file")]
+        public Output<string?> File { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the AEM package on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.PackageInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+        /// </summary>
+        [Output("maven")]
+        public Output<string?> Maven { get; private set; } = null!;
+
+        /// <summary>
+        /// URL of the Maven repository from which the AEM package is downloaded.
+        /// </summary>
+        [Output("maven_repository")]
+        public Output<string?> Maven_repository { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifier of the AEM package (in format 'group:name:version').
+        /// </summary>
+        [Output("pid")]
+        public Output<string> Pid { get; private set; } = null!;
+
+        /// <summary>
+        /// Remote path of the AEM package file on the machine.
+        /// </summary>
+        [Output("remote_path")]
+        public Output<string> Remote_path { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// URL of the AEM package file to be downloaded on the machine and deployed.
+        /// </summary>
+        [Output("url")]
+        public Output<string?> Url { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Package resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Package(string name, PackageArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:Package", name, args ?? new PackageArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Package(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:Package", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Package resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Package Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Package(name, id, options);
+        }
+    }
+
+    public sealed class PackageArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+        /// </summary>
+        [Input("checksum")]
+        public Input<string>? Checksum { get; set; }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Local path of the AEM package file to be deployed.
+        /// </summary>
+        [Input("file")]
+        public Input<string>? File { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+        /// </summary>
+        [Input("maven")]
+        public Input<string>? Maven { get; set; }
+
+        /// <summary>
+        /// URL of the Maven repository from which the AEM package is downloaded.
+        /// </summary>
+        [Input("maven_repository")]
+        public Input<string>? Maven_repository { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        /// <summary>
+        /// URL of the AEM package file to be downloaded on the machine and deployed.
+        /// </summary>
+        [Input("url")]
+        public Input<string>? Url { get; set; }
+
+        public PackageArgs()
+        {
+        }
+        public static new PackageArgs Empty => new PackageArgs();
+    }
+}
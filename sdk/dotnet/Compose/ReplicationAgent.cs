@@ -0,0 +1,280 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Replication agent set up on the AEM instances. Deleted from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:ReplicationAgent")]
+    public partial class ReplicationAgent : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Toggle the replication agent.
+        /// </summary>
+        [Output("enabled")]
+        public Output<bool?> Enabled { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the replication agent on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.ReplicationAgentInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+        /// </summary>
+        [Output("location")]
+        public Output<string?> Location { get; private set; } = null!;
+
+        /// <summary>
+        /// Name of the replication agent. By default, the resource name is used.
+        /// </summary>
+        [Output("name")]
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Repository path of the replication agent.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+        /// </summary>
+        [Output("properties")]
+        public Output<ImmutableDictionary<string, object>?> Properties { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// Password used to authenticate on the replication target.
+        /// </summary>
+        [Output("transport_password")]
+        public Output<string?> Transport_password { get; private set; } = null!;
+
+        /// <summary>
+        /// URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+        /// </summary>
+        [Output("transport_uri")]
+        public Output<string> Transport_uri { get; private set; } = null!;
+
+        /// <summary>
+        /// User used to authenticate on the replication target.
+        /// </summary>
+        [Output("transport_user")]
+        public Output<string?> Transport_user { get; private set; } = null!;
+
+        /// <summary>
+        /// Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+        /// </summary>
+        [Output("type")]
+        public Output<string?> Type { get; private set; } = null!;
+
+        /// <summary>
+        /// User whose permissions are used when replicating content. By default, the system user is used.
+        /// </summary>
+        [Output("user_id")]
+        public Output<string?> User_id { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ReplicationAgent resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ReplicationAgent(string name, ReplicationAgentArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:ReplicationAgent", name, args ?? new ReplicationAgentArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ReplicationAgent(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:ReplicationAgent", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                AdditionalSecretOutputs =
+                {
+                    "transport_password",
+                    "transport_user",
+                },
+                ReplaceOnChanges =
+                {
+                    "location",
+                    "name",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ReplicationAgent resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ReplicationAgent Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ReplicationAgent(name, id, options);
+        }
+    }
+
+    public sealed class ReplicationAgentArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Toggle the replication agent.
+        /// </summary>
+        [Input("enabled")]
+        public Input<bool>? Enabled { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+        /// </summary>
+        [Input("location")]
+        public Input<string>? Location { get; set; }
+
+        /// <summary>
+        /// Name of the replication agent. By default, the resource name is used.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        [Input("properties")]
+        private InputMap<object>? _properties;
+
+        /// <summary>
+        /// Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+        /// </summary>
+        public InputMap<object> Properties
+        {
+            get => _properties ?? (_properties = new InputMap<object>());
+            set => _properties = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        [Input("transport_password")]
+        private Input<string>? _transport_password;
+
+        /// <summary>
+        /// Password used to authenticate on the replication target.
+        /// </summary>
+        public Input<string>? Transport_password
+        {
+            get => _transport_password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _transport_password = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+        /// </summary>
+        [Input("transport_uri", required: true)]
+        public Input<string> Transport_uri { get; set; } = null!;
+
+        [Input("transport_user")]
+        private Input<string>? _transport_user;
+
+        /// <summary>
+        /// User used to authenticate on the replication target.
+        /// </summary>
+        public Input<string>? Transport_user
+        {
+            get => _transport_user;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _transport_user = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+        /// </summary>
+        [Input("type")]
+        public Input<string>? Type { get; set; }
+
+        /// <summary>
+        /// User whose permissions are used when replicating content. By default, the system user is used.
+        /// </summary>
+        [Input("user_id")]
+        public Input<string>? User_id { get; set; }
+
+        public ReplicationAgentArgs()
+        {
+        }
+        public static new ReplicationAgentArgs Empty => new ReplicationAgentArgs();
+    }
+}
@@ -0,0 +1,194 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Repository node with properties and child nodes saved on the AEM instances (e.g. cloud service or context-aware configuration). Removed from the instances when the resource is deleted (in merge mode, only the managed properties and child nodes are removed).
+    /// </summary>
+    [AemResourceType("aem:compose:RepoNode")]
+    public partial class RepoNode : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+        /// </summary>
+        [Output("children")]
+        public Output<ImmutableDictionary<string, object>?> Children { get; private set; } = null!;
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the repository node on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.RepoNodeInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+        /// </summary>
+        [Output("mode")]
+        public Output<string?> Mode { get; private set; } = null!;
+
+        /// <summary>
+        /// Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+        /// </summary>
+        [Output("properties")]
+        public Output<ImmutableDictionary<string, object>> Properties { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a RepoNode resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public RepoNode(string name, RepoNodeArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:RepoNode", name, args ?? new RepoNodeArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private RepoNode(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:RepoNode", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                ReplaceOnChanges =
+                {
+                    "path",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing RepoNode resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static RepoNode Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new RepoNode(name, id, options);
+        }
+    }
+
+    public sealed class RepoNodeArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("children")]
+        private InputMap<object>? _children;
+
+        /// <summary>
+        /// Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+        /// </summary>
+        public InputMap<object> Children
+        {
+            get => _children ?? (_children = new InputMap<object>());
+            set => _children = value;
+        }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+        /// </summary>
+        [Input("mode")]
+        public Input<string>? Mode { get; set; }
+
+        /// <summary>
+        /// Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        [Input("properties", required: true)]
+        private InputMap<object>? _properties;
+
+        /// <summary>
+        /// Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+        /// </summary>
+        public InputMap<object> Properties
+        {
+            get => _properties ?? (_properties = new InputMap<object>());
+            set => _properties = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public RepoNodeArgs()
+        {
+        }
+        public static new RepoNodeArgs Empty => new RepoNodeArgs();
+    }
+}
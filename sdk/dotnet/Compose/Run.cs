@@ -0,0 +1,159 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    public static class Run
+    {
+        /// <summary>
+        /// Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).
+        /// </summary>
+        public static Task<RunResult> InvokeAsync(RunArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<RunResult>("aem:compose:run", args ?? new RunArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).
+        /// </summary>
+        public static Output<RunResult> Invoke(RunInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<RunResult>("aem:compose:run", args ?? new RunInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class RunArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Inputs.Client Client { get; set; } = null!;
+
+        /// <summary>
+        /// Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.
+        /// </summary>
+        [Input("command")]
+        public string? Command { get; set; }
+
+        /// <summary>
+        /// Remote directory in which the command is executed. By default, the data directory is used.
+        /// </summary>
+        [Input("dir")]
+        public string? Dir { get; set; }
+
+        /// <summary>
+        /// Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.
+        /// </summary>
+        [Input("script")]
+        public Inputs.InstanceScript? Script { get; set; }
+
+        /// <summary>
+        /// Execute the command as a superuser.
+        /// </summary>
+        [Input("sudo")]
+        public bool? Sudo { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Inputs.System? System { get; set; }
+
+        /// <summary>
+        /// Maximum time of the command execution. By default, the client action timeout is used.
+        /// </summary>
+        [Input("timeout")]
+        public string? Timeout { get; set; }
+
+        public RunArgs()
+        {
+        }
+        public static new RunArgs Empty => new RunArgs();
+    }
+
+    public sealed class RunInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.
+        /// </summary>
+        [Input("command")]
+        public Input<string>? Command { get; set; }
+
+        /// <summary>
+        /// Remote directory in which the command is executed. By default, the data directory is used.
+        /// </summary>
+        [Input("dir")]
+        public Input<string>? Dir { get; set; }
+
+        /// <summary>
+        /// Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.
+        /// </summary>
+        [Input("script")]
+        public Input<Inputs.InstanceScriptArgs>? Script { get; set; }
+
+        /// <summary>
+        /// Execute the command as a superuser.
+        /// </summary>
+        [Input("sudo")]
+        public Input<bool>? Sudo { get; set; }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        /// <summary>
+        /// Maximum time of the command execution. By default, the client action timeout is used.
+        /// </summary>
+        [Input("timeout")]
+        public Input<string>? Timeout { get; set; }
+
+        public RunInvokeArgs()
+        {
+        }
+        public static new RunInvokeArgs Empty => new RunInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class RunResult
+    {
+        /// <summary>
+        /// Exit code of the command. Equals 124 when the command timed out.
+        /// </summary>
+        public readonly int Exit_code;
+        /// <summary>
+        /// Standard error of the command.
+        /// </summary>
+        public readonly string Stderr;
+        /// <summary>
+        /// Standard output of the command.
+        /// </summary>
+        public readonly string Stdout;
+
+        [OutputConstructor]
+        private RunResult(
+            int exit_code,
+
+            string stderr,
+
+            string stdout)
+        {
+            Exit_code = exit_code;
+            Stderr = stderr;
+            Stdout = stdout;
+        }
+    }
+}
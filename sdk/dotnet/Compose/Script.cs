@@ -0,0 +1,196 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// Groovy script or AEM Compose CLI command executed on the AEM instances once per trigger values (e.g. content migration). Deleting the resource does not revert the effects of the script.
+    /// </summary>
+    [AemResourceType("aem:compose:Script")]
+    public partial class Script : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+        /// </summary>
+        [Output("command")]
+        public Output<ImmutableArray<string>> Command { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Data passed to the Groovy script (available as 'data' variable).
+        /// </summary>
+        [Output("data")]
+        public Output<ImmutableDictionary<string, object>?> Data { get; private set; } = null!;
+
+        /// <summary>
+        /// Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+        /// </summary>
+        [Output("groovy")]
+        public Output<string?> Groovy { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Outputs recorded during the last execution of the script on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.ScriptInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, string>?> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Script resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Script(string name, ScriptArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:Script", name, args ?? new ScriptArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Script(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:Script", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Script resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Script Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Script(name, id, options);
+        }
+    }
+
+    public sealed class ScriptArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        [Input("command")]
+        private InputList<string>? _command;
+
+        /// <summary>
+        /// Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+        /// </summary>
+        public InputList<string> Command
+        {
+            get => _command ?? (_command = new InputList<string>());
+            set => _command = value;
+        }
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("data")]
+        private InputMap<object>? _data;
+
+        /// <summary>
+        /// Data passed to the Groovy script (available as 'data' variable).
+        /// </summary>
+        public InputMap<object> Data
+        {
+            get => _data ?? (_data = new InputMap<object>());
+            set => _data = value;
+        }
+
+        /// <summary>
+        /// Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+        /// </summary>
+        [Input("groovy")]
+        public Input<string>? Groovy { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        [Input("triggers")]
+        private InputMap<string>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+        /// </summary>
+        public InputMap<string> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<string>());
+            set => _triggers = value;
+        }
+
+        public ScriptArgs()
+        {
+        }
+        public static new ScriptArgs Empty => new ScriptArgs();
+    }
+}
@@ -0,0 +1,172 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// HTTP checks performed against the AEM instances after provisioning. Fails the deployment when any of the checks does not pass.
+    /// </summary>
+    [AemResourceType("aem:compose:SmokeTest")]
+    public partial class SmokeTest : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// HTTP checks performed against each target AEM instance.
+        /// </summary>
+        [Output("checks")]
+        public Output<ImmutableArray<Outputs.SmokeTestCheck>> Checks { get; private set; } = null!;
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Indicates if all checks passed on all target instances.
+        /// </summary>
+        [Output("passed")]
+        public Output<bool> Passed { get; private set; } = null!;
+
+        /// <summary>
+        /// Results of the checks recorded during the last run per instance.
+        /// </summary>
+        [Output("results")]
+        public Output<ImmutableArray<Outputs.SmokeTestResult>> Results { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<Outputs.System?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, string>?> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SmokeTest resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SmokeTest(string name, SmokeTestArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:SmokeTest", name, args ?? new SmokeTestArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SmokeTest(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:SmokeTest", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SmokeTest resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SmokeTest Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SmokeTest(name, id, options);
+        }
+    }
+
+    public sealed class SmokeTestArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("checks", required: true)]
+        private InputList<Inputs.SmokeTestCheckArgs>? _checks;
+
+        /// <summary>
+        /// HTTP checks performed against each target AEM instance.
+        /// </summary>
+        public InputList<Inputs.SmokeTestCheckArgs> Checks
+        {
+            get => _checks ?? (_checks = new InputList<Inputs.SmokeTestCheckArgs>());
+            set => _checks = value;
+        }
+
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        [Input("triggers")]
+        private InputMap<string>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+        /// </summary>
+        public InputMap<string> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<string>());
+            set => _triggers = value;
+        }
+
+        public SmokeTestArgs()
+        {
+        }
+        public static new SmokeTestArgs Empty => new SmokeTestArgs();
+    }
+}
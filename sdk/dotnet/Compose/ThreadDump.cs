@@ -0,0 +1,108 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    public static class ThreadDump
+    {
+        /// <summary>
+        /// Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+        /// </summary>
+        public static Task<ThreadDumpResult> InvokeAsync(ThreadDumpArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ThreadDumpResult>("aem:compose:threadDump", args ?? new ThreadDumpArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+        /// </summary>
+        public static Output<ThreadDumpResult> Invoke(ThreadDumpInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ThreadDumpResult>("aem:compose:threadDump", args ?? new ThreadDumpInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ThreadDumpArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Inputs.Client Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Inputs.Compose? Compose { get; set; }
+
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+        /// </summary>
+        [Input("instance_id", required: true)]
+        public string Instance_id { get; set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Inputs.System? System { get; set; }
+
+        public ThreadDumpArgs()
+        {
+        }
+        public static new ThreadDumpArgs Empty => new ThreadDumpArgs();
+    }
+
+    public sealed class ThreadDumpInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+        /// </summary>
+        [Input("instance_id", required: true)]
+        public Input<string> Instance_id { get; set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("system")]
+        public Input<Inputs.SystemArgs>? System { get; set; }
+
+        public ThreadDumpInvokeArgs()
+        {
+        }
+        public static new ThreadDumpInvokeArgs Empty => new ThreadDumpInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ThreadDumpResult
+    {
+        /// <summary>
+        /// Thread dump of the AEM instance JVM.
+        /// </summary>
+        public readonly string Content;
+
+        [OutputConstructor]
+        private ThreadDumpResult(string content)
+        {
+            Content = content;
+        }
+    }
+}
@@ -0,0 +1,240 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace WTTech.Aem.Compose
+{
+    /// <summary>
+    /// User created on the AEM instances along with its group membership. Deleted from the instances when the resource is deleted.
+    /// </summary>
+    [AemResourceType("aem:compose:User")]
+    public partial class User : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("client")]
+        public Output<Outputs.Client> Client { get; private set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("compose")]
+        public Output<Outputs.Compose?> Compose { get; private set; } = null!;
+
+        /// <summary>
+        /// Email address stored in the user profile.
+        /// </summary>
+        [Output("email")]
+        public Output<string?> Email { get; private set; } = null!;
+
+        /// <summary>
+        /// Family name stored in the user profile.
+        /// </summary>
+        [Output("family_name")]
+        public Output<string?> Family_name { get; private set; } = null!;
+
+        /// <summary>
+        /// Given name stored in the user profile.
+        /// </summary>
+        [Output("given_name")]
+        public Output<string?> Given_name { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+        /// </summary>
+        [Output("groups")]
+        public Output<ImmutableArray<string>> Groups { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        [Output("instance_ids")]
+        public Output<ImmutableArray<string>> Instance_ids { get; private set; } = null!;
+
+        /// <summary>
+        /// Current state of the user on the target AEM instances.
+        /// </summary>
+        [Output("instances")]
+        public Output<ImmutableArray<Outputs.UserInstanceModel>> Instances { get; private set; } = null!;
+
+        /// <summary>
+        /// Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+        /// </summary>
+        [Output("intermediate_path")]
+        public Output<string?> Intermediate_path { get; private set; } = null!;
+
+        /// <summary>
+        /// Password of the user. When empty, the user is not able to log in with a password.
+        /// </summary>
+        [Output("password")]
+        public Output<string?> Password { get; private set; } = null!;
+
+        /// <summary>
+        /// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Output("system")]
+        public Output<bool?> System { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifier of the user. By default, the resource name is used.
+        /// </summary>
+        [Output("user_id")]
+        public Output<string?> User_id { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a User resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public User(string name, UserArgs args, CustomResourceOptions? options = null)
+            : base("aem:compose:User", name, args ?? new UserArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private User(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("aem:compose:User", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/wttech/pulumi-aem",
+                AdditionalSecretOutputs =
+                {
+                    "password",
+                },
+                ReplaceOnChanges =
+                {
+                    "intermediate_path",
+                    "system",
+                    "user_id",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing User resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static User Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new User(name, id, options);
+        }
+    }
+
+    public sealed class UserArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("client", required: true)]
+        public Input<Inputs.ClientArgs> Client { get; set; } = null!;
+
+        /// <summary>
+        /// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        /// </summary>
+        [Input("compose")]
+        public Input<Inputs.ComposeArgs>? Compose { get; set; }
+
+        /// <summary>
+        /// Email address stored in the user profile.
+        /// </summary>
+        [Input("email")]
+        public Input<string>? Email { get; set; }
+
+        /// <summary>
+        /// Family name stored in the user profile.
+        /// </summary>
+        [Input("family_name")]
+        public Input<string>? Family_name { get; set; }
+
+        /// <summary>
+        /// Given name stored in the user profile.
+        /// </summary>
+        [Input("given_name")]
+        public Input<string>? Given_name { get; set; }
+
+        [Input("groups")]
+        private InputList<string>? _groups;
+
+        /// <summary>
+        /// Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+        /// </summary>
+        public InputList<string> Groups
+        {
+            get => _groups ?? (_groups = new InputList<string>());
+            set => _groups = value;
+        }
+
+        [Input("instance_ids")]
+        private InputList<string>? _instance_ids;
+
+        /// <summary>
+        /// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        /// </summary>
+        public InputList<string> Instance_ids
+        {
+            get => _instance_ids ?? (_instance_ids = new InputList<string>());
+            set => _instance_ids = value;
+        }
+
+        /// <summary>
+        /// Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+        /// </summary>
+        [Input("intermediate_path")]
+        public Input<string>? Intermediate_path { get; set; }
+
+        [Input("password")]
+        private Input<string>? _password;
+
+        /// <summary>
+        /// Password of the user. When empty, the user is not able to log in with a password.
+        /// </summary>
+        public Input<string>? Password
+        {
+            get => _password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _password = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Creates the system (service) user, which has no password and is used by the code through service user mappings.
+        /// </summary>
+        [Input("system")]
+        public Input<bool>? System { get; set; }
+
+        /// <summary>
+        /// Identifier of the user. By default, the resource name is used.
+        /// </summary>
+        [Input("user_id")]
+        public Input<string>? User_id { get; set; }
+
+        public UserArgs()
+        {
+        }
+        public static new UserArgs Empty => new UserArgs();
+    }
+}
@@ -0,0 +1,90 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+func GetInstanceStatus(ctx *pulumi.Context, args *GetInstanceStatusArgs, opts ...pulumi.InvokeOption) (*GetInstanceStatusResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetInstanceStatusResult
+	err := ctx.Invoke("aem:compose:getInstanceStatus", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetInstanceStatusArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids []string `pulumi:"instance_ids"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+}
+
+type GetInstanceStatusResult struct {
+	// Current state of the AEM instances running on the machine.
+	Instances []InstanceModel `pulumi:"instances"`
+}
+
+func GetInstanceStatusOutput(ctx *pulumi.Context, args GetInstanceStatusOutputArgs, opts ...pulumi.InvokeOption) GetInstanceStatusResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (GetInstanceStatusResult, error) {
+			args := v.(GetInstanceStatusArgs)
+			r, err := GetInstanceStatus(ctx, &args, opts...)
+			var s GetInstanceStatusResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(GetInstanceStatusResultOutput)
+}
+
+type GetInstanceStatusOutputArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput `pulumi:"compose"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayInput `pulumi:"instance_ids"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput `pulumi:"system"`
+}
+
+func (GetInstanceStatusOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetInstanceStatusArgs)(nil)).Elem()
+}
+
+type GetInstanceStatusResultOutput struct{ *pulumi.OutputState }
+
+func (GetInstanceStatusResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetInstanceStatusResult)(nil)).Elem()
+}
+
+func (o GetInstanceStatusResultOutput) ToGetInstanceStatusResultOutput() GetInstanceStatusResultOutput {
+	return o
+}
+
+func (o GetInstanceStatusResultOutput) ToGetInstanceStatusResultOutputWithContext(ctx context.Context) GetInstanceStatusResultOutput {
+	return o
+}
+
+// Current state of the AEM instances running on the machine.
+func (o GetInstanceStatusResultOutput) Instances() InstanceModelArrayOutput {
+	return o.ApplyT(func(v GetInstanceStatusResult) []InstanceModel { return v.Instances }).(InstanceModelArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetInstanceStatusResultOutput{})
+}
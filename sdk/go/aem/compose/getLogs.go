@@ -0,0 +1,102 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+func GetLogs(ctx *pulumi.Context, args *GetLogsArgs, opts ...pulumi.InvokeOption) (*GetLogsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetLogsResult
+	err := ctx.Invoke("aem:compose:getLogs", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetLogsArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+	File *string `pulumi:"file"`
+	// Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+	Filter *string `pulumi:"filter"`
+	// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+	Instance_id string `pulumi:"instance_id"`
+	// Number of the last lines to be returned. By default, 100 lines are returned.
+	Lines *int `pulumi:"lines"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+}
+
+type GetLogsResult struct {
+	// Last lines of the log file.
+	Content string `pulumi:"content"`
+}
+
+func GetLogsOutput(ctx *pulumi.Context, args GetLogsOutputArgs, opts ...pulumi.InvokeOption) GetLogsResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (GetLogsResult, error) {
+			args := v.(GetLogsArgs)
+			r, err := GetLogs(ctx, &args, opts...)
+			var s GetLogsResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(GetLogsResultOutput)
+}
+
+type GetLogsOutputArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput `pulumi:"compose"`
+	// Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+	File pulumi.StringPtrInput `pulumi:"file"`
+	// Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+	Filter pulumi.StringPtrInput `pulumi:"filter"`
+	// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+	Instance_id pulumi.StringInput `pulumi:"instance_id"`
+	// Number of the last lines to be returned. By default, 100 lines are returned.
+	Lines pulumi.IntPtrInput `pulumi:"lines"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput `pulumi:"system"`
+}
+
+func (GetLogsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetLogsArgs)(nil)).Elem()
+}
+
+type GetLogsResultOutput struct{ *pulumi.OutputState }
+
+func (GetLogsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetLogsResult)(nil)).Elem()
+}
+
+func (o GetLogsResultOutput) ToGetLogsResultOutput() GetLogsResultOutput {
+	return o
+}
+
+func (o GetLogsResultOutput) ToGetLogsResultOutputWithContext(ctx context.Context) GetLogsResultOutput {
+	return o
+}
+
+// Last lines of the log file.
+func (o GetLogsResultOutput) Content() pulumi.StringOutput {
+	return o.ApplyT(func(v GetLogsResult) string { return v.Content }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetLogsResultOutput{})
+}
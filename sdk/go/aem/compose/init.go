@@ -21,8 +21,30 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "aem:compose:Acl":
+		r = &Acl{}
+	case "aem:compose:Bundle":
+		r = &Bundle{}
+	case "aem:compose:Group":
+		r = &Group{}
 	case "aem:compose:Instance":
 		r = &Instance{}
+	case "aem:compose:OakIndex":
+		r = &OakIndex{}
+	case "aem:compose:OsgiConfig":
+		r = &OsgiConfig{}
+	case "aem:compose:Package":
+		r = &Package{}
+	case "aem:compose:ReplicationAgent":
+		r = &ReplicationAgent{}
+	case "aem:compose:RepoNode":
+		r = &RepoNode{}
+	case "aem:compose:Script":
+		r = &Script{}
+	case "aem:compose:SmokeTest":
+		r = &SmokeTest{}
+	case "aem:compose:User":
+		r = &User{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -15,14 +15,22 @@ import (
 type Instance struct {
 	pulumi.CustomResourceState
 
+	// Backups of the AEM instance files kept in the target location.
+	Backups BackupModelArrayOutput `pulumi:"backups"`
 	// Connection settings used to access the machine on which the AEM instance will be running.
 	Client ClientOutput `pulumi:"client"`
 	// AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
 	Compose ComposePtrOutput `pulumi:"compose"`
+	// Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+	Diagnostics DiagnosticsPtrOutput `pulumi:"diagnostics"`
+	// Contents of the AEM Compose YML configuration file actually written to the machine (after applying overrides).
+	Effective_config pulumi.StringOutput `pulumi:"effective_config"`
 	// Files or directories to be copied into the machine.
 	Files pulumi.StringMapOutput `pulumi:"files"`
 	// Current state of the configured AEM instances.
 	Instances InstanceModelArrayOutput `pulumi:"instances"`
+	// Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+	Preflight PreflightPtrOutput `pulumi:"preflight"`
 	// Operating system configuration for the machine on which AEM instance will be running.
 	System SystemPtrOutput `pulumi:"system"`
 }
@@ -37,6 +45,10 @@ func NewInstance(ctx *pulumi.Context,
 	if args.Client == nil {
 		return nil, errors.New("invalid value for required argument 'Client'")
 	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"effective_config",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Instance
 	err := ctx.RegisterResource("aem:compose:Instance", name, args, &resource, opts...)
@@ -74,8 +86,12 @@ type instanceArgs struct {
 	Client Client `pulumi:"client"`
 	// AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
 	Compose *Compose `pulumi:"compose"`
+	// Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+	Diagnostics *Diagnostics `pulumi:"diagnostics"`
 	// Files or directories to be copied into the machine.
 	Files map[string]string `pulumi:"files"`
+	// Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+	Preflight *Preflight `pulumi:"preflight"`
 	// Operating system configuration for the machine on which AEM instance will be running.
 	System *System `pulumi:"system"`
 }
@@ -86,8 +102,12 @@ type InstanceArgs struct {
 	Client ClientInput
 	// AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
 	Compose ComposePtrInput
+	// Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+	Diagnostics DiagnosticsPtrInput
 	// Files or directories to be copied into the machine.
 	Files pulumi.StringMapInput
+	// Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+	Preflight PreflightPtrInput
 	// Operating system configuration for the machine on which AEM instance will be running.
 	System SystemPtrInput
 }
@@ -179,6 +199,11 @@ func (o InstanceOutput) ToInstanceOutputWithContext(ctx context.Context) Instanc
 	return o
 }
 
+// Backups of the AEM instance files kept in the target location.
+func (o InstanceOutput) Backups() BackupModelArrayOutput {
+	return o.ApplyT(func(v *Instance) BackupModelArrayOutput { return v.Backups }).(BackupModelArrayOutput)
+}
+
 // Connection settings used to access the machine on which the AEM instance will be running.
 func (o InstanceOutput) Client() ClientOutput {
 	return o.ApplyT(func(v *Instance) ClientOutput { return v.Client }).(ClientOutput)
@@ -189,6 +214,16 @@ func (o InstanceOutput) Compose() ComposePtrOutput {
 	return o.ApplyT(func(v *Instance) ComposePtrOutput { return v.Compose }).(ComposePtrOutput)
 }
 
+// Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+func (o InstanceOutput) Diagnostics() DiagnosticsPtrOutput {
+	return o.ApplyT(func(v *Instance) DiagnosticsPtrOutput { return v.Diagnostics }).(DiagnosticsPtrOutput)
+}
+
+// Contents of the AEM Compose YML configuration file actually written to the machine (after applying overrides).
+func (o InstanceOutput) Effective_config() pulumi.StringOutput {
+	return o.ApplyT(func(v *Instance) pulumi.StringOutput { return v.Effective_config }).(pulumi.StringOutput)
+}
+
 // Files or directories to be copied into the machine.
 func (o InstanceOutput) Files() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *Instance) pulumi.StringMapOutput { return v.Files }).(pulumi.StringMapOutput)
@@ -199,6 +234,11 @@ func (o InstanceOutput) Instances() InstanceModelArrayOutput {
 	return o.ApplyT(func(v *Instance) InstanceModelArrayOutput { return v.Instances }).(InstanceModelArrayOutput)
 }
 
+// Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+func (o InstanceOutput) Preflight() PreflightPtrOutput {
+	return o.ApplyT(func(v *Instance) PreflightPtrOutput { return v.Preflight }).(PreflightPtrOutput)
+}
+
 // Operating system configuration for the machine on which AEM instance will be running.
 func (o InstanceOutput) System() SystemPtrOutput {
 	return o.ApplyT(func(v *Instance) SystemPtrOutput { return v.System }).(SystemPtrOutput)
@@ -0,0 +1,343 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// Oak index definition saved on the AEM instances. Reindexing is triggered when the definition changes and awaited before the operation completes. Removed from the instances when the resource is deleted.
+type OakIndex struct {
+	pulumi.CustomResourceState
+
+	// Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+	Async pulumi.StringArrayOutput `pulumi:"async"`
+	// Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+	Children pulumi.MapOutput `pulumi:"children"`
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientOutput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrOutput `pulumi:"compose"`
+	// Repository paths covered by the index.
+	Included_paths pulumi.StringArrayOutput `pulumi:"included_paths"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayOutput `pulumi:"instance_ids"`
+	// Current state of the index on the target AEM instances.
+	Instances OakIndexInstanceModelArrayOutput `pulumi:"instances"`
+	// Name of the index definition node under '/oak:index'. By default, the resource name is used.
+	Name pulumi.StringPtrOutput `pulumi:"name"`
+	// Repository path of the index definition.
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+	Properties pulumi.MapOutput `pulumi:"properties"`
+	// Maximum time to wait for reindexing to complete after the definition is changed.
+	Reindex_timeout pulumi.StringPtrOutput `pulumi:"reindex_timeout"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrOutput `pulumi:"system"`
+	// Type of the index. Possible values are 'lucene' and 'property'.
+	Type pulumi.StringPtrOutput `pulumi:"type"`
+}
+
+// NewOakIndex registers a new resource with the given unique name, arguments, and options.
+func NewOakIndex(ctx *pulumi.Context,
+	name string, args *OakIndexArgs, opts ...pulumi.ResourceOption) (*OakIndex, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Client == nil {
+		return nil, errors.New("invalid value for required argument 'Client'")
+	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"name",
+	})
+	opts = append(opts, replaceOnChanges)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource OakIndex
+	err := ctx.RegisterResource("aem:compose:OakIndex", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetOakIndex gets an existing OakIndex resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetOakIndex(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *OakIndexState, opts ...pulumi.ResourceOption) (*OakIndex, error) {
+	var resource OakIndex
+	err := ctx.ReadResource("aem:compose:OakIndex", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering OakIndex resources.
+type oakIndexState struct {
+}
+
+type OakIndexState struct {
+}
+
+func (OakIndexState) ElementType() reflect.Type {
+	return reflect.TypeOf((*oakIndexState)(nil)).Elem()
+}
+
+type oakIndexArgs struct {
+	// Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+	Async []string `pulumi:"async"`
+	// Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+	Children map[string]interface{} `pulumi:"children"`
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Repository paths covered by the index.
+	Included_paths []string `pulumi:"included_paths"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids []string `pulumi:"instance_ids"`
+	// Name of the index definition node under '/oak:index'. By default, the resource name is used.
+	Name *string `pulumi:"name"`
+	// Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+	Properties map[string]interface{} `pulumi:"properties"`
+	// Maximum time to wait for reindexing to complete after the definition is changed.
+	Reindex_timeout *string `pulumi:"reindex_timeout"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+	// Type of the index. Possible values are 'lucene' and 'property'.
+	Type *string `pulumi:"type"`
+}
+
+// The set of arguments for constructing a OakIndex resource.
+type OakIndexArgs struct {
+	// Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+	Async pulumi.StringArrayInput
+	// Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+	Children pulumi.MapInput
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput
+	// Repository paths covered by the index.
+	Included_paths pulumi.StringArrayInput
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayInput
+	// Name of the index definition node under '/oak:index'. By default, the resource name is used.
+	Name pulumi.StringPtrInput
+	// Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+	Properties pulumi.MapInput
+	// Maximum time to wait for reindexing to complete after the definition is changed.
+	Reindex_timeout pulumi.StringPtrInput
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput
+	// Type of the index. Possible values are 'lucene' and 'property'.
+	Type pulumi.StringPtrInput
+}
+
+func (OakIndexArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*oakIndexArgs)(nil)).Elem()
+}
+
+type OakIndexInput interface {
+	pulumi.Input
+
+	ToOakIndexOutput() OakIndexOutput
+	ToOakIndexOutputWithContext(ctx context.Context) OakIndexOutput
+}
+
+func (*OakIndex) ElementType() reflect.Type {
+	return reflect.TypeOf((**OakIndex)(nil)).Elem()
+}
+
+func (i *OakIndex) ToOakIndexOutput() OakIndexOutput {
+	return i.ToOakIndexOutputWithContext(context.Background())
+}
+
+func (i *OakIndex) ToOakIndexOutputWithContext(ctx context.Context) OakIndexOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OakIndexOutput)
+}
+
+// OakIndexArrayInput is an input type that accepts OakIndexArray and OakIndexArrayOutput values.
+// You can construct a concrete instance of `OakIndexArrayInput` via:
+//
+//	OakIndexArray{ OakIndexArgs{...} }
+type OakIndexArrayInput interface {
+	pulumi.Input
+
+	ToOakIndexArrayOutput() OakIndexArrayOutput
+	ToOakIndexArrayOutputWithContext(context.Context) OakIndexArrayOutput
+}
+
+type OakIndexArray []OakIndexInput
+
+func (OakIndexArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*OakIndex)(nil)).Elem()
+}
+
+func (i OakIndexArray) ToOakIndexArrayOutput() OakIndexArrayOutput {
+	return i.ToOakIndexArrayOutputWithContext(context.Background())
+}
+
+func (i OakIndexArray) ToOakIndexArrayOutputWithContext(ctx context.Context) OakIndexArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OakIndexArrayOutput)
+}
+
+// OakIndexMapInput is an input type that accepts OakIndexMap and OakIndexMapOutput values.
+// You can construct a concrete instance of `OakIndexMapInput` via:
+//
+//	OakIndexMap{ "key": OakIndexArgs{...} }
+type OakIndexMapInput interface {
+	pulumi.Input
+
+	ToOakIndexMapOutput() OakIndexMapOutput
+	ToOakIndexMapOutputWithContext(context.Context) OakIndexMapOutput
+}
+
+type OakIndexMap map[string]OakIndexInput
+
+func (OakIndexMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*OakIndex)(nil)).Elem()
+}
+
+func (i OakIndexMap) ToOakIndexMapOutput() OakIndexMapOutput {
+	return i.ToOakIndexMapOutputWithContext(context.Background())
+}
+
+func (i OakIndexMap) ToOakIndexMapOutputWithContext(ctx context.Context) OakIndexMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OakIndexMapOutput)
+}
+
+type OakIndexOutput struct{ *pulumi.OutputState }
+
+func (OakIndexOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OakIndex)(nil)).Elem()
+}
+
+func (o OakIndexOutput) ToOakIndexOutput() OakIndexOutput {
+	return o
+}
+
+func (o OakIndexOutput) ToOakIndexOutputWithContext(ctx context.Context) OakIndexOutput {
+	return o
+}
+
+// Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+func (o OakIndexOutput) Async() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringArrayOutput { return v.Async }).(pulumi.StringArrayOutput)
+}
+
+// Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+func (o OakIndexOutput) Children() pulumi.MapOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.MapOutput { return v.Children }).(pulumi.MapOutput)
+}
+
+// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o OakIndexOutput) Client() ClientOutput {
+	return o.ApplyT(func(v *OakIndex) ClientOutput { return v.Client }).(ClientOutput)
+}
+
+// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+func (o OakIndexOutput) Compose() ComposePtrOutput {
+	return o.ApplyT(func(v *OakIndex) ComposePtrOutput { return v.Compose }).(ComposePtrOutput)
+}
+
+// Repository paths covered by the index.
+func (o OakIndexOutput) Included_paths() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringArrayOutput { return v.Included_paths }).(pulumi.StringArrayOutput)
+}
+
+// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+func (o OakIndexOutput) Instance_ids() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringArrayOutput { return v.Instance_ids }).(pulumi.StringArrayOutput)
+}
+
+// Current state of the index on the target AEM instances.
+func (o OakIndexOutput) Instances() OakIndexInstanceModelArrayOutput {
+	return o.ApplyT(func(v *OakIndex) OakIndexInstanceModelArrayOutput { return v.Instances }).(OakIndexInstanceModelArrayOutput)
+}
+
+// Name of the index definition node under '/oak:index'. By default, the resource name is used.
+func (o OakIndexOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Repository path of the index definition.
+func (o OakIndexOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+func (o OakIndexOutput) Properties() pulumi.MapOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.MapOutput { return v.Properties }).(pulumi.MapOutput)
+}
+
+// Maximum time to wait for reindexing to complete after the definition is changed.
+func (o OakIndexOutput) Reindex_timeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringPtrOutput { return v.Reindex_timeout }).(pulumi.StringPtrOutput)
+}
+
+// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o OakIndexOutput) System() SystemPtrOutput {
+	return o.ApplyT(func(v *OakIndex) SystemPtrOutput { return v.System }).(SystemPtrOutput)
+}
+
+// Type of the index. Possible values are 'lucene' and 'property'.
+func (o OakIndexOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OakIndex) pulumi.StringPtrOutput { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type OakIndexArrayOutput struct{ *pulumi.OutputState }
+
+func (OakIndexArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*OakIndex)(nil)).Elem()
+}
+
+func (o OakIndexArrayOutput) ToOakIndexArrayOutput() OakIndexArrayOutput {
+	return o
+}
+
+func (o OakIndexArrayOutput) ToOakIndexArrayOutputWithContext(ctx context.Context) OakIndexArrayOutput {
+	return o
+}
+
+func (o OakIndexArrayOutput) Index(i pulumi.IntInput) OakIndexOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *OakIndex {
+		return vs[0].([]*OakIndex)[vs[1].(int)]
+	}).(OakIndexOutput)
+}
+
+type OakIndexMapOutput struct{ *pulumi.OutputState }
+
+func (OakIndexMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*OakIndex)(nil)).Elem()
+}
+
+func (o OakIndexMapOutput) ToOakIndexMapOutput() OakIndexMapOutput {
+	return o
+}
+
+func (o OakIndexMapOutput) ToOakIndexMapOutputWithContext(ctx context.Context) OakIndexMapOutput {
+	return o
+}
+
+func (o OakIndexMapOutput) MapIndex(k pulumi.StringInput) OakIndexOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *OakIndex {
+		return vs[0].(map[string]*OakIndex)[vs[1].(string)]
+	}).(OakIndexOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*OakIndexInput)(nil)).Elem(), &OakIndex{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OakIndexArrayInput)(nil)).Elem(), OakIndexArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OakIndexMapInput)(nil)).Elem(), OakIndexMap{})
+	pulumi.RegisterOutputType(OakIndexOutput{})
+	pulumi.RegisterOutputType(OakIndexArrayOutput{})
+	pulumi.RegisterOutputType(OakIndexMapOutput{})
+}
@@ -0,0 +1,315 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// OSGi configuration saved on the AEM instances. Deleted from the instances when the resource is deleted.
+type OsgiConfig struct {
+	pulumi.CustomResourceState
+
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientOutput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrOutput `pulumi:"compose"`
+	// Persistent identifier under which the OSGi configuration is saved (for factory configurations in format 'factory_pid~name').
+	Effective_pid pulumi.StringOutput `pulumi:"effective_pid"`
+	// Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+	Factory_pid pulumi.StringPtrOutput `pulumi:"factory_pid"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayOutput `pulumi:"instance_ids"`
+	// Current state of the OSGi configuration on the target AEM instances.
+	Instances OsgiConfigInstanceModelArrayOutput `pulumi:"instances"`
+	// Name of the factory OSGi configuration instance. By default, the resource name is used.
+	Name pulumi.StringPtrOutput `pulumi:"name"`
+	// Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+	Pid pulumi.StringPtrOutput `pulumi:"pid"`
+	// Properties of the OSGi configuration.
+	Properties pulumi.MapOutput `pulumi:"properties"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrOutput `pulumi:"system"`
+}
+
+// NewOsgiConfig registers a new resource with the given unique name, arguments, and options.
+func NewOsgiConfig(ctx *pulumi.Context,
+	name string, args *OsgiConfigArgs, opts ...pulumi.ResourceOption) (*OsgiConfig, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Client == nil {
+		return nil, errors.New("invalid value for required argument 'Client'")
+	}
+	if args.Properties == nil {
+		return nil, errors.New("invalid value for required argument 'Properties'")
+	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"factory_pid",
+		"name",
+		"pid",
+	})
+	opts = append(opts, replaceOnChanges)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource OsgiConfig
+	err := ctx.RegisterResource("aem:compose:OsgiConfig", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetOsgiConfig gets an existing OsgiConfig resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetOsgiConfig(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *OsgiConfigState, opts ...pulumi.ResourceOption) (*OsgiConfig, error) {
+	var resource OsgiConfig
+	err := ctx.ReadResource("aem:compose:OsgiConfig", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering OsgiConfig resources.
+type osgiConfigState struct {
+}
+
+type OsgiConfigState struct {
+}
+
+func (OsgiConfigState) ElementType() reflect.Type {
+	return reflect.TypeOf((*osgiConfigState)(nil)).Elem()
+}
+
+type osgiConfigArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+	Factory_pid *string `pulumi:"factory_pid"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids []string `pulumi:"instance_ids"`
+	// Name of the factory OSGi configuration instance. By default, the resource name is used.
+	Name *string `pulumi:"name"`
+	// Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+	Pid *string `pulumi:"pid"`
+	// Properties of the OSGi configuration.
+	Properties map[string]interface{} `pulumi:"properties"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+}
+
+// The set of arguments for constructing a OsgiConfig resource.
+type OsgiConfigArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput
+	// Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+	Factory_pid pulumi.StringPtrInput
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayInput
+	// Name of the factory OSGi configuration instance. By default, the resource name is used.
+	Name pulumi.StringPtrInput
+	// Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+	Pid pulumi.StringPtrInput
+	// Properties of the OSGi configuration.
+	Properties pulumi.MapInput
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput
+}
+
+func (OsgiConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*osgiConfigArgs)(nil)).Elem()
+}
+
+type OsgiConfigInput interface {
+	pulumi.Input
+
+	ToOsgiConfigOutput() OsgiConfigOutput
+	ToOsgiConfigOutputWithContext(ctx context.Context) OsgiConfigOutput
+}
+
+func (*OsgiConfig) ElementType() reflect.Type {
+	return reflect.TypeOf((**OsgiConfig)(nil)).Elem()
+}
+
+func (i *OsgiConfig) ToOsgiConfigOutput() OsgiConfigOutput {
+	return i.ToOsgiConfigOutputWithContext(context.Background())
+}
+
+func (i *OsgiConfig) ToOsgiConfigOutputWithContext(ctx context.Context) OsgiConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OsgiConfigOutput)
+}
+
+// OsgiConfigArrayInput is an input type that accepts OsgiConfigArray and OsgiConfigArrayOutput values.
+// You can construct a concrete instance of `OsgiConfigArrayInput` via:
+//
+//	OsgiConfigArray{ OsgiConfigArgs{...} }
+type OsgiConfigArrayInput interface {
+	pulumi.Input
+
+	ToOsgiConfigArrayOutput() OsgiConfigArrayOutput
+	ToOsgiConfigArrayOutputWithContext(context.Context) OsgiConfigArrayOutput
+}
+
+type OsgiConfigArray []OsgiConfigInput
+
+func (OsgiConfigArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*OsgiConfig)(nil)).Elem()
+}
+
+func (i OsgiConfigArray) ToOsgiConfigArrayOutput() OsgiConfigArrayOutput {
+	return i.ToOsgiConfigArrayOutputWithContext(context.Background())
+}
+
+func (i OsgiConfigArray) ToOsgiConfigArrayOutputWithContext(ctx context.Context) OsgiConfigArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OsgiConfigArrayOutput)
+}
+
+// OsgiConfigMapInput is an input type that accepts OsgiConfigMap and OsgiConfigMapOutput values.
+// You can construct a concrete instance of `OsgiConfigMapInput` via:
+//
+//	OsgiConfigMap{ "key": OsgiConfigArgs{...} }
+type OsgiConfigMapInput interface {
+	pulumi.Input
+
+	ToOsgiConfigMapOutput() OsgiConfigMapOutput
+	ToOsgiConfigMapOutputWithContext(context.Context) OsgiConfigMapOutput
+}
+
+type OsgiConfigMap map[string]OsgiConfigInput
+
+func (OsgiConfigMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*OsgiConfig)(nil)).Elem()
+}
+
+func (i OsgiConfigMap) ToOsgiConfigMapOutput() OsgiConfigMapOutput {
+	return i.ToOsgiConfigMapOutputWithContext(context.Background())
+}
+
+func (i OsgiConfigMap) ToOsgiConfigMapOutputWithContext(ctx context.Context) OsgiConfigMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OsgiConfigMapOutput)
+}
+
+type OsgiConfigOutput struct{ *pulumi.OutputState }
+
+func (OsgiConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OsgiConfig)(nil)).Elem()
+}
+
+func (o OsgiConfigOutput) ToOsgiConfigOutput() OsgiConfigOutput {
+	return o
+}
+
+func (o OsgiConfigOutput) ToOsgiConfigOutputWithContext(ctx context.Context) OsgiConfigOutput {
+	return o
+}
+
+// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o OsgiConfigOutput) Client() ClientOutput {
+	return o.ApplyT(func(v *OsgiConfig) ClientOutput { return v.Client }).(ClientOutput)
+}
+
+// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+func (o OsgiConfigOutput) Compose() ComposePtrOutput {
+	return o.ApplyT(func(v *OsgiConfig) ComposePtrOutput { return v.Compose }).(ComposePtrOutput)
+}
+
+// Persistent identifier under which the OSGi configuration is saved (for factory configurations in format 'factory_pid~name').
+func (o OsgiConfigOutput) Effective_pid() pulumi.StringOutput {
+	return o.ApplyT(func(v *OsgiConfig) pulumi.StringOutput { return v.Effective_pid }).(pulumi.StringOutput)
+}
+
+// Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+func (o OsgiConfigOutput) Factory_pid() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OsgiConfig) pulumi.StringPtrOutput { return v.Factory_pid }).(pulumi.StringPtrOutput)
+}
+
+// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+func (o OsgiConfigOutput) Instance_ids() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OsgiConfig) pulumi.StringArrayOutput { return v.Instance_ids }).(pulumi.StringArrayOutput)
+}
+
+// Current state of the OSGi configuration on the target AEM instances.
+func (o OsgiConfigOutput) Instances() OsgiConfigInstanceModelArrayOutput {
+	return o.ApplyT(func(v *OsgiConfig) OsgiConfigInstanceModelArrayOutput { return v.Instances }).(OsgiConfigInstanceModelArrayOutput)
+}
+
+// Name of the factory OSGi configuration instance. By default, the resource name is used.
+func (o OsgiConfigOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OsgiConfig) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+func (o OsgiConfigOutput) Pid() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OsgiConfig) pulumi.StringPtrOutput { return v.Pid }).(pulumi.StringPtrOutput)
+}
+
+// Properties of the OSGi configuration.
+func (o OsgiConfigOutput) Properties() pulumi.MapOutput {
+	return o.ApplyT(func(v *OsgiConfig) pulumi.MapOutput { return v.Properties }).(pulumi.MapOutput)
+}
+
+// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o OsgiConfigOutput) System() SystemPtrOutput {
+	return o.ApplyT(func(v *OsgiConfig) SystemPtrOutput { return v.System }).(SystemPtrOutput)
+}
+
+type OsgiConfigArrayOutput struct{ *pulumi.OutputState }
+
+func (OsgiConfigArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*OsgiConfig)(nil)).Elem()
+}
+
+func (o OsgiConfigArrayOutput) ToOsgiConfigArrayOutput() OsgiConfigArrayOutput {
+	return o
+}
+
+func (o OsgiConfigArrayOutput) ToOsgiConfigArrayOutputWithContext(ctx context.Context) OsgiConfigArrayOutput {
+	return o
+}
+
+func (o OsgiConfigArrayOutput) Index(i pulumi.IntInput) OsgiConfigOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *OsgiConfig {
+		return vs[0].([]*OsgiConfig)[vs[1].(int)]
+	}).(OsgiConfigOutput)
+}
+
+type OsgiConfigMapOutput struct{ *pulumi.OutputState }
+
+func (OsgiConfigMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*OsgiConfig)(nil)).Elem()
+}
+
+func (o OsgiConfigMapOutput) ToOsgiConfigMapOutput() OsgiConfigMapOutput {
+	return o
+}
+
+func (o OsgiConfigMapOutput) ToOsgiConfigMapOutputWithContext(ctx context.Context) OsgiConfigMapOutput {
+	return o
+}
+
+func (o OsgiConfigMapOutput) MapIndex(k pulumi.StringInput) OsgiConfigOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *OsgiConfig {
+		return vs[0].(map[string]*OsgiConfig)[vs[1].(string)]
+	}).(OsgiConfigOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*OsgiConfigInput)(nil)).Elem(), &OsgiConfig{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OsgiConfigArrayInput)(nil)).Elem(), OsgiConfigArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OsgiConfigMapInput)(nil)).Elem(), OsgiConfigMap{})
+	pulumi.RegisterOutputType(OsgiConfigOutput{})
+	pulumi.RegisterOutputType(OsgiConfigArrayOutput{})
+	pulumi.RegisterOutputType(OsgiConfigMapOutput{})
+}
@@ -13,11 +13,486 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type AclInstanceModel struct {
+	// Privileges granted to the principal on the AEM instance.
+	Allow []string `pulumi:"allow"`
+	// Privileges denied to the principal on the AEM instance.
+	Deny []string `pulumi:"deny"`
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+}
+
+type AclInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (AclInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AclInstanceModel)(nil)).Elem()
+}
+
+func (o AclInstanceModelOutput) ToAclInstanceModelOutput() AclInstanceModelOutput {
+	return o
+}
+
+func (o AclInstanceModelOutput) ToAclInstanceModelOutputWithContext(ctx context.Context) AclInstanceModelOutput {
+	return o
+}
+
+// Privileges granted to the principal on the AEM instance.
+func (o AclInstanceModelOutput) Allow() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AclInstanceModel) []string { return v.Allow }).(pulumi.StringArrayOutput)
+}
+
+// Privileges denied to the principal on the AEM instance.
+func (o AclInstanceModelOutput) Deny() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AclInstanceModel) []string { return v.Deny }).(pulumi.StringArrayOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o AclInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v AclInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+type AclInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (AclInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AclInstanceModel)(nil)).Elem()
+}
+
+func (o AclInstanceModelArrayOutput) ToAclInstanceModelArrayOutput() AclInstanceModelArrayOutput {
+	return o
+}
+
+func (o AclInstanceModelArrayOutput) ToAclInstanceModelArrayOutputWithContext(ctx context.Context) AclInstanceModelArrayOutput {
+	return o
+}
+
+func (o AclInstanceModelArrayOutput) Index(i pulumi.IntInput) AclInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AclInstanceModel {
+		return vs[0].([]AclInstanceModel)[vs[1].(int)]
+	}).(AclInstanceModelOutput)
+}
+
+type ActivateResultItem struct {
+	// Unique identifier of AEM instance on which the replication was requested.
+	Instance_id string `pulumi:"instance_id"`
+	// Response message of the replication request.
+	Message string `pulumi:"message"`
+	// Repository path of the replicated content.
+	Path string `pulumi:"path"`
+	// HTTP status of the replication request.
+	Status int `pulumi:"status"`
+	// Indicates if the replication request was accepted.
+	Succeeded bool `pulumi:"succeeded"`
+}
+
+type ActivateResultItemOutput struct{ *pulumi.OutputState }
+
+func (ActivateResultItemOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ActivateResultItem)(nil)).Elem()
+}
+
+func (o ActivateResultItemOutput) ToActivateResultItemOutput() ActivateResultItemOutput {
+	return o
+}
+
+func (o ActivateResultItemOutput) ToActivateResultItemOutputWithContext(ctx context.Context) ActivateResultItemOutput {
+	return o
+}
+
+// Unique identifier of AEM instance on which the replication was requested.
+func (o ActivateResultItemOutput) Instance_id() pulumi.StringOutput {
+	return o.ApplyT(func(v ActivateResultItem) string { return v.Instance_id }).(pulumi.StringOutput)
+}
+
+// Response message of the replication request.
+func (o ActivateResultItemOutput) Message() pulumi.StringOutput {
+	return o.ApplyT(func(v ActivateResultItem) string { return v.Message }).(pulumi.StringOutput)
+}
+
+// Repository path of the replicated content.
+func (o ActivateResultItemOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v ActivateResultItem) string { return v.Path }).(pulumi.StringOutput)
+}
+
+// HTTP status of the replication request.
+func (o ActivateResultItemOutput) Status() pulumi.IntOutput {
+	return o.ApplyT(func(v ActivateResultItem) int { return v.Status }).(pulumi.IntOutput)
+}
+
+// Indicates if the replication request was accepted.
+func (o ActivateResultItemOutput) Succeeded() pulumi.BoolOutput {
+	return o.ApplyT(func(v ActivateResultItem) bool { return v.Succeeded }).(pulumi.BoolOutput)
+}
+
+type ActivateResultItemArrayOutput struct{ *pulumi.OutputState }
+
+func (ActivateResultItemArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ActivateResultItem)(nil)).Elem()
+}
+
+func (o ActivateResultItemArrayOutput) ToActivateResultItemArrayOutput() ActivateResultItemArrayOutput {
+	return o
+}
+
+func (o ActivateResultItemArrayOutput) ToActivateResultItemArrayOutputWithContext(ctx context.Context) ActivateResultItemArrayOutput {
+	return o
+}
+
+func (o ActivateResultItemArrayOutput) Index(i pulumi.IntInput) ActivateResultItemOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ActivateResultItem {
+		return vs[0].([]ActivateResultItem)[vs[1].(int)]
+	}).(ActivateResultItemOutput)
+}
+
+type Backup struct {
+	// Toggle making a backup before deleting the instance.
+	Before_delete *bool `pulumi:"before_delete"`
+	// Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+	Before_update *bool `pulumi:"before_update"`
+	// Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+	Compression *string `pulumi:"compression"`
+	// Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+	Retention *int `pulumi:"retention"`
+	// Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+	Target string `pulumi:"target"`
+}
+
+// BackupInput is an input type that accepts BackupArgs and BackupOutput values.
+// You can construct a concrete instance of `BackupInput` via:
+//
+//	BackupArgs{...}
+type BackupInput interface {
+	pulumi.Input
+
+	ToBackupOutput() BackupOutput
+	ToBackupOutputWithContext(context.Context) BackupOutput
+}
+
+type BackupArgs struct {
+	// Toggle making a backup before deleting the instance.
+	Before_delete pulumi.BoolPtrInput `pulumi:"before_delete"`
+	// Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+	Before_update pulumi.BoolPtrInput `pulumi:"before_update"`
+	// Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+	Compression pulumi.StringPtrInput `pulumi:"compression"`
+	// Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+	Retention pulumi.IntPtrInput `pulumi:"retention"`
+	// Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+	Target pulumi.StringInput `pulumi:"target"`
+}
+
+func (BackupArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Backup)(nil)).Elem()
+}
+
+func (i BackupArgs) ToBackupOutput() BackupOutput {
+	return i.ToBackupOutputWithContext(context.Background())
+}
+
+func (i BackupArgs) ToBackupOutputWithContext(ctx context.Context) BackupOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BackupOutput)
+}
+
+func (i BackupArgs) ToBackupPtrOutput() BackupPtrOutput {
+	return i.ToBackupPtrOutputWithContext(context.Background())
+}
+
+func (i BackupArgs) ToBackupPtrOutputWithContext(ctx context.Context) BackupPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BackupOutput).ToBackupPtrOutputWithContext(ctx)
+}
+
+// BackupPtrInput is an input type that accepts BackupArgs, BackupPtr and BackupPtrOutput values.
+// You can construct a concrete instance of `BackupPtrInput` via:
+//
+//	        BackupArgs{...}
+//
+//	or:
+//
+//	        nil
+type BackupPtrInput interface {
+	pulumi.Input
+
+	ToBackupPtrOutput() BackupPtrOutput
+	ToBackupPtrOutputWithContext(context.Context) BackupPtrOutput
+}
+
+type backupPtrType BackupArgs
+
+func BackupPtr(v *BackupArgs) BackupPtrInput {
+	return (*backupPtrType)(v)
+}
+
+func (*backupPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Backup)(nil)).Elem()
+}
+
+func (i *backupPtrType) ToBackupPtrOutput() BackupPtrOutput {
+	return i.ToBackupPtrOutputWithContext(context.Background())
+}
+
+func (i *backupPtrType) ToBackupPtrOutputWithContext(ctx context.Context) BackupPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BackupPtrOutput)
+}
+
+type BackupOutput struct{ *pulumi.OutputState }
+
+func (BackupOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Backup)(nil)).Elem()
+}
+
+func (o BackupOutput) ToBackupOutput() BackupOutput {
+	return o
+}
+
+func (o BackupOutput) ToBackupOutputWithContext(ctx context.Context) BackupOutput {
+	return o
+}
+
+func (o BackupOutput) ToBackupPtrOutput() BackupPtrOutput {
+	return o.ToBackupPtrOutputWithContext(context.Background())
+}
+
+func (o BackupOutput) ToBackupPtrOutputWithContext(ctx context.Context) BackupPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Backup) *Backup {
+		return &v
+	}).(BackupPtrOutput)
+}
+
+// Toggle making a backup before deleting the instance.
+func (o BackupOutput) Before_delete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Backup) *bool { return v.Before_delete }).(pulumi.BoolPtrOutput)
+}
+
+// Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+func (o BackupOutput) Before_update() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Backup) *bool { return v.Before_update }).(pulumi.BoolPtrOutput)
+}
+
+// Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+func (o BackupOutput) Compression() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Backup) *string { return v.Compression }).(pulumi.StringPtrOutput)
+}
+
+// Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+func (o BackupOutput) Retention() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Backup) *int { return v.Retention }).(pulumi.IntPtrOutput)
+}
+
+// Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+func (o BackupOutput) Target() pulumi.StringOutput {
+	return o.ApplyT(func(v Backup) string { return v.Target }).(pulumi.StringOutput)
+}
+
+type BackupPtrOutput struct{ *pulumi.OutputState }
+
+func (BackupPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Backup)(nil)).Elem()
+}
+
+func (o BackupPtrOutput) ToBackupPtrOutput() BackupPtrOutput {
+	return o
+}
+
+func (o BackupPtrOutput) ToBackupPtrOutputWithContext(ctx context.Context) BackupPtrOutput {
+	return o
+}
+
+func (o BackupPtrOutput) Elem() BackupOutput {
+	return o.ApplyT(func(v *Backup) Backup {
+		if v != nil {
+			return *v
+		}
+		var ret Backup
+		return ret
+	}).(BackupOutput)
+}
+
+// Toggle making a backup before deleting the instance.
+func (o BackupPtrOutput) Before_delete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Backup) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Before_delete
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+func (o BackupPtrOutput) Before_update() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Backup) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Before_update
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+func (o BackupPtrOutput) Compression() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Backup) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Compression
+	}).(pulumi.StringPtrOutput)
+}
+
+// Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+func (o BackupPtrOutput) Retention() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Backup) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Retention
+	}).(pulumi.IntPtrOutput)
+}
+
+// Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+func (o BackupPtrOutput) Target() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Backup) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Target
+	}).(pulumi.StringPtrOutput)
+}
+
+type BackupModel struct {
+	// SHA-256 checksum of the backup file.
+	Checksum string `pulumi:"checksum"`
+	// Location of the uploaded backup file. Could be used as 'restore_from' value.
+	Location string `pulumi:"location"`
+	// Name of the backup file.
+	Name string `pulumi:"name"`
+	// Size of the backup file in bytes.
+	Size int `pulumi:"size"`
+	// Time at which the backup was made (RFC 3339, UTC).
+	Timestamp string `pulumi:"timestamp"`
+}
+
+type BackupModelOutput struct{ *pulumi.OutputState }
+
+func (BackupModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BackupModel)(nil)).Elem()
+}
+
+func (o BackupModelOutput) ToBackupModelOutput() BackupModelOutput {
+	return o
+}
+
+func (o BackupModelOutput) ToBackupModelOutputWithContext(ctx context.Context) BackupModelOutput {
+	return o
+}
+
+// SHA-256 checksum of the backup file.
+func (o BackupModelOutput) Checksum() pulumi.StringOutput {
+	return o.ApplyT(func(v BackupModel) string { return v.Checksum }).(pulumi.StringOutput)
+}
+
+// Location of the uploaded backup file. Could be used as 'restore_from' value.
+func (o BackupModelOutput) Location() pulumi.StringOutput {
+	return o.ApplyT(func(v BackupModel) string { return v.Location }).(pulumi.StringOutput)
+}
+
+// Name of the backup file.
+func (o BackupModelOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v BackupModel) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Size of the backup file in bytes.
+func (o BackupModelOutput) Size() pulumi.IntOutput {
+	return o.ApplyT(func(v BackupModel) int { return v.Size }).(pulumi.IntOutput)
+}
+
+// Time at which the backup was made (RFC 3339, UTC).
+func (o BackupModelOutput) Timestamp() pulumi.StringOutput {
+	return o.ApplyT(func(v BackupModel) string { return v.Timestamp }).(pulumi.StringOutput)
+}
+
+type BackupModelArrayOutput struct{ *pulumi.OutputState }
+
+func (BackupModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BackupModel)(nil)).Elem()
+}
+
+func (o BackupModelArrayOutput) ToBackupModelArrayOutput() BackupModelArrayOutput {
+	return o
+}
+
+func (o BackupModelArrayOutput) ToBackupModelArrayOutputWithContext(ctx context.Context) BackupModelArrayOutput {
+	return o
+}
+
+func (o BackupModelArrayOutput) Index(i pulumi.IntInput) BackupModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BackupModel {
+		return vs[0].([]BackupModel)[vs[1].(int)]
+	}).(BackupModelOutput)
+}
+
+type BundleInstanceModel struct {
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').
+	State string `pulumi:"state"`
+	// Version of the bundle installed on the AEM instance.
+	Version string `pulumi:"version"`
+}
+
+type BundleInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (BundleInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BundleInstanceModel)(nil)).Elem()
+}
+
+func (o BundleInstanceModelOutput) ToBundleInstanceModelOutput() BundleInstanceModelOutput {
+	return o
+}
+
+func (o BundleInstanceModelOutput) ToBundleInstanceModelOutputWithContext(ctx context.Context) BundleInstanceModelOutput {
+	return o
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o BundleInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v BundleInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').
+func (o BundleInstanceModelOutput) State() pulumi.StringOutput {
+	return o.ApplyT(func(v BundleInstanceModel) string { return v.State }).(pulumi.StringOutput)
+}
+
+// Version of the bundle installed on the AEM instance.
+func (o BundleInstanceModelOutput) Version() pulumi.StringOutput {
+	return o.ApplyT(func(v BundleInstanceModel) string { return v.Version }).(pulumi.StringOutput)
+}
+
+type BundleInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (BundleInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BundleInstanceModel)(nil)).Elem()
+}
+
+func (o BundleInstanceModelArrayOutput) ToBundleInstanceModelArrayOutput() BundleInstanceModelArrayOutput {
+	return o
+}
+
+func (o BundleInstanceModelArrayOutput) ToBundleInstanceModelArrayOutputWithContext(ctx context.Context) BundleInstanceModelArrayOutput {
+	return o
+}
+
+func (o BundleInstanceModelArrayOutput) Index(i pulumi.IntInput) BundleInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BundleInstanceModel {
+		return vs[0].([]BundleInstanceModel)[vs[1].(int)]
+	}).(BundleInstanceModelOutput)
+}
+
 type Client struct {
 	// Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
 	Action_timeout *string `pulumi:"action_timeout"`
 	// Credentials for the connection type
 	Credentials map[string]string `pulumi:"credentials"`
+	// Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+	Port_forward *bool `pulumi:"port_forward"`
 	// Settings for the connection type
 	Settings map[string]string `pulumi:"settings"`
 	// Used when reading the AEM instance state when determining the plan.
@@ -42,6 +517,8 @@ type ClientArgs struct {
 	Action_timeout pulumi.StringPtrInput `pulumi:"action_timeout"`
 	// Credentials for the connection type
 	Credentials pulumi.StringMapInput `pulumi:"credentials"`
+	// Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+	Port_forward pulumi.BoolPtrInput `pulumi:"port_forward"`
 	// Settings for the connection type
 	Settings pulumi.StringMapInput `pulumi:"settings"`
 	// Used when reading the AEM instance state when determining the plan.
@@ -86,6 +563,11 @@ func (o ClientOutput) Credentials() pulumi.StringMapOutput {
 	return o.ApplyT(func(v Client) map[string]string { return v.Credentials }).(pulumi.StringMapOutput)
 }
 
+// Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+func (o ClientOutput) Port_forward() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Client) *bool { return v.Port_forward }).(pulumi.BoolPtrOutput)
+}
+
 // Settings for the connection type
 func (o ClientOutput) Settings() pulumi.StringMapOutput {
 	return o.ApplyT(func(v Client) map[string]string { return v.Settings }).(pulumi.StringMapOutput)
@@ -102,9 +584,17 @@ func (o ClientOutput) Type() pulumi.StringOutput {
 }
 
 type Compose struct {
+	// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+	Admin_password *string `pulumi:"admin_password"`
+	// Settings for backing up AEM instance files.
+	Backup *Backup `pulumi:"backup"`
 	// Contents of the AEM Compose YML configuration file.
 	Config *string `pulumi:"config"`
-	// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+	// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+	Config_lists_strategy *string `pulumi:"config_lists_strategy"`
+	// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+	Config_overrides interface{} `pulumi:"config_overrides"`
+	// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
 	Configure *InstanceScript `pulumi:"configure"`
 	// Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
 	Create *InstanceScript `pulumi:"create"`
@@ -112,6 +602,12 @@ type Compose struct {
 	Delete *InstanceScript `pulumi:"delete"`
 	// Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
 	Download *bool `pulumi:"download"`
+	// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+	Instances []ComposeInstance `pulumi:"instances"`
+	// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+	Readiness *Readiness `pulumi:"readiness"`
+	// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+	Restore_from *string `pulumi:"restore_from"`
 	// Version of AEM Compose tool to use on remote machine.
 	Version *string `pulumi:"version"`
 }
@@ -128,9 +624,17 @@ type ComposeInput interface {
 }
 
 type ComposeArgs struct {
+	// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+	Admin_password pulumi.StringPtrInput `pulumi:"admin_password"`
+	// Settings for backing up AEM instance files.
+	Backup BackupPtrInput `pulumi:"backup"`
 	// Contents of the AEM Compose YML configuration file.
 	Config pulumi.StringPtrInput `pulumi:"config"`
-	// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+	// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+	Config_lists_strategy pulumi.StringPtrInput `pulumi:"config_lists_strategy"`
+	// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+	Config_overrides pulumi.Input `pulumi:"config_overrides"`
+	// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
 	Configure InstanceScriptPtrInput `pulumi:"configure"`
 	// Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
 	Create InstanceScriptPtrInput `pulumi:"create"`
@@ -138,6 +642,12 @@ type ComposeArgs struct {
 	Delete InstanceScriptPtrInput `pulumi:"delete"`
 	// Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
 	Download pulumi.BoolPtrInput `pulumi:"download"`
+	// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+	Instances ComposeInstanceArrayInput `pulumi:"instances"`
+	// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+	Readiness ReadinessPtrInput `pulumi:"readiness"`
+	// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+	Restore_from pulumi.StringPtrInput `pulumi:"restore_from"`
 	// Version of AEM Compose tool to use on remote machine.
 	Version pulumi.StringPtrInput `pulumi:"version"`
 }
@@ -219,12 +729,32 @@ func (o ComposeOutput) ToComposePtrOutputWithContext(ctx context.Context) Compos
 	}).(ComposePtrOutput)
 }
 
+// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+func (o ComposeOutput) Admin_password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Compose) *string { return v.Admin_password }).(pulumi.StringPtrOutput)
+}
+
+// Settings for backing up AEM instance files.
+func (o ComposeOutput) Backup() BackupPtrOutput {
+	return o.ApplyT(func(v Compose) *Backup { return v.Backup }).(BackupPtrOutput)
+}
+
 // Contents of the AEM Compose YML configuration file.
 func (o ComposeOutput) Config() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v Compose) *string { return v.Config }).(pulumi.StringPtrOutput)
 }
 
-// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+func (o ComposeOutput) Config_lists_strategy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Compose) *string { return v.Config_lists_strategy }).(pulumi.StringPtrOutput)
+}
+
+// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+func (o ComposeOutput) Config_overrides() pulumi.AnyOutput {
+	return o.ApplyT(func(v Compose) interface{} { return v.Config_overrides }).(pulumi.AnyOutput)
+}
+
+// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
 func (o ComposeOutput) Configure() InstanceScriptPtrOutput {
 	return o.ApplyT(func(v Compose) *InstanceScript { return v.Configure }).(InstanceScriptPtrOutput)
 }
@@ -244,6 +774,21 @@ func (o ComposeOutput) Download() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v Compose) *bool { return v.Download }).(pulumi.BoolPtrOutput)
 }
 
+// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+func (o ComposeOutput) Instances() ComposeInstanceArrayOutput {
+	return o.ApplyT(func(v Compose) []ComposeInstance { return v.Instances }).(ComposeInstanceArrayOutput)
+}
+
+// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+func (o ComposeOutput) Readiness() ReadinessPtrOutput {
+	return o.ApplyT(func(v Compose) *Readiness { return v.Readiness }).(ReadinessPtrOutput)
+}
+
+// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+func (o ComposeOutput) Restore_from() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Compose) *string { return v.Restore_from }).(pulumi.StringPtrOutput)
+}
+
 // Version of AEM Compose tool to use on remote machine.
 func (o ComposeOutput) Version() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v Compose) *string { return v.Version }).(pulumi.StringPtrOutput)
@@ -273,28 +818,68 @@ func (o ComposePtrOutput) Elem() ComposeOutput {
 	}).(ComposeOutput)
 }
 
-// Contents of the AEM Compose YML configuration file.
-func (o ComposePtrOutput) Config() pulumi.StringPtrOutput {
+// Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+func (o ComposePtrOutput) Admin_password() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Compose) *string {
 		if v == nil {
 			return nil
 		}
-		return v.Config
+		return v.Admin_password
 	}).(pulumi.StringPtrOutput)
 }
 
-// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
-func (o ComposePtrOutput) Configure() InstanceScriptPtrOutput {
-	return o.ApplyT(func(v *Compose) *InstanceScript {
+// Settings for backing up AEM instance files.
+func (o ComposePtrOutput) Backup() BackupPtrOutput {
+	return o.ApplyT(func(v *Compose) *Backup {
 		if v == nil {
 			return nil
 		}
-		return v.Configure
-	}).(InstanceScriptPtrOutput)
+		return v.Backup
+	}).(BackupPtrOutput)
 }
 
-// Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
-func (o ComposePtrOutput) Create() InstanceScriptPtrOutput {
+// Contents of the AEM Compose YML configuration file.
+func (o ComposePtrOutput) Config() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Compose) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Config
+	}).(pulumi.StringPtrOutput)
+}
+
+// Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+func (o ComposePtrOutput) Config_lists_strategy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Compose) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Config_lists_strategy
+	}).(pulumi.StringPtrOutput)
+}
+
+// Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+func (o ComposePtrOutput) Config_overrides() pulumi.AnyOutput {
+	return o.ApplyT(func(v *Compose) interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Config_overrides
+	}).(pulumi.AnyOutput)
+}
+
+// Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+func (o ComposePtrOutput) Configure() InstanceScriptPtrOutput {
+	return o.ApplyT(func(v *Compose) *InstanceScript {
+		if v == nil {
+			return nil
+		}
+		return v.Configure
+	}).(InstanceScriptPtrOutput)
+}
+
+// Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+func (o ComposePtrOutput) Create() InstanceScriptPtrOutput {
 	return o.ApplyT(func(v *Compose) *InstanceScript {
 		if v == nil {
 			return nil
@@ -323,6 +908,36 @@ func (o ComposePtrOutput) Download() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
+// Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+func (o ComposePtrOutput) Instances() ComposeInstanceArrayOutput {
+	return o.ApplyT(func(v *Compose) []ComposeInstance {
+		if v == nil {
+			return nil
+		}
+		return v.Instances
+	}).(ComposeInstanceArrayOutput)
+}
+
+// Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+func (o ComposePtrOutput) Readiness() ReadinessPtrOutput {
+	return o.ApplyT(func(v *Compose) *Readiness {
+		if v == nil {
+			return nil
+		}
+		return v.Readiness
+	}).(ReadinessPtrOutput)
+}
+
+// Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+func (o ComposePtrOutput) Restore_from() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Compose) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Restore_from
+	}).(pulumi.StringPtrOutput)
+}
+
 // Version of AEM Compose tool to use on remote machine.
 func (o ComposePtrOutput) Version() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Compose) *string {
@@ -333,241 +948,2081 @@ func (o ComposePtrOutput) Version() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+type ComposeInstance struct {
+	// Environment variables set for the AEM instance process (in format 'NAME=value').
+	Env_vars []string `pulumi:"env_vars"`
+	// The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+	Http_url string `pulumi:"http_url"`
+	// Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+	Id string `pulumi:"id"`
+	// JVM options passed to the AEM instance process.
+	Jvm_opts []string `pulumi:"jvm_opts"`
+	// Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+	Password *string `pulumi:"password"`
+	// Run modes of the AEM instance.
+	Run_modes []string `pulumi:"run_modes"`
+	// Secret variables set for the AEM instance process (in format 'NAME=value').
+	Secret_vars []string `pulumi:"secret_vars"`
+	// Sling properties set for the AEM instance (in format 'name=value').
+	Sling_props []string `pulumi:"sling_props"`
+	// Options passed to the AEM instance start script.
+	Start_opts []string `pulumi:"start_opts"`
+	// User used to communicate with the AEM instance.
+	User *string `pulumi:"user"`
+}
+
+// ComposeInstanceInput is an input type that accepts ComposeInstanceArgs and ComposeInstanceOutput values.
+// You can construct a concrete instance of `ComposeInstanceInput` via:
+//
+//	ComposeInstanceArgs{...}
+type ComposeInstanceInput interface {
+	pulumi.Input
+
+	ToComposeInstanceOutput() ComposeInstanceOutput
+	ToComposeInstanceOutputWithContext(context.Context) ComposeInstanceOutput
+}
+
+type ComposeInstanceArgs struct {
+	// Environment variables set for the AEM instance process (in format 'NAME=value').
+	Env_vars pulumi.StringArrayInput `pulumi:"env_vars"`
+	// The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+	Http_url pulumi.StringInput `pulumi:"http_url"`
+	// Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+	Id pulumi.StringInput `pulumi:"id"`
+	// JVM options passed to the AEM instance process.
+	Jvm_opts pulumi.StringArrayInput `pulumi:"jvm_opts"`
+	// Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+	Password pulumi.StringPtrInput `pulumi:"password"`
+	// Run modes of the AEM instance.
+	Run_modes pulumi.StringArrayInput `pulumi:"run_modes"`
+	// Secret variables set for the AEM instance process (in format 'NAME=value').
+	Secret_vars pulumi.StringArrayInput `pulumi:"secret_vars"`
+	// Sling properties set for the AEM instance (in format 'name=value').
+	Sling_props pulumi.StringArrayInput `pulumi:"sling_props"`
+	// Options passed to the AEM instance start script.
+	Start_opts pulumi.StringArrayInput `pulumi:"start_opts"`
+	// User used to communicate with the AEM instance.
+	User pulumi.StringPtrInput `pulumi:"user"`
+}
+
+func (ComposeInstanceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ComposeInstance)(nil)).Elem()
+}
+
+func (i ComposeInstanceArgs) ToComposeInstanceOutput() ComposeInstanceOutput {
+	return i.ToComposeInstanceOutputWithContext(context.Background())
+}
+
+func (i ComposeInstanceArgs) ToComposeInstanceOutputWithContext(ctx context.Context) ComposeInstanceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComposeInstanceOutput)
+}
+
+// ComposeInstanceArrayInput is an input type that accepts ComposeInstanceArray and ComposeInstanceArrayOutput values.
+// You can construct a concrete instance of `ComposeInstanceArrayInput` via:
+//
+//	ComposeInstanceArray{ ComposeInstanceArgs{...} }
+type ComposeInstanceArrayInput interface {
+	pulumi.Input
+
+	ToComposeInstanceArrayOutput() ComposeInstanceArrayOutput
+	ToComposeInstanceArrayOutputWithContext(context.Context) ComposeInstanceArrayOutput
+}
+
+type ComposeInstanceArray []ComposeInstanceInput
+
+func (ComposeInstanceArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ComposeInstance)(nil)).Elem()
+}
+
+func (i ComposeInstanceArray) ToComposeInstanceArrayOutput() ComposeInstanceArrayOutput {
+	return i.ToComposeInstanceArrayOutputWithContext(context.Background())
+}
+
+func (i ComposeInstanceArray) ToComposeInstanceArrayOutputWithContext(ctx context.Context) ComposeInstanceArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComposeInstanceArrayOutput)
+}
+
+type ComposeInstanceOutput struct{ *pulumi.OutputState }
+
+func (ComposeInstanceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ComposeInstance)(nil)).Elem()
+}
+
+func (o ComposeInstanceOutput) ToComposeInstanceOutput() ComposeInstanceOutput {
+	return o
+}
+
+func (o ComposeInstanceOutput) ToComposeInstanceOutputWithContext(ctx context.Context) ComposeInstanceOutput {
+	return o
+}
+
+// Environment variables set for the AEM instance process (in format 'NAME=value').
+func (o ComposeInstanceOutput) Env_vars() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ComposeInstance) []string { return v.Env_vars }).(pulumi.StringArrayOutput)
+}
+
+// The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+func (o ComposeInstanceOutput) Http_url() pulumi.StringOutput {
+	return o.ApplyT(func(v ComposeInstance) string { return v.Http_url }).(pulumi.StringOutput)
+}
+
+// Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+func (o ComposeInstanceOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ComposeInstance) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// JVM options passed to the AEM instance process.
+func (o ComposeInstanceOutput) Jvm_opts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ComposeInstance) []string { return v.Jvm_opts }).(pulumi.StringArrayOutput)
+}
+
+// Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+func (o ComposeInstanceOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ComposeInstance) *string { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+// Run modes of the AEM instance.
+func (o ComposeInstanceOutput) Run_modes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ComposeInstance) []string { return v.Run_modes }).(pulumi.StringArrayOutput)
+}
+
+// Secret variables set for the AEM instance process (in format 'NAME=value').
+func (o ComposeInstanceOutput) Secret_vars() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ComposeInstance) []string { return v.Secret_vars }).(pulumi.StringArrayOutput)
+}
+
+// Sling properties set for the AEM instance (in format 'name=value').
+func (o ComposeInstanceOutput) Sling_props() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ComposeInstance) []string { return v.Sling_props }).(pulumi.StringArrayOutput)
+}
+
+// Options passed to the AEM instance start script.
+func (o ComposeInstanceOutput) Start_opts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ComposeInstance) []string { return v.Start_opts }).(pulumi.StringArrayOutput)
+}
+
+// User used to communicate with the AEM instance.
+func (o ComposeInstanceOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ComposeInstance) *string { return v.User }).(pulumi.StringPtrOutput)
+}
+
+type ComposeInstanceArrayOutput struct{ *pulumi.OutputState }
+
+func (ComposeInstanceArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ComposeInstance)(nil)).Elem()
+}
+
+func (o ComposeInstanceArrayOutput) ToComposeInstanceArrayOutput() ComposeInstanceArrayOutput {
+	return o
+}
+
+func (o ComposeInstanceArrayOutput) ToComposeInstanceArrayOutputWithContext(ctx context.Context) ComposeInstanceArrayOutput {
+	return o
+}
+
+func (o ComposeInstanceArrayOutput) Index(i pulumi.IntInput) ComposeInstanceOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ComposeInstance {
+		return vs[0].([]ComposeInstance)[vs[1].(int)]
+	}).(ComposeInstanceOutput)
+}
+
+type Diagnostics struct {
+	// Number of the last lines of the AEM logs and system journal to be collected.
+	Log_lines *int `pulumi:"log_lines"`
+	// Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+	Output_dir *string `pulumi:"output_dir"`
+	// Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+	Summary_lines *int `pulumi:"summary_lines"`
+	// Toggle collecting thread dumps of the running AEM instances.
+	Thread_dump *bool `pulumi:"thread_dump"`
+}
+
+// DiagnosticsInput is an input type that accepts DiagnosticsArgs and DiagnosticsOutput values.
+// You can construct a concrete instance of `DiagnosticsInput` via:
+//
+//	DiagnosticsArgs{...}
+type DiagnosticsInput interface {
+	pulumi.Input
+
+	ToDiagnosticsOutput() DiagnosticsOutput
+	ToDiagnosticsOutputWithContext(context.Context) DiagnosticsOutput
+}
+
+type DiagnosticsArgs struct {
+	// Number of the last lines of the AEM logs and system journal to be collected.
+	Log_lines pulumi.IntPtrInput `pulumi:"log_lines"`
+	// Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+	Output_dir pulumi.StringPtrInput `pulumi:"output_dir"`
+	// Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+	Summary_lines pulumi.IntPtrInput `pulumi:"summary_lines"`
+	// Toggle collecting thread dumps of the running AEM instances.
+	Thread_dump pulumi.BoolPtrInput `pulumi:"thread_dump"`
+}
+
+func (DiagnosticsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Diagnostics)(nil)).Elem()
+}
+
+func (i DiagnosticsArgs) ToDiagnosticsOutput() DiagnosticsOutput {
+	return i.ToDiagnosticsOutputWithContext(context.Background())
+}
+
+func (i DiagnosticsArgs) ToDiagnosticsOutputWithContext(ctx context.Context) DiagnosticsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DiagnosticsOutput)
+}
+
+func (i DiagnosticsArgs) ToDiagnosticsPtrOutput() DiagnosticsPtrOutput {
+	return i.ToDiagnosticsPtrOutputWithContext(context.Background())
+}
+
+func (i DiagnosticsArgs) ToDiagnosticsPtrOutputWithContext(ctx context.Context) DiagnosticsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DiagnosticsOutput).ToDiagnosticsPtrOutputWithContext(ctx)
+}
+
+// DiagnosticsPtrInput is an input type that accepts DiagnosticsArgs, DiagnosticsPtr and DiagnosticsPtrOutput values.
+// You can construct a concrete instance of `DiagnosticsPtrInput` via:
+//
+//	        DiagnosticsArgs{...}
+//
+//	or:
+//
+//	        nil
+type DiagnosticsPtrInput interface {
+	pulumi.Input
+
+	ToDiagnosticsPtrOutput() DiagnosticsPtrOutput
+	ToDiagnosticsPtrOutputWithContext(context.Context) DiagnosticsPtrOutput
+}
+
+type diagnosticsPtrType DiagnosticsArgs
+
+func DiagnosticsPtr(v *DiagnosticsArgs) DiagnosticsPtrInput {
+	return (*diagnosticsPtrType)(v)
+}
+
+func (*diagnosticsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Diagnostics)(nil)).Elem()
+}
+
+func (i *diagnosticsPtrType) ToDiagnosticsPtrOutput() DiagnosticsPtrOutput {
+	return i.ToDiagnosticsPtrOutputWithContext(context.Background())
+}
+
+func (i *diagnosticsPtrType) ToDiagnosticsPtrOutputWithContext(ctx context.Context) DiagnosticsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DiagnosticsPtrOutput)
+}
+
+type DiagnosticsOutput struct{ *pulumi.OutputState }
+
+func (DiagnosticsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Diagnostics)(nil)).Elem()
+}
+
+func (o DiagnosticsOutput) ToDiagnosticsOutput() DiagnosticsOutput {
+	return o
+}
+
+func (o DiagnosticsOutput) ToDiagnosticsOutputWithContext(ctx context.Context) DiagnosticsOutput {
+	return o
+}
+
+func (o DiagnosticsOutput) ToDiagnosticsPtrOutput() DiagnosticsPtrOutput {
+	return o.ToDiagnosticsPtrOutputWithContext(context.Background())
+}
+
+func (o DiagnosticsOutput) ToDiagnosticsPtrOutputWithContext(ctx context.Context) DiagnosticsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Diagnostics) *Diagnostics {
+		return &v
+	}).(DiagnosticsPtrOutput)
+}
+
+// Number of the last lines of the AEM logs and system journal to be collected.
+func (o DiagnosticsOutput) Log_lines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Diagnostics) *int { return v.Log_lines }).(pulumi.IntPtrOutput)
+}
+
+// Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+func (o DiagnosticsOutput) Output_dir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Diagnostics) *string { return v.Output_dir }).(pulumi.StringPtrOutput)
+}
+
+// Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+func (o DiagnosticsOutput) Summary_lines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Diagnostics) *int { return v.Summary_lines }).(pulumi.IntPtrOutput)
+}
+
+// Toggle collecting thread dumps of the running AEM instances.
+func (o DiagnosticsOutput) Thread_dump() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Diagnostics) *bool { return v.Thread_dump }).(pulumi.BoolPtrOutput)
+}
+
+type DiagnosticsPtrOutput struct{ *pulumi.OutputState }
+
+func (DiagnosticsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Diagnostics)(nil)).Elem()
+}
+
+func (o DiagnosticsPtrOutput) ToDiagnosticsPtrOutput() DiagnosticsPtrOutput {
+	return o
+}
+
+func (o DiagnosticsPtrOutput) ToDiagnosticsPtrOutputWithContext(ctx context.Context) DiagnosticsPtrOutput {
+	return o
+}
+
+func (o DiagnosticsPtrOutput) Elem() DiagnosticsOutput {
+	return o.ApplyT(func(v *Diagnostics) Diagnostics {
+		if v != nil {
+			return *v
+		}
+		var ret Diagnostics
+		return ret
+	}).(DiagnosticsOutput)
+}
+
+// Number of the last lines of the AEM logs and system journal to be collected.
+func (o DiagnosticsPtrOutput) Log_lines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Diagnostics) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Log_lines
+	}).(pulumi.IntPtrOutput)
+}
+
+// Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+func (o DiagnosticsPtrOutput) Output_dir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Diagnostics) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Output_dir
+	}).(pulumi.StringPtrOutput)
+}
+
+// Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+func (o DiagnosticsPtrOutput) Summary_lines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Diagnostics) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Summary_lines
+	}).(pulumi.IntPtrOutput)
+}
+
+// Toggle collecting thread dumps of the running AEM instances.
+func (o DiagnosticsPtrOutput) Thread_dump() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Diagnostics) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Thread_dump
+	}).(pulumi.BoolPtrOutput)
+}
+
+type GroupInstanceModel struct {
+	// Indicates if the group exists on the AEM instance.
+	Exists bool `pulumi:"exists"`
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Identifiers of the direct members of the group on the AEM instance.
+	Members []string `pulumi:"members"`
+	// Repository path of the group on the AEM instance.
+	Path string `pulumi:"path"`
+}
+
+type GroupInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (GroupInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupInstanceModel)(nil)).Elem()
+}
+
+func (o GroupInstanceModelOutput) ToGroupInstanceModelOutput() GroupInstanceModelOutput {
+	return o
+}
+
+func (o GroupInstanceModelOutput) ToGroupInstanceModelOutputWithContext(ctx context.Context) GroupInstanceModelOutput {
+	return o
+}
+
+// Indicates if the group exists on the AEM instance.
+func (o GroupInstanceModelOutput) Exists() pulumi.BoolOutput {
+	return o.ApplyT(func(v GroupInstanceModel) bool { return v.Exists }).(pulumi.BoolOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o GroupInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v GroupInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Identifiers of the direct members of the group on the AEM instance.
+func (o GroupInstanceModelOutput) Members() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v GroupInstanceModel) []string { return v.Members }).(pulumi.StringArrayOutput)
+}
+
+// Repository path of the group on the AEM instance.
+func (o GroupInstanceModelOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v GroupInstanceModel) string { return v.Path }).(pulumi.StringOutput)
+}
+
+type GroupInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (GroupInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GroupInstanceModel)(nil)).Elem()
+}
+
+func (o GroupInstanceModelArrayOutput) ToGroupInstanceModelArrayOutput() GroupInstanceModelArrayOutput {
+	return o
+}
+
+func (o GroupInstanceModelArrayOutput) ToGroupInstanceModelArrayOutputWithContext(ctx context.Context) GroupInstanceModelArrayOutput {
+	return o
+}
+
+func (o GroupInstanceModelArrayOutput) Index(i pulumi.IntInput) GroupInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) GroupInstanceModel {
+		return vs[0].([]GroupInstanceModel)[vs[1].(int)]
+	}).(GroupInstanceModelOutput)
+}
+
 type InstanceModel struct {
 	// Version of the AEM instance. Reflects service pack installations.
 	Aem_version string `pulumi:"aem_version"`
 	// A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
 	Attributes []string `pulumi:"attributes"`
+	// Indicates if the AEM instance files are created on the machine.
+	Created bool `pulumi:"created"`
+	// Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.
+	Debug_port int `pulumi:"debug_port"`
 	// Remote path in which AEM instance is stored.
 	Dir string `pulumi:"dir"`
+	// A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.
+	Health_checks []string `pulumi:"health_checks"`
+	// Port on which the AEM instance accepts HTTP requests.
+	Http_port int `pulumi:"http_port"`
 	// Unique identifier of AEM instance defined in the configuration.
 	Id string `pulumi:"id"`
+	// Indicates if the AEM instance is running and responds to HTTP requests.
+	Reachable bool `pulumi:"reachable"`
 	// A list of run modes for a specific AEM instance.
 	Run_modes []string `pulumi:"run_modes"`
+	// Indicates if the AEM instance process is running.
+	Running bool `pulumi:"running"`
+	// Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).
+	Up_to_date bool `pulumi:"up_to_date"`
 	// The machine-internal HTTP URL address used for communication with the AEM instance.
 	Url string `pulumi:"url"`
 }
 
-type InstanceModelOutput struct{ *pulumi.OutputState }
+type InstanceModelOutput struct{ *pulumi.OutputState }
+
+func (InstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceModel)(nil)).Elem()
+}
+
+func (o InstanceModelOutput) ToInstanceModelOutput() InstanceModelOutput {
+	return o
+}
+
+func (o InstanceModelOutput) ToInstanceModelOutputWithContext(ctx context.Context) InstanceModelOutput {
+	return o
+}
+
+// Version of the AEM instance. Reflects service pack installations.
+func (o InstanceModelOutput) Aem_version() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceModel) string { return v.Aem_version }).(pulumi.StringOutput)
+}
+
+// A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
+func (o InstanceModelOutput) Attributes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceModel) []string { return v.Attributes }).(pulumi.StringArrayOutput)
+}
+
+// Indicates if the AEM instance files are created on the machine.
+func (o InstanceModelOutput) Created() pulumi.BoolOutput {
+	return o.ApplyT(func(v InstanceModel) bool { return v.Created }).(pulumi.BoolOutput)
+}
+
+// Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.
+func (o InstanceModelOutput) Debug_port() pulumi.IntOutput {
+	return o.ApplyT(func(v InstanceModel) int { return v.Debug_port }).(pulumi.IntOutput)
+}
+
+// Remote path in which AEM instance is stored.
+func (o InstanceModelOutput) Dir() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceModel) string { return v.Dir }).(pulumi.StringOutput)
+}
+
+// A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.
+func (o InstanceModelOutput) Health_checks() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceModel) []string { return v.Health_checks }).(pulumi.StringArrayOutput)
+}
+
+// Port on which the AEM instance accepts HTTP requests.
+func (o InstanceModelOutput) Http_port() pulumi.IntOutput {
+	return o.ApplyT(func(v InstanceModel) int { return v.Http_port }).(pulumi.IntOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o InstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Indicates if the AEM instance is running and responds to HTTP requests.
+func (o InstanceModelOutput) Reachable() pulumi.BoolOutput {
+	return o.ApplyT(func(v InstanceModel) bool { return v.Reachable }).(pulumi.BoolOutput)
+}
+
+// A list of run modes for a specific AEM instance.
+func (o InstanceModelOutput) Run_modes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceModel) []string { return v.Run_modes }).(pulumi.StringArrayOutput)
+}
+
+// Indicates if the AEM instance process is running.
+func (o InstanceModelOutput) Running() pulumi.BoolOutput {
+	return o.ApplyT(func(v InstanceModel) bool { return v.Running }).(pulumi.BoolOutput)
+}
+
+// Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).
+func (o InstanceModelOutput) Up_to_date() pulumi.BoolOutput {
+	return o.ApplyT(func(v InstanceModel) bool { return v.Up_to_date }).(pulumi.BoolOutput)
+}
+
+// The machine-internal HTTP URL address used for communication with the AEM instance.
+func (o InstanceModelOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceModel) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type InstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (InstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]InstanceModel)(nil)).Elem()
+}
+
+func (o InstanceModelArrayOutput) ToInstanceModelArrayOutput() InstanceModelArrayOutput {
+	return o
+}
+
+func (o InstanceModelArrayOutput) ToInstanceModelArrayOutputWithContext(ctx context.Context) InstanceModelArrayOutput {
+	return o
+}
+
+func (o InstanceModelArrayOutput) Index(i pulumi.IntInput) InstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) InstanceModel {
+		return vs[0].([]InstanceModel)[vs[1].(int)]
+	}).(InstanceModelOutput)
+}
+
+type InstanceScript struct {
+	// Inline shell commands to be executed
+	Inline []string `pulumi:"inline"`
+	// Multiline shell script to be executed
+	Script *string `pulumi:"script"`
+}
+
+// InstanceScriptInput is an input type that accepts InstanceScriptArgs and InstanceScriptOutput values.
+// You can construct a concrete instance of `InstanceScriptInput` via:
+//
+//	InstanceScriptArgs{...}
+type InstanceScriptInput interface {
+	pulumi.Input
+
+	ToInstanceScriptOutput() InstanceScriptOutput
+	ToInstanceScriptOutputWithContext(context.Context) InstanceScriptOutput
+}
+
+type InstanceScriptArgs struct {
+	// Inline shell commands to be executed
+	Inline pulumi.StringArrayInput `pulumi:"inline"`
+	// Multiline shell script to be executed
+	Script pulumi.StringPtrInput `pulumi:"script"`
+}
+
+func (InstanceScriptArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceScript)(nil)).Elem()
+}
+
+func (i InstanceScriptArgs) ToInstanceScriptOutput() InstanceScriptOutput {
+	return i.ToInstanceScriptOutputWithContext(context.Background())
+}
+
+func (i InstanceScriptArgs) ToInstanceScriptOutputWithContext(ctx context.Context) InstanceScriptOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceScriptOutput)
+}
+
+func (i InstanceScriptArgs) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
+	return i.ToInstanceScriptPtrOutputWithContext(context.Background())
+}
+
+func (i InstanceScriptArgs) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceScriptOutput).ToInstanceScriptPtrOutputWithContext(ctx)
+}
+
+// InstanceScriptPtrInput is an input type that accepts InstanceScriptArgs, InstanceScriptPtr and InstanceScriptPtrOutput values.
+// You can construct a concrete instance of `InstanceScriptPtrInput` via:
+//
+//	        InstanceScriptArgs{...}
+//
+//	or:
+//
+//	        nil
+type InstanceScriptPtrInput interface {
+	pulumi.Input
+
+	ToInstanceScriptPtrOutput() InstanceScriptPtrOutput
+	ToInstanceScriptPtrOutputWithContext(context.Context) InstanceScriptPtrOutput
+}
+
+type instanceScriptPtrType InstanceScriptArgs
+
+func InstanceScriptPtr(v *InstanceScriptArgs) InstanceScriptPtrInput {
+	return (*instanceScriptPtrType)(v)
+}
+
+func (*instanceScriptPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceScript)(nil)).Elem()
+}
+
+func (i *instanceScriptPtrType) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
+	return i.ToInstanceScriptPtrOutputWithContext(context.Background())
+}
+
+func (i *instanceScriptPtrType) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceScriptPtrOutput)
+}
+
+type InstanceScriptOutput struct{ *pulumi.OutputState }
+
+func (InstanceScriptOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceScript)(nil)).Elem()
+}
+
+func (o InstanceScriptOutput) ToInstanceScriptOutput() InstanceScriptOutput {
+	return o
+}
+
+func (o InstanceScriptOutput) ToInstanceScriptOutputWithContext(ctx context.Context) InstanceScriptOutput {
+	return o
+}
+
+func (o InstanceScriptOutput) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
+	return o.ToInstanceScriptPtrOutputWithContext(context.Background())
+}
+
+func (o InstanceScriptOutput) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v InstanceScript) *InstanceScript {
+		return &v
+	}).(InstanceScriptPtrOutput)
+}
+
+// Inline shell commands to be executed
+func (o InstanceScriptOutput) Inline() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceScript) []string { return v.Inline }).(pulumi.StringArrayOutput)
+}
+
+// Multiline shell script to be executed
+func (o InstanceScriptOutput) Script() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InstanceScript) *string { return v.Script }).(pulumi.StringPtrOutput)
+}
+
+type InstanceScriptPtrOutput struct{ *pulumi.OutputState }
+
+func (InstanceScriptPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceScript)(nil)).Elem()
+}
+
+func (o InstanceScriptPtrOutput) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
+	return o
+}
+
+func (o InstanceScriptPtrOutput) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
+	return o
+}
+
+func (o InstanceScriptPtrOutput) Elem() InstanceScriptOutput {
+	return o.ApplyT(func(v *InstanceScript) InstanceScript {
+		if v != nil {
+			return *v
+		}
+		var ret InstanceScript
+		return ret
+	}).(InstanceScriptOutput)
+}
+
+// Inline shell commands to be executed
+func (o InstanceScriptPtrOutput) Inline() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *InstanceScript) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Inline
+	}).(pulumi.StringArrayOutput)
+}
+
+// Multiline shell script to be executed
+func (o InstanceScriptPtrOutput) Script() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InstanceScript) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Script
+	}).(pulumi.StringPtrOutput)
+}
+
+type OakIndexInstanceModel struct {
+	// Indicates if the index definition exists on the AEM instance.
+	Exists bool `pulumi:"exists"`
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Number of times the index was rebuilt on the AEM instance.
+	Reindex_count int `pulumi:"reindex_count"`
+	// Indicates if the index is being rebuilt on the AEM instance.
+	Reindexing bool `pulumi:"reindexing"`
+}
+
+type OakIndexInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (OakIndexInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OakIndexInstanceModel)(nil)).Elem()
+}
+
+func (o OakIndexInstanceModelOutput) ToOakIndexInstanceModelOutput() OakIndexInstanceModelOutput {
+	return o
+}
+
+func (o OakIndexInstanceModelOutput) ToOakIndexInstanceModelOutputWithContext(ctx context.Context) OakIndexInstanceModelOutput {
+	return o
+}
+
+// Indicates if the index definition exists on the AEM instance.
+func (o OakIndexInstanceModelOutput) Exists() pulumi.BoolOutput {
+	return o.ApplyT(func(v OakIndexInstanceModel) bool { return v.Exists }).(pulumi.BoolOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o OakIndexInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v OakIndexInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Number of times the index was rebuilt on the AEM instance.
+func (o OakIndexInstanceModelOutput) Reindex_count() pulumi.IntOutput {
+	return o.ApplyT(func(v OakIndexInstanceModel) int { return v.Reindex_count }).(pulumi.IntOutput)
+}
+
+// Indicates if the index is being rebuilt on the AEM instance.
+func (o OakIndexInstanceModelOutput) Reindexing() pulumi.BoolOutput {
+	return o.ApplyT(func(v OakIndexInstanceModel) bool { return v.Reindexing }).(pulumi.BoolOutput)
+}
+
+type OakIndexInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (OakIndexInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]OakIndexInstanceModel)(nil)).Elem()
+}
+
+func (o OakIndexInstanceModelArrayOutput) ToOakIndexInstanceModelArrayOutput() OakIndexInstanceModelArrayOutput {
+	return o
+}
+
+func (o OakIndexInstanceModelArrayOutput) ToOakIndexInstanceModelArrayOutputWithContext(ctx context.Context) OakIndexInstanceModelArrayOutput {
+	return o
+}
+
+func (o OakIndexInstanceModelArrayOutput) Index(i pulumi.IntInput) OakIndexInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) OakIndexInstanceModel {
+		return vs[0].([]OakIndexInstanceModel)[vs[1].(int)]
+	}).(OakIndexInstanceModelOutput)
+}
+
+type OsgiConfigInstanceModel struct {
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Properties of the OSGi configuration read from the AEM instance.
+	Properties map[string]interface{} `pulumi:"properties"`
+}
+
+type OsgiConfigInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (OsgiConfigInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OsgiConfigInstanceModel)(nil)).Elem()
+}
+
+func (o OsgiConfigInstanceModelOutput) ToOsgiConfigInstanceModelOutput() OsgiConfigInstanceModelOutput {
+	return o
+}
+
+func (o OsgiConfigInstanceModelOutput) ToOsgiConfigInstanceModelOutputWithContext(ctx context.Context) OsgiConfigInstanceModelOutput {
+	return o
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o OsgiConfigInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v OsgiConfigInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Properties of the OSGi configuration read from the AEM instance.
+func (o OsgiConfigInstanceModelOutput) Properties() pulumi.MapOutput {
+	return o.ApplyT(func(v OsgiConfigInstanceModel) map[string]interface{} { return v.Properties }).(pulumi.MapOutput)
+}
+
+type OsgiConfigInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (OsgiConfigInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]OsgiConfigInstanceModel)(nil)).Elem()
+}
+
+func (o OsgiConfigInstanceModelArrayOutput) ToOsgiConfigInstanceModelArrayOutput() OsgiConfigInstanceModelArrayOutput {
+	return o
+}
+
+func (o OsgiConfigInstanceModelArrayOutput) ToOsgiConfigInstanceModelArrayOutputWithContext(ctx context.Context) OsgiConfigInstanceModelArrayOutput {
+	return o
+}
+
+func (o OsgiConfigInstanceModelArrayOutput) Index(i pulumi.IntInput) OsgiConfigInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) OsgiConfigInstanceModel {
+		return vs[0].([]OsgiConfigInstanceModel)[vs[1].(int)]
+	}).(OsgiConfigInstanceModelOutput)
+}
+
+type PackageInstanceModel struct {
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Indicates if the package is installed on the AEM instance.
+	Installed bool `pulumi:"installed"`
+	// Time at which the package was installed on the AEM instance.
+	Last_unpacked string `pulumi:"last_unpacked"`
+}
+
+type PackageInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (PackageInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PackageInstanceModel)(nil)).Elem()
+}
+
+func (o PackageInstanceModelOutput) ToPackageInstanceModelOutput() PackageInstanceModelOutput {
+	return o
+}
+
+func (o PackageInstanceModelOutput) ToPackageInstanceModelOutputWithContext(ctx context.Context) PackageInstanceModelOutput {
+	return o
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o PackageInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v PackageInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Indicates if the package is installed on the AEM instance.
+func (o PackageInstanceModelOutput) Installed() pulumi.BoolOutput {
+	return o.ApplyT(func(v PackageInstanceModel) bool { return v.Installed }).(pulumi.BoolOutput)
+}
+
+// Time at which the package was installed on the AEM instance.
+func (o PackageInstanceModelOutput) Last_unpacked() pulumi.StringOutput {
+	return o.ApplyT(func(v PackageInstanceModel) string { return v.Last_unpacked }).(pulumi.StringOutput)
+}
+
+type PackageInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (PackageInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PackageInstanceModel)(nil)).Elem()
+}
+
+func (o PackageInstanceModelArrayOutput) ToPackageInstanceModelArrayOutput() PackageInstanceModelArrayOutput {
+	return o
+}
+
+func (o PackageInstanceModelArrayOutput) ToPackageInstanceModelArrayOutputWithContext(ctx context.Context) PackageInstanceModelArrayOutput {
+	return o
+}
+
+func (o PackageInstanceModelArrayOutput) Index(i pulumi.IntInput) PackageInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PackageInstanceModel {
+		return vs[0].([]PackageInstanceModel)[vs[1].(int)]
+	}).(PackageInstanceModelOutput)
+}
+
+type Preflight struct {
+	// Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+	Java_version *string `pulumi:"java_version"`
+	// Minimum free disk space in gigabytes required in the data directory.
+	Min_disk_space *int `pulumi:"min_disk_space"`
+	// Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+	Ports *bool `pulumi:"ports"`
+}
+
+// PreflightInput is an input type that accepts PreflightArgs and PreflightOutput values.
+// You can construct a concrete instance of `PreflightInput` via:
+//
+//	PreflightArgs{...}
+type PreflightInput interface {
+	pulumi.Input
+
+	ToPreflightOutput() PreflightOutput
+	ToPreflightOutputWithContext(context.Context) PreflightOutput
+}
+
+type PreflightArgs struct {
+	// Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+	Java_version pulumi.StringPtrInput `pulumi:"java_version"`
+	// Minimum free disk space in gigabytes required in the data directory.
+	Min_disk_space pulumi.IntPtrInput `pulumi:"min_disk_space"`
+	// Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+	Ports pulumi.BoolPtrInput `pulumi:"ports"`
+}
+
+func (PreflightArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Preflight)(nil)).Elem()
+}
+
+func (i PreflightArgs) ToPreflightOutput() PreflightOutput {
+	return i.ToPreflightOutputWithContext(context.Background())
+}
+
+func (i PreflightArgs) ToPreflightOutputWithContext(ctx context.Context) PreflightOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreflightOutput)
+}
+
+func (i PreflightArgs) ToPreflightPtrOutput() PreflightPtrOutput {
+	return i.ToPreflightPtrOutputWithContext(context.Background())
+}
+
+func (i PreflightArgs) ToPreflightPtrOutputWithContext(ctx context.Context) PreflightPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreflightOutput).ToPreflightPtrOutputWithContext(ctx)
+}
+
+// PreflightPtrInput is an input type that accepts PreflightArgs, PreflightPtr and PreflightPtrOutput values.
+// You can construct a concrete instance of `PreflightPtrInput` via:
+//
+//	        PreflightArgs{...}
+//
+//	or:
+//
+//	        nil
+type PreflightPtrInput interface {
+	pulumi.Input
+
+	ToPreflightPtrOutput() PreflightPtrOutput
+	ToPreflightPtrOutputWithContext(context.Context) PreflightPtrOutput
+}
+
+type preflightPtrType PreflightArgs
+
+func PreflightPtr(v *PreflightArgs) PreflightPtrInput {
+	return (*preflightPtrType)(v)
+}
+
+func (*preflightPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Preflight)(nil)).Elem()
+}
+
+func (i *preflightPtrType) ToPreflightPtrOutput() PreflightPtrOutput {
+	return i.ToPreflightPtrOutputWithContext(context.Background())
+}
+
+func (i *preflightPtrType) ToPreflightPtrOutputWithContext(ctx context.Context) PreflightPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreflightPtrOutput)
+}
+
+type PreflightOutput struct{ *pulumi.OutputState }
+
+func (PreflightOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Preflight)(nil)).Elem()
+}
+
+func (o PreflightOutput) ToPreflightOutput() PreflightOutput {
+	return o
+}
+
+func (o PreflightOutput) ToPreflightOutputWithContext(ctx context.Context) PreflightOutput {
+	return o
+}
+
+func (o PreflightOutput) ToPreflightPtrOutput() PreflightPtrOutput {
+	return o.ToPreflightPtrOutputWithContext(context.Background())
+}
+
+func (o PreflightOutput) ToPreflightPtrOutputWithContext(ctx context.Context) PreflightPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Preflight) *Preflight {
+		return &v
+	}).(PreflightPtrOutput)
+}
+
+// Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+func (o PreflightOutput) Java_version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Preflight) *string { return v.Java_version }).(pulumi.StringPtrOutput)
+}
+
+// Minimum free disk space in gigabytes required in the data directory.
+func (o PreflightOutput) Min_disk_space() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Preflight) *int { return v.Min_disk_space }).(pulumi.IntPtrOutput)
+}
+
+// Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+func (o PreflightOutput) Ports() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Preflight) *bool { return v.Ports }).(pulumi.BoolPtrOutput)
+}
+
+type PreflightPtrOutput struct{ *pulumi.OutputState }
+
+func (PreflightPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Preflight)(nil)).Elem()
+}
+
+func (o PreflightPtrOutput) ToPreflightPtrOutput() PreflightPtrOutput {
+	return o
+}
+
+func (o PreflightPtrOutput) ToPreflightPtrOutputWithContext(ctx context.Context) PreflightPtrOutput {
+	return o
+}
+
+func (o PreflightPtrOutput) Elem() PreflightOutput {
+	return o.ApplyT(func(v *Preflight) Preflight {
+		if v != nil {
+			return *v
+		}
+		var ret Preflight
+		return ret
+	}).(PreflightOutput)
+}
+
+// Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+func (o PreflightPtrOutput) Java_version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Preflight) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Java_version
+	}).(pulumi.StringPtrOutput)
+}
+
+// Minimum free disk space in gigabytes required in the data directory.
+func (o PreflightPtrOutput) Min_disk_space() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Preflight) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Min_disk_space
+	}).(pulumi.IntPtrOutput)
+}
+
+// Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+func (o PreflightPtrOutput) Ports() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Preflight) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Ports
+	}).(pulumi.BoolPtrOutput)
+}
+
+type Readiness struct {
+	// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+	Attributes []string `pulumi:"attributes"`
+	// Time between subsequent readiness checks.
+	Interval *string `pulumi:"interval"`
+	// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+	Policy *string `pulumi:"policy"`
+	// HTTP requests performed against each instance which need to respond with the expected status.
+	Probes []ReadinessProbe `pulumi:"probes"`
+	// Maximum time to wait for the instances to become ready.
+	Timeout *string `pulumi:"timeout"`
+}
+
+// ReadinessInput is an input type that accepts ReadinessArgs and ReadinessOutput values.
+// You can construct a concrete instance of `ReadinessInput` via:
+//
+//	ReadinessArgs{...}
+type ReadinessInput interface {
+	pulumi.Input
+
+	ToReadinessOutput() ReadinessOutput
+	ToReadinessOutputWithContext(context.Context) ReadinessOutput
+}
+
+type ReadinessArgs struct {
+	// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+	Attributes pulumi.StringArrayInput `pulumi:"attributes"`
+	// Time between subsequent readiness checks.
+	Interval pulumi.StringPtrInput `pulumi:"interval"`
+	// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+	Policy pulumi.StringPtrInput `pulumi:"policy"`
+	// HTTP requests performed against each instance which need to respond with the expected status.
+	Probes ReadinessProbeArrayInput `pulumi:"probes"`
+	// Maximum time to wait for the instances to become ready.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+}
+
+func (ReadinessArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Readiness)(nil)).Elem()
+}
+
+func (i ReadinessArgs) ToReadinessOutput() ReadinessOutput {
+	return i.ToReadinessOutputWithContext(context.Background())
+}
+
+func (i ReadinessArgs) ToReadinessOutputWithContext(ctx context.Context) ReadinessOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReadinessOutput)
+}
+
+func (i ReadinessArgs) ToReadinessPtrOutput() ReadinessPtrOutput {
+	return i.ToReadinessPtrOutputWithContext(context.Background())
+}
+
+func (i ReadinessArgs) ToReadinessPtrOutputWithContext(ctx context.Context) ReadinessPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReadinessOutput).ToReadinessPtrOutputWithContext(ctx)
+}
+
+// ReadinessPtrInput is an input type that accepts ReadinessArgs, ReadinessPtr and ReadinessPtrOutput values.
+// You can construct a concrete instance of `ReadinessPtrInput` via:
+//
+//	        ReadinessArgs{...}
+//
+//	or:
+//
+//	        nil
+type ReadinessPtrInput interface {
+	pulumi.Input
+
+	ToReadinessPtrOutput() ReadinessPtrOutput
+	ToReadinessPtrOutputWithContext(context.Context) ReadinessPtrOutput
+}
+
+type readinessPtrType ReadinessArgs
+
+func ReadinessPtr(v *ReadinessArgs) ReadinessPtrInput {
+	return (*readinessPtrType)(v)
+}
+
+func (*readinessPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Readiness)(nil)).Elem()
+}
+
+func (i *readinessPtrType) ToReadinessPtrOutput() ReadinessPtrOutput {
+	return i.ToReadinessPtrOutputWithContext(context.Background())
+}
+
+func (i *readinessPtrType) ToReadinessPtrOutputWithContext(ctx context.Context) ReadinessPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReadinessPtrOutput)
+}
+
+type ReadinessOutput struct{ *pulumi.OutputState }
+
+func (ReadinessOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Readiness)(nil)).Elem()
+}
+
+func (o ReadinessOutput) ToReadinessOutput() ReadinessOutput {
+	return o
+}
+
+func (o ReadinessOutput) ToReadinessOutputWithContext(ctx context.Context) ReadinessOutput {
+	return o
+}
+
+func (o ReadinessOutput) ToReadinessPtrOutput() ReadinessPtrOutput {
+	return o.ToReadinessPtrOutputWithContext(context.Background())
+}
+
+func (o ReadinessOutput) ToReadinessPtrOutputWithContext(ctx context.Context) ReadinessPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Readiness) *Readiness {
+		return &v
+	}).(ReadinessPtrOutput)
+}
+
+// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+func (o ReadinessOutput) Attributes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Readiness) []string { return v.Attributes }).(pulumi.StringArrayOutput)
+}
+
+// Time between subsequent readiness checks.
+func (o ReadinessOutput) Interval() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Readiness) *string { return v.Interval }).(pulumi.StringPtrOutput)
+}
+
+// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+func (o ReadinessOutput) Policy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Readiness) *string { return v.Policy }).(pulumi.StringPtrOutput)
+}
+
+// HTTP requests performed against each instance which need to respond with the expected status.
+func (o ReadinessOutput) Probes() ReadinessProbeArrayOutput {
+	return o.ApplyT(func(v Readiness) []ReadinessProbe { return v.Probes }).(ReadinessProbeArrayOutput)
+}
+
+// Maximum time to wait for the instances to become ready.
+func (o ReadinessOutput) Timeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Readiness) *string { return v.Timeout }).(pulumi.StringPtrOutput)
+}
+
+type ReadinessPtrOutput struct{ *pulumi.OutputState }
+
+func (ReadinessPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Readiness)(nil)).Elem()
+}
+
+func (o ReadinessPtrOutput) ToReadinessPtrOutput() ReadinessPtrOutput {
+	return o
+}
+
+func (o ReadinessPtrOutput) ToReadinessPtrOutputWithContext(ctx context.Context) ReadinessPtrOutput {
+	return o
+}
+
+func (o ReadinessPtrOutput) Elem() ReadinessOutput {
+	return o.ApplyT(func(v *Readiness) Readiness {
+		if v != nil {
+			return *v
+		}
+		var ret Readiness
+		return ret
+	}).(ReadinessOutput)
+}
+
+// Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+func (o ReadinessPtrOutput) Attributes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Readiness) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Attributes
+	}).(pulumi.StringArrayOutput)
+}
+
+// Time between subsequent readiness checks.
+func (o ReadinessPtrOutput) Interval() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Readiness) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Interval
+	}).(pulumi.StringPtrOutput)
+}
+
+// Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+func (o ReadinessPtrOutput) Policy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Readiness) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Policy
+	}).(pulumi.StringPtrOutput)
+}
+
+// HTTP requests performed against each instance which need to respond with the expected status.
+func (o ReadinessPtrOutput) Probes() ReadinessProbeArrayOutput {
+	return o.ApplyT(func(v *Readiness) []ReadinessProbe {
+		if v == nil {
+			return nil
+		}
+		return v.Probes
+	}).(ReadinessProbeArrayOutput)
+}
+
+// Maximum time to wait for the instances to become ready.
+func (o ReadinessPtrOutput) Timeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Readiness) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Timeout
+	}).(pulumi.StringPtrOutput)
+}
+
+type ReadinessProbe struct {
+	// Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+	Path string `pulumi:"path"`
+	// Expected HTTP status of the response.
+	Status *int `pulumi:"status"`
+}
+
+// ReadinessProbeInput is an input type that accepts ReadinessProbeArgs and ReadinessProbeOutput values.
+// You can construct a concrete instance of `ReadinessProbeInput` via:
+//
+//	ReadinessProbeArgs{...}
+type ReadinessProbeInput interface {
+	pulumi.Input
+
+	ToReadinessProbeOutput() ReadinessProbeOutput
+	ToReadinessProbeOutputWithContext(context.Context) ReadinessProbeOutput
+}
+
+type ReadinessProbeArgs struct {
+	// Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+	Path pulumi.StringInput `pulumi:"path"`
+	// Expected HTTP status of the response.
+	Status pulumi.IntPtrInput `pulumi:"status"`
+}
+
+func (ReadinessProbeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReadinessProbe)(nil)).Elem()
+}
+
+func (i ReadinessProbeArgs) ToReadinessProbeOutput() ReadinessProbeOutput {
+	return i.ToReadinessProbeOutputWithContext(context.Background())
+}
+
+func (i ReadinessProbeArgs) ToReadinessProbeOutputWithContext(ctx context.Context) ReadinessProbeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReadinessProbeOutput)
+}
+
+// ReadinessProbeArrayInput is an input type that accepts ReadinessProbeArray and ReadinessProbeArrayOutput values.
+// You can construct a concrete instance of `ReadinessProbeArrayInput` via:
+//
+//	ReadinessProbeArray{ ReadinessProbeArgs{...} }
+type ReadinessProbeArrayInput interface {
+	pulumi.Input
+
+	ToReadinessProbeArrayOutput() ReadinessProbeArrayOutput
+	ToReadinessProbeArrayOutputWithContext(context.Context) ReadinessProbeArrayOutput
+}
+
+type ReadinessProbeArray []ReadinessProbeInput
+
+func (ReadinessProbeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReadinessProbe)(nil)).Elem()
+}
+
+func (i ReadinessProbeArray) ToReadinessProbeArrayOutput() ReadinessProbeArrayOutput {
+	return i.ToReadinessProbeArrayOutputWithContext(context.Background())
+}
+
+func (i ReadinessProbeArray) ToReadinessProbeArrayOutputWithContext(ctx context.Context) ReadinessProbeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReadinessProbeArrayOutput)
+}
+
+type ReadinessProbeOutput struct{ *pulumi.OutputState }
+
+func (ReadinessProbeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReadinessProbe)(nil)).Elem()
+}
+
+func (o ReadinessProbeOutput) ToReadinessProbeOutput() ReadinessProbeOutput {
+	return o
+}
+
+func (o ReadinessProbeOutput) ToReadinessProbeOutputWithContext(ctx context.Context) ReadinessProbeOutput {
+	return o
+}
+
+// Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+func (o ReadinessProbeOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v ReadinessProbe) string { return v.Path }).(pulumi.StringOutput)
+}
+
+// Expected HTTP status of the response.
+func (o ReadinessProbeOutput) Status() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ReadinessProbe) *int { return v.Status }).(pulumi.IntPtrOutput)
+}
+
+type ReadinessProbeArrayOutput struct{ *pulumi.OutputState }
+
+func (ReadinessProbeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReadinessProbe)(nil)).Elem()
+}
+
+func (o ReadinessProbeArrayOutput) ToReadinessProbeArrayOutput() ReadinessProbeArrayOutput {
+	return o
+}
+
+func (o ReadinessProbeArrayOutput) ToReadinessProbeArrayOutputWithContext(ctx context.Context) ReadinessProbeArrayOutput {
+	return o
+}
+
+func (o ReadinessProbeArrayOutput) Index(i pulumi.IntInput) ReadinessProbeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ReadinessProbe {
+		return vs[0].([]ReadinessProbe)[vs[1].(int)]
+	}).(ReadinessProbeOutput)
+}
+
+type ReplicationAgentInstanceModel struct {
+	// Indicates if the replication agent is enabled on the AEM instance.
+	Enabled bool `pulumi:"enabled"`
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// URI of the replication target read from the AEM instance.
+	Transport_uri string `pulumi:"transport_uri"`
+}
+
+type ReplicationAgentInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (ReplicationAgentInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReplicationAgentInstanceModel)(nil)).Elem()
+}
+
+func (o ReplicationAgentInstanceModelOutput) ToReplicationAgentInstanceModelOutput() ReplicationAgentInstanceModelOutput {
+	return o
+}
+
+func (o ReplicationAgentInstanceModelOutput) ToReplicationAgentInstanceModelOutputWithContext(ctx context.Context) ReplicationAgentInstanceModelOutput {
+	return o
+}
+
+// Indicates if the replication agent is enabled on the AEM instance.
+func (o ReplicationAgentInstanceModelOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v ReplicationAgentInstanceModel) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o ReplicationAgentInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ReplicationAgentInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// URI of the replication target read from the AEM instance.
+func (o ReplicationAgentInstanceModelOutput) Transport_uri() pulumi.StringOutput {
+	return o.ApplyT(func(v ReplicationAgentInstanceModel) string { return v.Transport_uri }).(pulumi.StringOutput)
+}
+
+type ReplicationAgentInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (ReplicationAgentInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReplicationAgentInstanceModel)(nil)).Elem()
+}
+
+func (o ReplicationAgentInstanceModelArrayOutput) ToReplicationAgentInstanceModelArrayOutput() ReplicationAgentInstanceModelArrayOutput {
+	return o
+}
+
+func (o ReplicationAgentInstanceModelArrayOutput) ToReplicationAgentInstanceModelArrayOutputWithContext(ctx context.Context) ReplicationAgentInstanceModelArrayOutput {
+	return o
+}
+
+func (o ReplicationAgentInstanceModelArrayOutput) Index(i pulumi.IntInput) ReplicationAgentInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ReplicationAgentInstanceModel {
+		return vs[0].([]ReplicationAgentInstanceModel)[vs[1].(int)]
+	}).(ReplicationAgentInstanceModelOutput)
+}
+
+type RepoNodeInstanceModel struct {
+	// Indicates if the repository node exists on the AEM instance.
+	Exists bool `pulumi:"exists"`
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Properties of the repository node read from the AEM instance.
+	Properties map[string]interface{} `pulumi:"properties"`
+}
+
+type RepoNodeInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (RepoNodeInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RepoNodeInstanceModel)(nil)).Elem()
+}
+
+func (o RepoNodeInstanceModelOutput) ToRepoNodeInstanceModelOutput() RepoNodeInstanceModelOutput {
+	return o
+}
+
+func (o RepoNodeInstanceModelOutput) ToRepoNodeInstanceModelOutputWithContext(ctx context.Context) RepoNodeInstanceModelOutput {
+	return o
+}
+
+// Indicates if the repository node exists on the AEM instance.
+func (o RepoNodeInstanceModelOutput) Exists() pulumi.BoolOutput {
+	return o.ApplyT(func(v RepoNodeInstanceModel) bool { return v.Exists }).(pulumi.BoolOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o RepoNodeInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v RepoNodeInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Properties of the repository node read from the AEM instance.
+func (o RepoNodeInstanceModelOutput) Properties() pulumi.MapOutput {
+	return o.ApplyT(func(v RepoNodeInstanceModel) map[string]interface{} { return v.Properties }).(pulumi.MapOutput)
+}
+
+type RepoNodeInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (RepoNodeInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]RepoNodeInstanceModel)(nil)).Elem()
+}
+
+func (o RepoNodeInstanceModelArrayOutput) ToRepoNodeInstanceModelArrayOutput() RepoNodeInstanceModelArrayOutput {
+	return o
+}
+
+func (o RepoNodeInstanceModelArrayOutput) ToRepoNodeInstanceModelArrayOutputWithContext(ctx context.Context) RepoNodeInstanceModelArrayOutput {
+	return o
+}
+
+func (o RepoNodeInstanceModelArrayOutput) Index(i pulumi.IntInput) RepoNodeInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) RepoNodeInstanceModel {
+		return vs[0].([]RepoNodeInstanceModel)[vs[1].(int)]
+	}).(RepoNodeInstanceModelOutput)
+}
+
+type Schedule struct {
+	// Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+	Builtin *string `pulumi:"builtin"`
+	// Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+	Name string `pulumi:"name"`
+	// Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+	On_calendar string `pulumi:"on_calendar"`
+	// Script(s) to be executed. Mutually exclusive with 'builtin'.
+	Script *InstanceScript `pulumi:"script"`
+}
+
+// ScheduleInput is an input type that accepts ScheduleArgs and ScheduleOutput values.
+// You can construct a concrete instance of `ScheduleInput` via:
+//
+//	ScheduleArgs{...}
+type ScheduleInput interface {
+	pulumi.Input
+
+	ToScheduleOutput() ScheduleOutput
+	ToScheduleOutputWithContext(context.Context) ScheduleOutput
+}
+
+type ScheduleArgs struct {
+	// Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+	Builtin pulumi.StringPtrInput `pulumi:"builtin"`
+	// Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+	Name pulumi.StringInput `pulumi:"name"`
+	// Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+	On_calendar pulumi.StringInput `pulumi:"on_calendar"`
+	// Script(s) to be executed. Mutually exclusive with 'builtin'.
+	Script InstanceScriptPtrInput `pulumi:"script"`
+}
+
+func (ScheduleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Schedule)(nil)).Elem()
+}
+
+func (i ScheduleArgs) ToScheduleOutput() ScheduleOutput {
+	return i.ToScheduleOutputWithContext(context.Background())
+}
+
+func (i ScheduleArgs) ToScheduleOutputWithContext(ctx context.Context) ScheduleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScheduleOutput)
+}
+
+// ScheduleArrayInput is an input type that accepts ScheduleArray and ScheduleArrayOutput values.
+// You can construct a concrete instance of `ScheduleArrayInput` via:
+//
+//	ScheduleArray{ ScheduleArgs{...} }
+type ScheduleArrayInput interface {
+	pulumi.Input
+
+	ToScheduleArrayOutput() ScheduleArrayOutput
+	ToScheduleArrayOutputWithContext(context.Context) ScheduleArrayOutput
+}
+
+type ScheduleArray []ScheduleInput
+
+func (ScheduleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Schedule)(nil)).Elem()
+}
+
+func (i ScheduleArray) ToScheduleArrayOutput() ScheduleArrayOutput {
+	return i.ToScheduleArrayOutputWithContext(context.Background())
+}
+
+func (i ScheduleArray) ToScheduleArrayOutputWithContext(ctx context.Context) ScheduleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScheduleArrayOutput)
+}
+
+type ScheduleOutput struct{ *pulumi.OutputState }
+
+func (ScheduleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Schedule)(nil)).Elem()
+}
+
+func (o ScheduleOutput) ToScheduleOutput() ScheduleOutput {
+	return o
+}
+
+func (o ScheduleOutput) ToScheduleOutputWithContext(ctx context.Context) ScheduleOutput {
+	return o
+}
+
+// Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+func (o ScheduleOutput) Builtin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Schedule) *string { return v.Builtin }).(pulumi.StringPtrOutput)
+}
+
+// Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+func (o ScheduleOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v Schedule) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+func (o ScheduleOutput) On_calendar() pulumi.StringOutput {
+	return o.ApplyT(func(v Schedule) string { return v.On_calendar }).(pulumi.StringOutput)
+}
 
-func (InstanceModelOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*InstanceModel)(nil)).Elem()
+// Script(s) to be executed. Mutually exclusive with 'builtin'.
+func (o ScheduleOutput) Script() InstanceScriptPtrOutput {
+	return o.ApplyT(func(v Schedule) *InstanceScript { return v.Script }).(InstanceScriptPtrOutput)
 }
 
-func (o InstanceModelOutput) ToInstanceModelOutput() InstanceModelOutput {
+type ScheduleArrayOutput struct{ *pulumi.OutputState }
+
+func (ScheduleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Schedule)(nil)).Elem()
+}
+
+func (o ScheduleArrayOutput) ToScheduleArrayOutput() ScheduleArrayOutput {
 	return o
 }
 
-func (o InstanceModelOutput) ToInstanceModelOutputWithContext(ctx context.Context) InstanceModelOutput {
+func (o ScheduleArrayOutput) ToScheduleArrayOutputWithContext(ctx context.Context) ScheduleArrayOutput {
 	return o
 }
 
-// Version of the AEM instance. Reflects service pack installations.
-func (o InstanceModelOutput) Aem_version() pulumi.StringOutput {
-	return o.ApplyT(func(v InstanceModel) string { return v.Aem_version }).(pulumi.StringOutput)
+func (o ScheduleArrayOutput) Index(i pulumi.IntInput) ScheduleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Schedule {
+		return vs[0].([]Schedule)[vs[1].(int)]
+	}).(ScheduleOutput)
 }
 
-// A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
-func (o InstanceModelOutput) Attributes() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v InstanceModel) []string { return v.Attributes }).(pulumi.StringArrayOutput)
+type ScriptInstanceModel struct {
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Standard output of the script executed on the AEM instance.
+	Output string `pulumi:"output"`
+	// Result returned by the Groovy script executed on the AEM instance.
+	Result string `pulumi:"result"`
 }
 
-// Remote path in which AEM instance is stored.
-func (o InstanceModelOutput) Dir() pulumi.StringOutput {
-	return o.ApplyT(func(v InstanceModel) string { return v.Dir }).(pulumi.StringOutput)
+type ScriptInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (ScriptInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ScriptInstanceModel)(nil)).Elem()
+}
+
+func (o ScriptInstanceModelOutput) ToScriptInstanceModelOutput() ScriptInstanceModelOutput {
+	return o
+}
+
+func (o ScriptInstanceModelOutput) ToScriptInstanceModelOutputWithContext(ctx context.Context) ScriptInstanceModelOutput {
+	return o
 }
 
 // Unique identifier of AEM instance defined in the configuration.
-func (o InstanceModelOutput) Id() pulumi.StringOutput {
-	return o.ApplyT(func(v InstanceModel) string { return v.Id }).(pulumi.StringOutput)
+func (o ScriptInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ScriptInstanceModel) string { return v.Id }).(pulumi.StringOutput)
 }
 
-// A list of run modes for a specific AEM instance.
-func (o InstanceModelOutput) Run_modes() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v InstanceModel) []string { return v.Run_modes }).(pulumi.StringArrayOutput)
+// Standard output of the script executed on the AEM instance.
+func (o ScriptInstanceModelOutput) Output() pulumi.StringOutput {
+	return o.ApplyT(func(v ScriptInstanceModel) string { return v.Output }).(pulumi.StringOutput)
 }
 
-// The machine-internal HTTP URL address used for communication with the AEM instance.
-func (o InstanceModelOutput) Url() pulumi.StringOutput {
-	return o.ApplyT(func(v InstanceModel) string { return v.Url }).(pulumi.StringOutput)
+// Result returned by the Groovy script executed on the AEM instance.
+func (o ScriptInstanceModelOutput) Result() pulumi.StringOutput {
+	return o.ApplyT(func(v ScriptInstanceModel) string { return v.Result }).(pulumi.StringOutput)
 }
 
-type InstanceModelArrayOutput struct{ *pulumi.OutputState }
+type ScriptInstanceModelArrayOutput struct{ *pulumi.OutputState }
 
-func (InstanceModelArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]InstanceModel)(nil)).Elem()
+func (ScriptInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ScriptInstanceModel)(nil)).Elem()
 }
 
-func (o InstanceModelArrayOutput) ToInstanceModelArrayOutput() InstanceModelArrayOutput {
+func (o ScriptInstanceModelArrayOutput) ToScriptInstanceModelArrayOutput() ScriptInstanceModelArrayOutput {
 	return o
 }
 
-func (o InstanceModelArrayOutput) ToInstanceModelArrayOutputWithContext(ctx context.Context) InstanceModelArrayOutput {
+func (o ScriptInstanceModelArrayOutput) ToScriptInstanceModelArrayOutputWithContext(ctx context.Context) ScriptInstanceModelArrayOutput {
 	return o
 }
 
-func (o InstanceModelArrayOutput) Index(i pulumi.IntInput) InstanceModelOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) InstanceModel {
-		return vs[0].([]InstanceModel)[vs[1].(int)]
-	}).(InstanceModelOutput)
+func (o ScriptInstanceModelArrayOutput) Index(i pulumi.IntInput) ScriptInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ScriptInstanceModel {
+		return vs[0].([]ScriptInstanceModel)[vs[1].(int)]
+	}).(ScriptInstanceModelOutput)
 }
 
-type InstanceScript struct {
-	// Inline shell commands to be executed
-	Inline []string `pulumi:"inline"`
-	// Multiline shell script to be executed
-	Script *string `pulumi:"script"`
+type SmokeTestAuth struct {
+	// Password of the user.
+	Password *string `pulumi:"password"`
+	// Name of the user. When empty, the request is performed anonymously.
+	User *string `pulumi:"user"`
 }
 
-// InstanceScriptInput is an input type that accepts InstanceScriptArgs and InstanceScriptOutput values.
-// You can construct a concrete instance of `InstanceScriptInput` via:
+// SmokeTestAuthInput is an input type that accepts SmokeTestAuthArgs and SmokeTestAuthOutput values.
+// You can construct a concrete instance of `SmokeTestAuthInput` via:
 //
-//	InstanceScriptArgs{...}
-type InstanceScriptInput interface {
+//	SmokeTestAuthArgs{...}
+type SmokeTestAuthInput interface {
 	pulumi.Input
 
-	ToInstanceScriptOutput() InstanceScriptOutput
-	ToInstanceScriptOutputWithContext(context.Context) InstanceScriptOutput
+	ToSmokeTestAuthOutput() SmokeTestAuthOutput
+	ToSmokeTestAuthOutputWithContext(context.Context) SmokeTestAuthOutput
 }
 
-type InstanceScriptArgs struct {
-	// Inline shell commands to be executed
-	Inline pulumi.StringArrayInput `pulumi:"inline"`
-	// Multiline shell script to be executed
-	Script pulumi.StringPtrInput `pulumi:"script"`
+type SmokeTestAuthArgs struct {
+	// Password of the user.
+	Password pulumi.StringPtrInput `pulumi:"password"`
+	// Name of the user. When empty, the request is performed anonymously.
+	User pulumi.StringPtrInput `pulumi:"user"`
 }
 
-func (InstanceScriptArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*InstanceScript)(nil)).Elem()
+func (SmokeTestAuthArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTestAuth)(nil)).Elem()
 }
 
-func (i InstanceScriptArgs) ToInstanceScriptOutput() InstanceScriptOutput {
-	return i.ToInstanceScriptOutputWithContext(context.Background())
+func (i SmokeTestAuthArgs) ToSmokeTestAuthOutput() SmokeTestAuthOutput {
+	return i.ToSmokeTestAuthOutputWithContext(context.Background())
 }
 
-func (i InstanceScriptArgs) ToInstanceScriptOutputWithContext(ctx context.Context) InstanceScriptOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(InstanceScriptOutput)
+func (i SmokeTestAuthArgs) ToSmokeTestAuthOutputWithContext(ctx context.Context) SmokeTestAuthOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestAuthOutput)
 }
 
-func (i InstanceScriptArgs) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
-	return i.ToInstanceScriptPtrOutputWithContext(context.Background())
+func (i SmokeTestAuthArgs) ToSmokeTestAuthPtrOutput() SmokeTestAuthPtrOutput {
+	return i.ToSmokeTestAuthPtrOutputWithContext(context.Background())
 }
 
-func (i InstanceScriptArgs) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(InstanceScriptOutput).ToInstanceScriptPtrOutputWithContext(ctx)
+func (i SmokeTestAuthArgs) ToSmokeTestAuthPtrOutputWithContext(ctx context.Context) SmokeTestAuthPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestAuthOutput).ToSmokeTestAuthPtrOutputWithContext(ctx)
 }
 
-// InstanceScriptPtrInput is an input type that accepts InstanceScriptArgs, InstanceScriptPtr and InstanceScriptPtrOutput values.
-// You can construct a concrete instance of `InstanceScriptPtrInput` via:
+// SmokeTestAuthPtrInput is an input type that accepts SmokeTestAuthArgs, SmokeTestAuthPtr and SmokeTestAuthPtrOutput values.
+// You can construct a concrete instance of `SmokeTestAuthPtrInput` via:
 //
-//	        InstanceScriptArgs{...}
+//	        SmokeTestAuthArgs{...}
 //
 //	or:
 //
 //	        nil
-type InstanceScriptPtrInput interface {
+type SmokeTestAuthPtrInput interface {
 	pulumi.Input
 
-	ToInstanceScriptPtrOutput() InstanceScriptPtrOutput
-	ToInstanceScriptPtrOutputWithContext(context.Context) InstanceScriptPtrOutput
+	ToSmokeTestAuthPtrOutput() SmokeTestAuthPtrOutput
+	ToSmokeTestAuthPtrOutputWithContext(context.Context) SmokeTestAuthPtrOutput
 }
 
-type instanceScriptPtrType InstanceScriptArgs
+type smokeTestAuthPtrType SmokeTestAuthArgs
 
-func InstanceScriptPtr(v *InstanceScriptArgs) InstanceScriptPtrInput {
-	return (*instanceScriptPtrType)(v)
+func SmokeTestAuthPtr(v *SmokeTestAuthArgs) SmokeTestAuthPtrInput {
+	return (*smokeTestAuthPtrType)(v)
 }
 
-func (*instanceScriptPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**InstanceScript)(nil)).Elem()
+func (*smokeTestAuthPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SmokeTestAuth)(nil)).Elem()
 }
 
-func (i *instanceScriptPtrType) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
-	return i.ToInstanceScriptPtrOutputWithContext(context.Background())
+func (i *smokeTestAuthPtrType) ToSmokeTestAuthPtrOutput() SmokeTestAuthPtrOutput {
+	return i.ToSmokeTestAuthPtrOutputWithContext(context.Background())
 }
 
-func (i *instanceScriptPtrType) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(InstanceScriptPtrOutput)
+func (i *smokeTestAuthPtrType) ToSmokeTestAuthPtrOutputWithContext(ctx context.Context) SmokeTestAuthPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestAuthPtrOutput)
 }
 
-type InstanceScriptOutput struct{ *pulumi.OutputState }
+type SmokeTestAuthOutput struct{ *pulumi.OutputState }
 
-func (InstanceScriptOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*InstanceScript)(nil)).Elem()
+func (SmokeTestAuthOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTestAuth)(nil)).Elem()
 }
 
-func (o InstanceScriptOutput) ToInstanceScriptOutput() InstanceScriptOutput {
+func (o SmokeTestAuthOutput) ToSmokeTestAuthOutput() SmokeTestAuthOutput {
 	return o
 }
 
-func (o InstanceScriptOutput) ToInstanceScriptOutputWithContext(ctx context.Context) InstanceScriptOutput {
+func (o SmokeTestAuthOutput) ToSmokeTestAuthOutputWithContext(ctx context.Context) SmokeTestAuthOutput {
 	return o
 }
 
-func (o InstanceScriptOutput) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
-	return o.ToInstanceScriptPtrOutputWithContext(context.Background())
+func (o SmokeTestAuthOutput) ToSmokeTestAuthPtrOutput() SmokeTestAuthPtrOutput {
+	return o.ToSmokeTestAuthPtrOutputWithContext(context.Background())
 }
 
-func (o InstanceScriptOutput) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v InstanceScript) *InstanceScript {
+func (o SmokeTestAuthOutput) ToSmokeTestAuthPtrOutputWithContext(ctx context.Context) SmokeTestAuthPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SmokeTestAuth) *SmokeTestAuth {
 		return &v
-	}).(InstanceScriptPtrOutput)
+	}).(SmokeTestAuthPtrOutput)
 }
 
-// Inline shell commands to be executed
-func (o InstanceScriptOutput) Inline() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v InstanceScript) []string { return v.Inline }).(pulumi.StringArrayOutput)
+// Password of the user.
+func (o SmokeTestAuthOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestAuth) *string { return v.Password }).(pulumi.StringPtrOutput)
 }
 
-// Multiline shell script to be executed
-func (o InstanceScriptOutput) Script() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v InstanceScript) *string { return v.Script }).(pulumi.StringPtrOutput)
+// Name of the user. When empty, the request is performed anonymously.
+func (o SmokeTestAuthOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestAuth) *string { return v.User }).(pulumi.StringPtrOutput)
 }
 
-type InstanceScriptPtrOutput struct{ *pulumi.OutputState }
+type SmokeTestAuthPtrOutput struct{ *pulumi.OutputState }
 
-func (InstanceScriptPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**InstanceScript)(nil)).Elem()
+func (SmokeTestAuthPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SmokeTestAuth)(nil)).Elem()
 }
 
-func (o InstanceScriptPtrOutput) ToInstanceScriptPtrOutput() InstanceScriptPtrOutput {
+func (o SmokeTestAuthPtrOutput) ToSmokeTestAuthPtrOutput() SmokeTestAuthPtrOutput {
 	return o
 }
 
-func (o InstanceScriptPtrOutput) ToInstanceScriptPtrOutputWithContext(ctx context.Context) InstanceScriptPtrOutput {
+func (o SmokeTestAuthPtrOutput) ToSmokeTestAuthPtrOutputWithContext(ctx context.Context) SmokeTestAuthPtrOutput {
 	return o
 }
 
-func (o InstanceScriptPtrOutput) Elem() InstanceScriptOutput {
-	return o.ApplyT(func(v *InstanceScript) InstanceScript {
+func (o SmokeTestAuthPtrOutput) Elem() SmokeTestAuthOutput {
+	return o.ApplyT(func(v *SmokeTestAuth) SmokeTestAuth {
 		if v != nil {
 			return *v
 		}
-		var ret InstanceScript
+		var ret SmokeTestAuth
 		return ret
-	}).(InstanceScriptOutput)
+	}).(SmokeTestAuthOutput)
 }
 
-// Inline shell commands to be executed
-func (o InstanceScriptPtrOutput) Inline() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *InstanceScript) []string {
+// Password of the user.
+func (o SmokeTestAuthPtrOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SmokeTestAuth) *string {
 		if v == nil {
 			return nil
 		}
-		return v.Inline
-	}).(pulumi.StringArrayOutput)
+		return v.Password
+	}).(pulumi.StringPtrOutput)
 }
 
-// Multiline shell script to be executed
-func (o InstanceScriptPtrOutput) Script() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *InstanceScript) *string {
+// Name of the user. When empty, the request is performed anonymously.
+func (o SmokeTestAuthPtrOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SmokeTestAuth) *string {
 		if v == nil {
 			return nil
 		}
-		return v.Script
+		return v.User
 	}).(pulumi.StringPtrOutput)
 }
 
+type SmokeTestCheck struct {
+	// Credentials used to perform the request. By default, the credentials of the instance are used.
+	Auth *SmokeTestAuth `pulumi:"auth"`
+	// Regular expression which the response body needs to match.
+	Expected_body *string `pulumi:"expected_body"`
+	// Expected HTTP status of the response.
+	Expected_status *int `pulumi:"expected_status"`
+	// Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+	Json_path *string `pulumi:"json_path"`
+	// Expected value found at the JSON path.
+	Json_value *string `pulumi:"json_value"`
+	// HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+	Method *string `pulumi:"method"`
+	// Name of the check used in the results. By default, the method and path are used.
+	Name *string `pulumi:"name"`
+	// Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+	Path string `pulumi:"path"`
+}
+
+// SmokeTestCheckInput is an input type that accepts SmokeTestCheckArgs and SmokeTestCheckOutput values.
+// You can construct a concrete instance of `SmokeTestCheckInput` via:
+//
+//	SmokeTestCheckArgs{...}
+type SmokeTestCheckInput interface {
+	pulumi.Input
+
+	ToSmokeTestCheckOutput() SmokeTestCheckOutput
+	ToSmokeTestCheckOutputWithContext(context.Context) SmokeTestCheckOutput
+}
+
+type SmokeTestCheckArgs struct {
+	// Credentials used to perform the request. By default, the credentials of the instance are used.
+	Auth SmokeTestAuthPtrInput `pulumi:"auth"`
+	// Regular expression which the response body needs to match.
+	Expected_body pulumi.StringPtrInput `pulumi:"expected_body"`
+	// Expected HTTP status of the response.
+	Expected_status pulumi.IntPtrInput `pulumi:"expected_status"`
+	// Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+	Json_path pulumi.StringPtrInput `pulumi:"json_path"`
+	// Expected value found at the JSON path.
+	Json_value pulumi.StringPtrInput `pulumi:"json_value"`
+	// HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+	Method pulumi.StringPtrInput `pulumi:"method"`
+	// Name of the check used in the results. By default, the method and path are used.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+	Path pulumi.StringInput `pulumi:"path"`
+}
+
+func (SmokeTestCheckArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTestCheck)(nil)).Elem()
+}
+
+func (i SmokeTestCheckArgs) ToSmokeTestCheckOutput() SmokeTestCheckOutput {
+	return i.ToSmokeTestCheckOutputWithContext(context.Background())
+}
+
+func (i SmokeTestCheckArgs) ToSmokeTestCheckOutputWithContext(ctx context.Context) SmokeTestCheckOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestCheckOutput)
+}
+
+// SmokeTestCheckArrayInput is an input type that accepts SmokeTestCheckArray and SmokeTestCheckArrayOutput values.
+// You can construct a concrete instance of `SmokeTestCheckArrayInput` via:
+//
+//	SmokeTestCheckArray{ SmokeTestCheckArgs{...} }
+type SmokeTestCheckArrayInput interface {
+	pulumi.Input
+
+	ToSmokeTestCheckArrayOutput() SmokeTestCheckArrayOutput
+	ToSmokeTestCheckArrayOutputWithContext(context.Context) SmokeTestCheckArrayOutput
+}
+
+type SmokeTestCheckArray []SmokeTestCheckInput
+
+func (SmokeTestCheckArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SmokeTestCheck)(nil)).Elem()
+}
+
+func (i SmokeTestCheckArray) ToSmokeTestCheckArrayOutput() SmokeTestCheckArrayOutput {
+	return i.ToSmokeTestCheckArrayOutputWithContext(context.Background())
+}
+
+func (i SmokeTestCheckArray) ToSmokeTestCheckArrayOutputWithContext(ctx context.Context) SmokeTestCheckArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestCheckArrayOutput)
+}
+
+type SmokeTestCheckOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestCheckOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTestCheck)(nil)).Elem()
+}
+
+func (o SmokeTestCheckOutput) ToSmokeTestCheckOutput() SmokeTestCheckOutput {
+	return o
+}
+
+func (o SmokeTestCheckOutput) ToSmokeTestCheckOutputWithContext(ctx context.Context) SmokeTestCheckOutput {
+	return o
+}
+
+// Credentials used to perform the request. By default, the credentials of the instance are used.
+func (o SmokeTestCheckOutput) Auth() SmokeTestAuthPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *SmokeTestAuth { return v.Auth }).(SmokeTestAuthPtrOutput)
+}
+
+// Regular expression which the response body needs to match.
+func (o SmokeTestCheckOutput) Expected_body() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *string { return v.Expected_body }).(pulumi.StringPtrOutput)
+}
+
+// Expected HTTP status of the response.
+func (o SmokeTestCheckOutput) Expected_status() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *int { return v.Expected_status }).(pulumi.IntPtrOutput)
+}
+
+// Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+func (o SmokeTestCheckOutput) Json_path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *string { return v.Json_path }).(pulumi.StringPtrOutput)
+}
+
+// Expected value found at the JSON path.
+func (o SmokeTestCheckOutput) Json_value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *string { return v.Json_value }).(pulumi.StringPtrOutput)
+}
+
+// HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+func (o SmokeTestCheckOutput) Method() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *string { return v.Method }).(pulumi.StringPtrOutput)
+}
+
+// Name of the check used in the results. By default, the method and path are used.
+func (o SmokeTestCheckOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTestCheck) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+func (o SmokeTestCheckOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v SmokeTestCheck) string { return v.Path }).(pulumi.StringOutput)
+}
+
+type SmokeTestCheckArrayOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestCheckArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SmokeTestCheck)(nil)).Elem()
+}
+
+func (o SmokeTestCheckArrayOutput) ToSmokeTestCheckArrayOutput() SmokeTestCheckArrayOutput {
+	return o
+}
+
+func (o SmokeTestCheckArrayOutput) ToSmokeTestCheckArrayOutputWithContext(ctx context.Context) SmokeTestCheckArrayOutput {
+	return o
+}
+
+func (o SmokeTestCheckArrayOutput) Index(i pulumi.IntInput) SmokeTestCheckOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SmokeTestCheck {
+		return vs[0].([]SmokeTestCheck)[vs[1].(int)]
+	}).(SmokeTestCheckOutput)
+}
+
+type SmokeTestResult struct {
+	// Name of the check.
+	Check string `pulumi:"check"`
+	// Unique identifier of AEM instance on which the check was performed.
+	Instance_id string `pulumi:"instance_id"`
+	// Reason of the failure.
+	Message string `pulumi:"message"`
+	// Indicates if the response met the expectations.
+	Passed bool `pulumi:"passed"`
+	// HTTP status of the response.
+	Status int `pulumi:"status"`
+}
+
+type SmokeTestResultOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTestResult)(nil)).Elem()
+}
+
+func (o SmokeTestResultOutput) ToSmokeTestResultOutput() SmokeTestResultOutput {
+	return o
+}
+
+func (o SmokeTestResultOutput) ToSmokeTestResultOutputWithContext(ctx context.Context) SmokeTestResultOutput {
+	return o
+}
+
+// Name of the check.
+func (o SmokeTestResultOutput) Check() pulumi.StringOutput {
+	return o.ApplyT(func(v SmokeTestResult) string { return v.Check }).(pulumi.StringOutput)
+}
+
+// Unique identifier of AEM instance on which the check was performed.
+func (o SmokeTestResultOutput) Instance_id() pulumi.StringOutput {
+	return o.ApplyT(func(v SmokeTestResult) string { return v.Instance_id }).(pulumi.StringOutput)
+}
+
+// Reason of the failure.
+func (o SmokeTestResultOutput) Message() pulumi.StringOutput {
+	return o.ApplyT(func(v SmokeTestResult) string { return v.Message }).(pulumi.StringOutput)
+}
+
+// Indicates if the response met the expectations.
+func (o SmokeTestResultOutput) Passed() pulumi.BoolOutput {
+	return o.ApplyT(func(v SmokeTestResult) bool { return v.Passed }).(pulumi.BoolOutput)
+}
+
+// HTTP status of the response.
+func (o SmokeTestResultOutput) Status() pulumi.IntOutput {
+	return o.ApplyT(func(v SmokeTestResult) int { return v.Status }).(pulumi.IntOutput)
+}
+
+type SmokeTestResultArrayOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestResultArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SmokeTestResult)(nil)).Elem()
+}
+
+func (o SmokeTestResultArrayOutput) ToSmokeTestResultArrayOutput() SmokeTestResultArrayOutput {
+	return o
+}
+
+func (o SmokeTestResultArrayOutput) ToSmokeTestResultArrayOutputWithContext(ctx context.Context) SmokeTestResultArrayOutput {
+	return o
+}
+
+func (o SmokeTestResultArrayOutput) Index(i pulumi.IntInput) SmokeTestResultOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SmokeTestResult {
+		return vs[0].([]SmokeTestResult)[vs[1].(int)]
+	}).(SmokeTestResultOutput)
+}
+
 type System struct {
 	// Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
 	Bootstrap *InstanceScript `pulumi:"bootstrap"`
@@ -575,6 +3030,8 @@ type System struct {
 	Data_dir *string `pulumi:"data_dir"`
 	// Environment variables for AEM instances.
 	Env map[string]string `pulumi:"env"`
+	// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+	Schedules []Schedule `pulumi:"schedules"`
 	// Contents of the AEM system service definition file (systemd).
 	Service_config *string `pulumi:"service_config"`
 	// System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
@@ -601,6 +3058,8 @@ type SystemArgs struct {
 	Data_dir pulumi.StringPtrInput `pulumi:"data_dir"`
 	// Environment variables for AEM instances.
 	Env pulumi.StringMapInput `pulumi:"env"`
+	// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+	Schedules ScheduleArrayInput `pulumi:"schedules"`
 	// Contents of the AEM system service definition file (systemd).
 	Service_config pulumi.StringPtrInput `pulumi:"service_config"`
 	// System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
@@ -701,6 +3160,11 @@ func (o SystemOutput) Env() pulumi.StringMapOutput {
 	return o.ApplyT(func(v System) map[string]string { return v.Env }).(pulumi.StringMapOutput)
 }
 
+// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+func (o SystemOutput) Schedules() ScheduleArrayOutput {
+	return o.ApplyT(func(v System) []Schedule { return v.Schedules }).(ScheduleArrayOutput)
+}
+
 // Contents of the AEM system service definition file (systemd).
 func (o SystemOutput) Service_config() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v System) *string { return v.Service_config }).(pulumi.StringPtrOutput)
@@ -770,6 +3234,16 @@ func (o SystemPtrOutput) Env() pulumi.StringMapOutput {
 	}).(pulumi.StringMapOutput)
 }
 
+// Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+func (o SystemPtrOutput) Schedules() ScheduleArrayOutput {
+	return o.ApplyT(func(v *System) []Schedule {
+		if v == nil {
+			return nil
+		}
+		return v.Schedules
+	}).(ScheduleArrayOutput)
+}
+
 // Contents of the AEM system service definition file (systemd).
 func (o SystemPtrOutput) Service_config() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *System) *string {
@@ -800,21 +3274,148 @@ func (o SystemPtrOutput) Work_dir() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+type UserInstanceModel struct {
+	// Indicates if the user exists on the AEM instance.
+	Exists bool `pulumi:"exists"`
+	// Identifiers of the groups to which the user directly belongs on the AEM instance.
+	Groups []string `pulumi:"groups"`
+	// Unique identifier of AEM instance defined in the configuration.
+	Id string `pulumi:"id"`
+	// Repository path of the user on the AEM instance.
+	Path string `pulumi:"path"`
+}
+
+type UserInstanceModelOutput struct{ *pulumi.OutputState }
+
+func (UserInstanceModelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*UserInstanceModel)(nil)).Elem()
+}
+
+func (o UserInstanceModelOutput) ToUserInstanceModelOutput() UserInstanceModelOutput {
+	return o
+}
+
+func (o UserInstanceModelOutput) ToUserInstanceModelOutputWithContext(ctx context.Context) UserInstanceModelOutput {
+	return o
+}
+
+// Indicates if the user exists on the AEM instance.
+func (o UserInstanceModelOutput) Exists() pulumi.BoolOutput {
+	return o.ApplyT(func(v UserInstanceModel) bool { return v.Exists }).(pulumi.BoolOutput)
+}
+
+// Identifiers of the groups to which the user directly belongs on the AEM instance.
+func (o UserInstanceModelOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v UserInstanceModel) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// Unique identifier of AEM instance defined in the configuration.
+func (o UserInstanceModelOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v UserInstanceModel) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Repository path of the user on the AEM instance.
+func (o UserInstanceModelOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v UserInstanceModel) string { return v.Path }).(pulumi.StringOutput)
+}
+
+type UserInstanceModelArrayOutput struct{ *pulumi.OutputState }
+
+func (UserInstanceModelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]UserInstanceModel)(nil)).Elem()
+}
+
+func (o UserInstanceModelArrayOutput) ToUserInstanceModelArrayOutput() UserInstanceModelArrayOutput {
+	return o
+}
+
+func (o UserInstanceModelArrayOutput) ToUserInstanceModelArrayOutputWithContext(ctx context.Context) UserInstanceModelArrayOutput {
+	return o
+}
+
+func (o UserInstanceModelArrayOutput) Index(i pulumi.IntInput) UserInstanceModelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) UserInstanceModel {
+		return vs[0].([]UserInstanceModel)[vs[1].(int)]
+	}).(UserInstanceModelOutput)
+}
+
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*BackupInput)(nil)).Elem(), BackupArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BackupPtrInput)(nil)).Elem(), BackupArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ClientInput)(nil)).Elem(), ClientArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ComposeInput)(nil)).Elem(), ComposeArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ComposePtrInput)(nil)).Elem(), ComposeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ComposeInstanceInput)(nil)).Elem(), ComposeInstanceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ComposeInstanceArrayInput)(nil)).Elem(), ComposeInstanceArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DiagnosticsInput)(nil)).Elem(), DiagnosticsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DiagnosticsPtrInput)(nil)).Elem(), DiagnosticsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*InstanceScriptInput)(nil)).Elem(), InstanceScriptArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*InstanceScriptPtrInput)(nil)).Elem(), InstanceScriptArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PreflightInput)(nil)).Elem(), PreflightArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PreflightPtrInput)(nil)).Elem(), PreflightArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReadinessInput)(nil)).Elem(), ReadinessArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReadinessPtrInput)(nil)).Elem(), ReadinessArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReadinessProbeInput)(nil)).Elem(), ReadinessProbeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReadinessProbeArrayInput)(nil)).Elem(), ReadinessProbeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScheduleInput)(nil)).Elem(), ScheduleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScheduleArrayInput)(nil)).Elem(), ScheduleArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestAuthInput)(nil)).Elem(), SmokeTestAuthArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestAuthPtrInput)(nil)).Elem(), SmokeTestAuthArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestCheckInput)(nil)).Elem(), SmokeTestCheckArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestCheckArrayInput)(nil)).Elem(), SmokeTestCheckArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SystemInput)(nil)).Elem(), SystemArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SystemPtrInput)(nil)).Elem(), SystemArgs{})
+	pulumi.RegisterOutputType(AclInstanceModelOutput{})
+	pulumi.RegisterOutputType(AclInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(ActivateResultItemOutput{})
+	pulumi.RegisterOutputType(ActivateResultItemArrayOutput{})
+	pulumi.RegisterOutputType(BackupOutput{})
+	pulumi.RegisterOutputType(BackupPtrOutput{})
+	pulumi.RegisterOutputType(BackupModelOutput{})
+	pulumi.RegisterOutputType(BackupModelArrayOutput{})
+	pulumi.RegisterOutputType(BundleInstanceModelOutput{})
+	pulumi.RegisterOutputType(BundleInstanceModelArrayOutput{})
 	pulumi.RegisterOutputType(ClientOutput{})
 	pulumi.RegisterOutputType(ComposeOutput{})
 	pulumi.RegisterOutputType(ComposePtrOutput{})
+	pulumi.RegisterOutputType(ComposeInstanceOutput{})
+	pulumi.RegisterOutputType(ComposeInstanceArrayOutput{})
+	pulumi.RegisterOutputType(DiagnosticsOutput{})
+	pulumi.RegisterOutputType(DiagnosticsPtrOutput{})
+	pulumi.RegisterOutputType(GroupInstanceModelOutput{})
+	pulumi.RegisterOutputType(GroupInstanceModelArrayOutput{})
 	pulumi.RegisterOutputType(InstanceModelOutput{})
 	pulumi.RegisterOutputType(InstanceModelArrayOutput{})
 	pulumi.RegisterOutputType(InstanceScriptOutput{})
 	pulumi.RegisterOutputType(InstanceScriptPtrOutput{})
+	pulumi.RegisterOutputType(OakIndexInstanceModelOutput{})
+	pulumi.RegisterOutputType(OakIndexInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(OsgiConfigInstanceModelOutput{})
+	pulumi.RegisterOutputType(OsgiConfigInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(PackageInstanceModelOutput{})
+	pulumi.RegisterOutputType(PackageInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(PreflightOutput{})
+	pulumi.RegisterOutputType(PreflightPtrOutput{})
+	pulumi.RegisterOutputType(ReadinessOutput{})
+	pulumi.RegisterOutputType(ReadinessPtrOutput{})
+	pulumi.RegisterOutputType(ReadinessProbeOutput{})
+	pulumi.RegisterOutputType(ReadinessProbeArrayOutput{})
+	pulumi.RegisterOutputType(ReplicationAgentInstanceModelOutput{})
+	pulumi.RegisterOutputType(ReplicationAgentInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(RepoNodeInstanceModelOutput{})
+	pulumi.RegisterOutputType(RepoNodeInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(ScheduleOutput{})
+	pulumi.RegisterOutputType(ScheduleArrayOutput{})
+	pulumi.RegisterOutputType(ScriptInstanceModelOutput{})
+	pulumi.RegisterOutputType(ScriptInstanceModelArrayOutput{})
+	pulumi.RegisterOutputType(SmokeTestAuthOutput{})
+	pulumi.RegisterOutputType(SmokeTestAuthPtrOutput{})
+	pulumi.RegisterOutputType(SmokeTestCheckOutput{})
+	pulumi.RegisterOutputType(SmokeTestCheckArrayOutput{})
+	pulumi.RegisterOutputType(SmokeTestResultOutput{})
+	pulumi.RegisterOutputType(SmokeTestResultArrayOutput{})
 	pulumi.RegisterOutputType(SystemOutput{})
 	pulumi.RegisterOutputType(SystemPtrOutput{})
+	pulumi.RegisterOutputType(UserInstanceModelOutput{})
+	pulumi.RegisterOutputType(UserInstanceModelArrayOutput{})
 }
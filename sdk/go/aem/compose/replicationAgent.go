@@ -0,0 +1,380 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// Replication agent set up on the AEM instances. Deleted from the instances when the resource is deleted.
+type ReplicationAgent struct {
+	pulumi.CustomResourceState
+
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientOutput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrOutput `pulumi:"compose"`
+	// Toggle the replication agent.
+	Enabled pulumi.BoolPtrOutput `pulumi:"enabled"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayOutput `pulumi:"instance_ids"`
+	// Current state of the replication agent on the target AEM instances.
+	Instances ReplicationAgentInstanceModelArrayOutput `pulumi:"instances"`
+	// Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+	Location pulumi.StringPtrOutput `pulumi:"location"`
+	// Name of the replication agent. By default, the resource name is used.
+	Name pulumi.StringPtrOutput `pulumi:"name"`
+	// Repository path of the replication agent.
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+	Properties pulumi.MapOutput `pulumi:"properties"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrOutput `pulumi:"system"`
+	// Password used to authenticate on the replication target.
+	Transport_password pulumi.StringPtrOutput `pulumi:"transport_password"`
+	// URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+	Transport_uri pulumi.StringOutput `pulumi:"transport_uri"`
+	// User used to authenticate on the replication target.
+	Transport_user pulumi.StringPtrOutput `pulumi:"transport_user"`
+	// Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+	Type pulumi.StringPtrOutput `pulumi:"type"`
+	// User whose permissions are used when replicating content. By default, the system user is used.
+	User_id pulumi.StringPtrOutput `pulumi:"user_id"`
+}
+
+// NewReplicationAgent registers a new resource with the given unique name, arguments, and options.
+func NewReplicationAgent(ctx *pulumi.Context,
+	name string, args *ReplicationAgentArgs, opts ...pulumi.ResourceOption) (*ReplicationAgent, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Client == nil {
+		return nil, errors.New("invalid value for required argument 'Client'")
+	}
+	if args.Transport_uri == nil {
+		return nil, errors.New("invalid value for required argument 'Transport_uri'")
+	}
+	if args.Transport_password != nil {
+		args.Transport_password = pulumi.ToSecret(args.Transport_password).(pulumi.StringPtrInput)
+	}
+	if args.Transport_user != nil {
+		args.Transport_user = pulumi.ToSecret(args.Transport_user).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"transport_password",
+		"transport_user",
+	})
+	opts = append(opts, secrets)
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"location",
+		"name",
+	})
+	opts = append(opts, replaceOnChanges)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource ReplicationAgent
+	err := ctx.RegisterResource("aem:compose:ReplicationAgent", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetReplicationAgent gets an existing ReplicationAgent resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetReplicationAgent(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ReplicationAgentState, opts ...pulumi.ResourceOption) (*ReplicationAgent, error) {
+	var resource ReplicationAgent
+	err := ctx.ReadResource("aem:compose:ReplicationAgent", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ReplicationAgent resources.
+type replicationAgentState struct {
+}
+
+type ReplicationAgentState struct {
+}
+
+func (ReplicationAgentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*replicationAgentState)(nil)).Elem()
+}
+
+type replicationAgentArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Toggle the replication agent.
+	Enabled *bool `pulumi:"enabled"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids []string `pulumi:"instance_ids"`
+	// Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+	Location *string `pulumi:"location"`
+	// Name of the replication agent. By default, the resource name is used.
+	Name *string `pulumi:"name"`
+	// Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+	Properties map[string]interface{} `pulumi:"properties"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+	// Password used to authenticate on the replication target.
+	Transport_password *string `pulumi:"transport_password"`
+	// URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+	Transport_uri string `pulumi:"transport_uri"`
+	// User used to authenticate on the replication target.
+	Transport_user *string `pulumi:"transport_user"`
+	// Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+	Type *string `pulumi:"type"`
+	// User whose permissions are used when replicating content. By default, the system user is used.
+	User_id *string `pulumi:"user_id"`
+}
+
+// The set of arguments for constructing a ReplicationAgent resource.
+type ReplicationAgentArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput
+	// Toggle the replication agent.
+	Enabled pulumi.BoolPtrInput
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayInput
+	// Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+	Location pulumi.StringPtrInput
+	// Name of the replication agent. By default, the resource name is used.
+	Name pulumi.StringPtrInput
+	// Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+	Properties pulumi.MapInput
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput
+	// Password used to authenticate on the replication target.
+	Transport_password pulumi.StringPtrInput
+	// URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+	Transport_uri pulumi.StringInput
+	// User used to authenticate on the replication target.
+	Transport_user pulumi.StringPtrInput
+	// Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+	Type pulumi.StringPtrInput
+	// User whose permissions are used when replicating content. By default, the system user is used.
+	User_id pulumi.StringPtrInput
+}
+
+func (ReplicationAgentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*replicationAgentArgs)(nil)).Elem()
+}
+
+type ReplicationAgentInput interface {
+	pulumi.Input
+
+	ToReplicationAgentOutput() ReplicationAgentOutput
+	ToReplicationAgentOutputWithContext(ctx context.Context) ReplicationAgentOutput
+}
+
+func (*ReplicationAgent) ElementType() reflect.Type {
+	return reflect.TypeOf((**ReplicationAgent)(nil)).Elem()
+}
+
+func (i *ReplicationAgent) ToReplicationAgentOutput() ReplicationAgentOutput {
+	return i.ToReplicationAgentOutputWithContext(context.Background())
+}
+
+func (i *ReplicationAgent) ToReplicationAgentOutputWithContext(ctx context.Context) ReplicationAgentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReplicationAgentOutput)
+}
+
+// ReplicationAgentArrayInput is an input type that accepts ReplicationAgentArray and ReplicationAgentArrayOutput values.
+// You can construct a concrete instance of `ReplicationAgentArrayInput` via:
+//
+//	ReplicationAgentArray{ ReplicationAgentArgs{...} }
+type ReplicationAgentArrayInput interface {
+	pulumi.Input
+
+	ToReplicationAgentArrayOutput() ReplicationAgentArrayOutput
+	ToReplicationAgentArrayOutputWithContext(context.Context) ReplicationAgentArrayOutput
+}
+
+type ReplicationAgentArray []ReplicationAgentInput
+
+func (ReplicationAgentArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ReplicationAgent)(nil)).Elem()
+}
+
+func (i ReplicationAgentArray) ToReplicationAgentArrayOutput() ReplicationAgentArrayOutput {
+	return i.ToReplicationAgentArrayOutputWithContext(context.Background())
+}
+
+func (i ReplicationAgentArray) ToReplicationAgentArrayOutputWithContext(ctx context.Context) ReplicationAgentArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReplicationAgentArrayOutput)
+}
+
+// ReplicationAgentMapInput is an input type that accepts ReplicationAgentMap and ReplicationAgentMapOutput values.
+// You can construct a concrete instance of `ReplicationAgentMapInput` via:
+//
+//	ReplicationAgentMap{ "key": ReplicationAgentArgs{...} }
+type ReplicationAgentMapInput interface {
+	pulumi.Input
+
+	ToReplicationAgentMapOutput() ReplicationAgentMapOutput
+	ToReplicationAgentMapOutputWithContext(context.Context) ReplicationAgentMapOutput
+}
+
+type ReplicationAgentMap map[string]ReplicationAgentInput
+
+func (ReplicationAgentMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ReplicationAgent)(nil)).Elem()
+}
+
+func (i ReplicationAgentMap) ToReplicationAgentMapOutput() ReplicationAgentMapOutput {
+	return i.ToReplicationAgentMapOutputWithContext(context.Background())
+}
+
+func (i ReplicationAgentMap) ToReplicationAgentMapOutputWithContext(ctx context.Context) ReplicationAgentMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReplicationAgentMapOutput)
+}
+
+type ReplicationAgentOutput struct{ *pulumi.OutputState }
+
+func (ReplicationAgentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ReplicationAgent)(nil)).Elem()
+}
+
+func (o ReplicationAgentOutput) ToReplicationAgentOutput() ReplicationAgentOutput {
+	return o
+}
+
+func (o ReplicationAgentOutput) ToReplicationAgentOutputWithContext(ctx context.Context) ReplicationAgentOutput {
+	return o
+}
+
+// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o ReplicationAgentOutput) Client() ClientOutput {
+	return o.ApplyT(func(v *ReplicationAgent) ClientOutput { return v.Client }).(ClientOutput)
+}
+
+// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+func (o ReplicationAgentOutput) Compose() ComposePtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) ComposePtrOutput { return v.Compose }).(ComposePtrOutput)
+}
+
+// Toggle the replication agent.
+func (o ReplicationAgentOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.BoolPtrOutput { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+func (o ReplicationAgentOutput) Instance_ids() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringArrayOutput { return v.Instance_ids }).(pulumi.StringArrayOutput)
+}
+
+// Current state of the replication agent on the target AEM instances.
+func (o ReplicationAgentOutput) Instances() ReplicationAgentInstanceModelArrayOutput {
+	return o.ApplyT(func(v *ReplicationAgent) ReplicationAgentInstanceModelArrayOutput { return v.Instances }).(ReplicationAgentInstanceModelArrayOutput)
+}
+
+// Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+func (o ReplicationAgentOutput) Location() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringPtrOutput { return v.Location }).(pulumi.StringPtrOutput)
+}
+
+// Name of the replication agent. By default, the resource name is used.
+func (o ReplicationAgentOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Repository path of the replication agent.
+func (o ReplicationAgentOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+func (o ReplicationAgentOutput) Properties() pulumi.MapOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.MapOutput { return v.Properties }).(pulumi.MapOutput)
+}
+
+// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o ReplicationAgentOutput) System() SystemPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) SystemPtrOutput { return v.System }).(SystemPtrOutput)
+}
+
+// Password used to authenticate on the replication target.
+func (o ReplicationAgentOutput) Transport_password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringPtrOutput { return v.Transport_password }).(pulumi.StringPtrOutput)
+}
+
+// URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+func (o ReplicationAgentOutput) Transport_uri() pulumi.StringOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringOutput { return v.Transport_uri }).(pulumi.StringOutput)
+}
+
+// User used to authenticate on the replication target.
+func (o ReplicationAgentOutput) Transport_user() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringPtrOutput { return v.Transport_user }).(pulumi.StringPtrOutput)
+}
+
+// Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+func (o ReplicationAgentOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringPtrOutput { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+// User whose permissions are used when replicating content. By default, the system user is used.
+func (o ReplicationAgentOutput) User_id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReplicationAgent) pulumi.StringPtrOutput { return v.User_id }).(pulumi.StringPtrOutput)
+}
+
+type ReplicationAgentArrayOutput struct{ *pulumi.OutputState }
+
+func (ReplicationAgentArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ReplicationAgent)(nil)).Elem()
+}
+
+func (o ReplicationAgentArrayOutput) ToReplicationAgentArrayOutput() ReplicationAgentArrayOutput {
+	return o
+}
+
+func (o ReplicationAgentArrayOutput) ToReplicationAgentArrayOutputWithContext(ctx context.Context) ReplicationAgentArrayOutput {
+	return o
+}
+
+func (o ReplicationAgentArrayOutput) Index(i pulumi.IntInput) ReplicationAgentOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ReplicationAgent {
+		return vs[0].([]*ReplicationAgent)[vs[1].(int)]
+	}).(ReplicationAgentOutput)
+}
+
+type ReplicationAgentMapOutput struct{ *pulumi.OutputState }
+
+func (ReplicationAgentMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ReplicationAgent)(nil)).Elem()
+}
+
+func (o ReplicationAgentMapOutput) ToReplicationAgentMapOutput() ReplicationAgentMapOutput {
+	return o
+}
+
+func (o ReplicationAgentMapOutput) ToReplicationAgentMapOutputWithContext(ctx context.Context) ReplicationAgentMapOutput {
+	return o
+}
+
+func (o ReplicationAgentMapOutput) MapIndex(k pulumi.StringInput) ReplicationAgentOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ReplicationAgent {
+		return vs[0].(map[string]*ReplicationAgent)[vs[1].(string)]
+	}).(ReplicationAgentOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ReplicationAgentInput)(nil)).Elem(), &ReplicationAgent{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReplicationAgentArrayInput)(nil)).Elem(), ReplicationAgentArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReplicationAgentMapInput)(nil)).Elem(), ReplicationAgentMap{})
+	pulumi.RegisterOutputType(ReplicationAgentOutput{})
+	pulumi.RegisterOutputType(ReplicationAgentArrayOutput{})
+	pulumi.RegisterOutputType(ReplicationAgentMapOutput{})
+}
@@ -0,0 +1,309 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// Repository node with properties and child nodes saved on the AEM instances (e.g. cloud service or context-aware configuration). Removed from the instances when the resource is deleted (in merge mode, only the managed properties and child nodes are removed).
+type RepoNode struct {
+	pulumi.CustomResourceState
+
+	// Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+	Children pulumi.MapOutput `pulumi:"children"`
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientOutput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrOutput `pulumi:"compose"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayOutput `pulumi:"instance_ids"`
+	// Current state of the repository node on the target AEM instances.
+	Instances RepoNodeInstanceModelArrayOutput `pulumi:"instances"`
+	// Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+	Mode pulumi.StringPtrOutput `pulumi:"mode"`
+	// Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+	Properties pulumi.MapOutput `pulumi:"properties"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrOutput `pulumi:"system"`
+}
+
+// NewRepoNode registers a new resource with the given unique name, arguments, and options.
+func NewRepoNode(ctx *pulumi.Context,
+	name string, args *RepoNodeArgs, opts ...pulumi.ResourceOption) (*RepoNode, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Client == nil {
+		return nil, errors.New("invalid value for required argument 'Client'")
+	}
+	if args.Path == nil {
+		return nil, errors.New("invalid value for required argument 'Path'")
+	}
+	if args.Properties == nil {
+		return nil, errors.New("invalid value for required argument 'Properties'")
+	}
+	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
+		"path",
+	})
+	opts = append(opts, replaceOnChanges)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource RepoNode
+	err := ctx.RegisterResource("aem:compose:RepoNode", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetRepoNode gets an existing RepoNode resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetRepoNode(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *RepoNodeState, opts ...pulumi.ResourceOption) (*RepoNode, error) {
+	var resource RepoNode
+	err := ctx.ReadResource("aem:compose:RepoNode", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering RepoNode resources.
+type repoNodeState struct {
+}
+
+type RepoNodeState struct {
+}
+
+func (RepoNodeState) ElementType() reflect.Type {
+	return reflect.TypeOf((*repoNodeState)(nil)).Elem()
+}
+
+type repoNodeArgs struct {
+	// Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+	Children map[string]interface{} `pulumi:"children"`
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids []string `pulumi:"instance_ids"`
+	// Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+	Mode *string `pulumi:"mode"`
+	// Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+	Path string `pulumi:"path"`
+	// Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+	Properties map[string]interface{} `pulumi:"properties"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+}
+
+// The set of arguments for constructing a RepoNode resource.
+type RepoNodeArgs struct {
+	// Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+	Children pulumi.MapInput
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayInput
+	// Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+	Mode pulumi.StringPtrInput
+	// Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+	Path pulumi.StringInput
+	// Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+	Properties pulumi.MapInput
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput
+}
+
+func (RepoNodeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*repoNodeArgs)(nil)).Elem()
+}
+
+type RepoNodeInput interface {
+	pulumi.Input
+
+	ToRepoNodeOutput() RepoNodeOutput
+	ToRepoNodeOutputWithContext(ctx context.Context) RepoNodeOutput
+}
+
+func (*RepoNode) ElementType() reflect.Type {
+	return reflect.TypeOf((**RepoNode)(nil)).Elem()
+}
+
+func (i *RepoNode) ToRepoNodeOutput() RepoNodeOutput {
+	return i.ToRepoNodeOutputWithContext(context.Background())
+}
+
+func (i *RepoNode) ToRepoNodeOutputWithContext(ctx context.Context) RepoNodeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RepoNodeOutput)
+}
+
+// RepoNodeArrayInput is an input type that accepts RepoNodeArray and RepoNodeArrayOutput values.
+// You can construct a concrete instance of `RepoNodeArrayInput` via:
+//
+//	RepoNodeArray{ RepoNodeArgs{...} }
+type RepoNodeArrayInput interface {
+	pulumi.Input
+
+	ToRepoNodeArrayOutput() RepoNodeArrayOutput
+	ToRepoNodeArrayOutputWithContext(context.Context) RepoNodeArrayOutput
+}
+
+type RepoNodeArray []RepoNodeInput
+
+func (RepoNodeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*RepoNode)(nil)).Elem()
+}
+
+func (i RepoNodeArray) ToRepoNodeArrayOutput() RepoNodeArrayOutput {
+	return i.ToRepoNodeArrayOutputWithContext(context.Background())
+}
+
+func (i RepoNodeArray) ToRepoNodeArrayOutputWithContext(ctx context.Context) RepoNodeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RepoNodeArrayOutput)
+}
+
+// RepoNodeMapInput is an input type that accepts RepoNodeMap and RepoNodeMapOutput values.
+// You can construct a concrete instance of `RepoNodeMapInput` via:
+//
+//	RepoNodeMap{ "key": RepoNodeArgs{...} }
+type RepoNodeMapInput interface {
+	pulumi.Input
+
+	ToRepoNodeMapOutput() RepoNodeMapOutput
+	ToRepoNodeMapOutputWithContext(context.Context) RepoNodeMapOutput
+}
+
+type RepoNodeMap map[string]RepoNodeInput
+
+func (RepoNodeMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*RepoNode)(nil)).Elem()
+}
+
+func (i RepoNodeMap) ToRepoNodeMapOutput() RepoNodeMapOutput {
+	return i.ToRepoNodeMapOutputWithContext(context.Background())
+}
+
+func (i RepoNodeMap) ToRepoNodeMapOutputWithContext(ctx context.Context) RepoNodeMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RepoNodeMapOutput)
+}
+
+type RepoNodeOutput struct{ *pulumi.OutputState }
+
+func (RepoNodeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**RepoNode)(nil)).Elem()
+}
+
+func (o RepoNodeOutput) ToRepoNodeOutput() RepoNodeOutput {
+	return o
+}
+
+func (o RepoNodeOutput) ToRepoNodeOutputWithContext(ctx context.Context) RepoNodeOutput {
+	return o
+}
+
+// Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+func (o RepoNodeOutput) Children() pulumi.MapOutput {
+	return o.ApplyT(func(v *RepoNode) pulumi.MapOutput { return v.Children }).(pulumi.MapOutput)
+}
+
+// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o RepoNodeOutput) Client() ClientOutput {
+	return o.ApplyT(func(v *RepoNode) ClientOutput { return v.Client }).(ClientOutput)
+}
+
+// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+func (o RepoNodeOutput) Compose() ComposePtrOutput {
+	return o.ApplyT(func(v *RepoNode) ComposePtrOutput { return v.Compose }).(ComposePtrOutput)
+}
+
+// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+func (o RepoNodeOutput) Instance_ids() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *RepoNode) pulumi.StringArrayOutput { return v.Instance_ids }).(pulumi.StringArrayOutput)
+}
+
+// Current state of the repository node on the target AEM instances.
+func (o RepoNodeOutput) Instances() RepoNodeInstanceModelArrayOutput {
+	return o.ApplyT(func(v *RepoNode) RepoNodeInstanceModelArrayOutput { return v.Instances }).(RepoNodeInstanceModelArrayOutput)
+}
+
+// Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+func (o RepoNodeOutput) Mode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RepoNode) pulumi.StringPtrOutput { return v.Mode }).(pulumi.StringPtrOutput)
+}
+
+// Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+func (o RepoNodeOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *RepoNode) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+func (o RepoNodeOutput) Properties() pulumi.MapOutput {
+	return o.ApplyT(func(v *RepoNode) pulumi.MapOutput { return v.Properties }).(pulumi.MapOutput)
+}
+
+// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o RepoNodeOutput) System() SystemPtrOutput {
+	return o.ApplyT(func(v *RepoNode) SystemPtrOutput { return v.System }).(SystemPtrOutput)
+}
+
+type RepoNodeArrayOutput struct{ *pulumi.OutputState }
+
+func (RepoNodeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*RepoNode)(nil)).Elem()
+}
+
+func (o RepoNodeArrayOutput) ToRepoNodeArrayOutput() RepoNodeArrayOutput {
+	return o
+}
+
+func (o RepoNodeArrayOutput) ToRepoNodeArrayOutputWithContext(ctx context.Context) RepoNodeArrayOutput {
+	return o
+}
+
+func (o RepoNodeArrayOutput) Index(i pulumi.IntInput) RepoNodeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *RepoNode {
+		return vs[0].([]*RepoNode)[vs[1].(int)]
+	}).(RepoNodeOutput)
+}
+
+type RepoNodeMapOutput struct{ *pulumi.OutputState }
+
+func (RepoNodeMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*RepoNode)(nil)).Elem()
+}
+
+func (o RepoNodeMapOutput) ToRepoNodeMapOutput() RepoNodeMapOutput {
+	return o
+}
+
+func (o RepoNodeMapOutput) ToRepoNodeMapOutputWithContext(ctx context.Context) RepoNodeMapOutput {
+	return o
+}
+
+func (o RepoNodeMapOutput) MapIndex(k pulumi.StringInput) RepoNodeOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *RepoNode {
+		return vs[0].(map[string]*RepoNode)[vs[1].(string)]
+	}).(RepoNodeOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*RepoNodeInput)(nil)).Elem(), &RepoNode{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RepoNodeArrayInput)(nil)).Elem(), RepoNodeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RepoNodeMapInput)(nil)).Elem(), RepoNodeMap{})
+	pulumi.RegisterOutputType(RepoNodeOutput{})
+	pulumi.RegisterOutputType(RepoNodeArrayOutput{})
+	pulumi.RegisterOutputType(RepoNodeMapOutput{})
+}
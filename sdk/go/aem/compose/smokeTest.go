@@ -0,0 +1,287 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// HTTP checks performed against the AEM instances after provisioning. Fails the deployment when any of the checks does not pass.
+type SmokeTest struct {
+	pulumi.CustomResourceState
+
+	// HTTP checks performed against each target AEM instance.
+	Checks SmokeTestCheckArrayOutput `pulumi:"checks"`
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientOutput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrOutput `pulumi:"compose"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayOutput `pulumi:"instance_ids"`
+	// Indicates if all checks passed on all target instances.
+	Passed pulumi.BoolOutput `pulumi:"passed"`
+	// Results of the checks recorded during the last run per instance.
+	Results SmokeTestResultArrayOutput `pulumi:"results"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrOutput `pulumi:"system"`
+	// Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+	Triggers pulumi.StringMapOutput `pulumi:"triggers"`
+}
+
+// NewSmokeTest registers a new resource with the given unique name, arguments, and options.
+func NewSmokeTest(ctx *pulumi.Context,
+	name string, args *SmokeTestArgs, opts ...pulumi.ResourceOption) (*SmokeTest, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Checks == nil {
+		return nil, errors.New("invalid value for required argument 'Checks'")
+	}
+	if args.Client == nil {
+		return nil, errors.New("invalid value for required argument 'Client'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource SmokeTest
+	err := ctx.RegisterResource("aem:compose:SmokeTest", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetSmokeTest gets an existing SmokeTest resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetSmokeTest(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *SmokeTestState, opts ...pulumi.ResourceOption) (*SmokeTest, error) {
+	var resource SmokeTest
+	err := ctx.ReadResource("aem:compose:SmokeTest", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering SmokeTest resources.
+type smokeTestState struct {
+}
+
+type SmokeTestState struct {
+}
+
+func (SmokeTestState) ElementType() reflect.Type {
+	return reflect.TypeOf((*smokeTestState)(nil)).Elem()
+}
+
+type smokeTestArgs struct {
+	// HTTP checks performed against each target AEM instance.
+	Checks []SmokeTestCheck `pulumi:"checks"`
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids []string `pulumi:"instance_ids"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+	// Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+	Triggers map[string]string `pulumi:"triggers"`
+}
+
+// The set of arguments for constructing a SmokeTest resource.
+type SmokeTestArgs struct {
+	// HTTP checks performed against each target AEM instance.
+	Checks SmokeTestCheckArrayInput
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput
+	// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+	Instance_ids pulumi.StringArrayInput
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput
+	// Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+	Triggers pulumi.StringMapInput
+}
+
+func (SmokeTestArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*smokeTestArgs)(nil)).Elem()
+}
+
+type SmokeTestInput interface {
+	pulumi.Input
+
+	ToSmokeTestOutput() SmokeTestOutput
+	ToSmokeTestOutputWithContext(ctx context.Context) SmokeTestOutput
+}
+
+func (*SmokeTest) ElementType() reflect.Type {
+	return reflect.TypeOf((**SmokeTest)(nil)).Elem()
+}
+
+func (i *SmokeTest) ToSmokeTestOutput() SmokeTestOutput {
+	return i.ToSmokeTestOutputWithContext(context.Background())
+}
+
+func (i *SmokeTest) ToSmokeTestOutputWithContext(ctx context.Context) SmokeTestOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestOutput)
+}
+
+// SmokeTestArrayInput is an input type that accepts SmokeTestArray and SmokeTestArrayOutput values.
+// You can construct a concrete instance of `SmokeTestArrayInput` via:
+//
+//	SmokeTestArray{ SmokeTestArgs{...} }
+type SmokeTestArrayInput interface {
+	pulumi.Input
+
+	ToSmokeTestArrayOutput() SmokeTestArrayOutput
+	ToSmokeTestArrayOutputWithContext(context.Context) SmokeTestArrayOutput
+}
+
+type SmokeTestArray []SmokeTestInput
+
+func (SmokeTestArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SmokeTest)(nil)).Elem()
+}
+
+func (i SmokeTestArray) ToSmokeTestArrayOutput() SmokeTestArrayOutput {
+	return i.ToSmokeTestArrayOutputWithContext(context.Background())
+}
+
+func (i SmokeTestArray) ToSmokeTestArrayOutputWithContext(ctx context.Context) SmokeTestArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestArrayOutput)
+}
+
+// SmokeTestMapInput is an input type that accepts SmokeTestMap and SmokeTestMapOutput values.
+// You can construct a concrete instance of `SmokeTestMapInput` via:
+//
+//	SmokeTestMap{ "key": SmokeTestArgs{...} }
+type SmokeTestMapInput interface {
+	pulumi.Input
+
+	ToSmokeTestMapOutput() SmokeTestMapOutput
+	ToSmokeTestMapOutputWithContext(context.Context) SmokeTestMapOutput
+}
+
+type SmokeTestMap map[string]SmokeTestInput
+
+func (SmokeTestMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SmokeTest)(nil)).Elem()
+}
+
+func (i SmokeTestMap) ToSmokeTestMapOutput() SmokeTestMapOutput {
+	return i.ToSmokeTestMapOutputWithContext(context.Background())
+}
+
+func (i SmokeTestMap) ToSmokeTestMapOutputWithContext(ctx context.Context) SmokeTestMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestMapOutput)
+}
+
+type SmokeTestOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SmokeTest)(nil)).Elem()
+}
+
+func (o SmokeTestOutput) ToSmokeTestOutput() SmokeTestOutput {
+	return o
+}
+
+func (o SmokeTestOutput) ToSmokeTestOutputWithContext(ctx context.Context) SmokeTestOutput {
+	return o
+}
+
+// HTTP checks performed against each target AEM instance.
+func (o SmokeTestOutput) Checks() SmokeTestCheckArrayOutput {
+	return o.ApplyT(func(v *SmokeTest) SmokeTestCheckArrayOutput { return v.Checks }).(SmokeTestCheckArrayOutput)
+}
+
+// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o SmokeTestOutput) Client() ClientOutput {
+	return o.ApplyT(func(v *SmokeTest) ClientOutput { return v.Client }).(ClientOutput)
+}
+
+// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+func (o SmokeTestOutput) Compose() ComposePtrOutput {
+	return o.ApplyT(func(v *SmokeTest) ComposePtrOutput { return v.Compose }).(ComposePtrOutput)
+}
+
+// Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+func (o SmokeTestOutput) Instance_ids() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *SmokeTest) pulumi.StringArrayOutput { return v.Instance_ids }).(pulumi.StringArrayOutput)
+}
+
+// Indicates if all checks passed on all target instances.
+func (o SmokeTestOutput) Passed() pulumi.BoolOutput {
+	return o.ApplyT(func(v *SmokeTest) pulumi.BoolOutput { return v.Passed }).(pulumi.BoolOutput)
+}
+
+// Results of the checks recorded during the last run per instance.
+func (o SmokeTestOutput) Results() SmokeTestResultArrayOutput {
+	return o.ApplyT(func(v *SmokeTest) SmokeTestResultArrayOutput { return v.Results }).(SmokeTestResultArrayOutput)
+}
+
+// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+func (o SmokeTestOutput) System() SystemPtrOutput {
+	return o.ApplyT(func(v *SmokeTest) SystemPtrOutput { return v.System }).(SystemPtrOutput)
+}
+
+// Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+func (o SmokeTestOutput) Triggers() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *SmokeTest) pulumi.StringMapOutput { return v.Triggers }).(pulumi.StringMapOutput)
+}
+
+type SmokeTestArrayOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SmokeTest)(nil)).Elem()
+}
+
+func (o SmokeTestArrayOutput) ToSmokeTestArrayOutput() SmokeTestArrayOutput {
+	return o
+}
+
+func (o SmokeTestArrayOutput) ToSmokeTestArrayOutputWithContext(ctx context.Context) SmokeTestArrayOutput {
+	return o
+}
+
+func (o SmokeTestArrayOutput) Index(i pulumi.IntInput) SmokeTestOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *SmokeTest {
+		return vs[0].([]*SmokeTest)[vs[1].(int)]
+	}).(SmokeTestOutput)
+}
+
+type SmokeTestMapOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SmokeTest)(nil)).Elem()
+}
+
+func (o SmokeTestMapOutput) ToSmokeTestMapOutput() SmokeTestMapOutput {
+	return o
+}
+
+func (o SmokeTestMapOutput) ToSmokeTestMapOutputWithContext(ctx context.Context) SmokeTestMapOutput {
+	return o
+}
+
+func (o SmokeTestMapOutput) MapIndex(k pulumi.StringInput) SmokeTestOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *SmokeTest {
+		return vs[0].(map[string]*SmokeTest)[vs[1].(string)]
+	}).(SmokeTestOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestInput)(nil)).Elem(), &SmokeTest{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestArrayInput)(nil)).Elem(), SmokeTestArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestMapInput)(nil)).Elem(), SmokeTestMap{})
+	pulumi.RegisterOutputType(SmokeTestOutput{})
+	pulumi.RegisterOutputType(SmokeTestArrayOutput{})
+	pulumi.RegisterOutputType(SmokeTestMapOutput{})
+}
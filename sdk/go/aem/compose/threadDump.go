@@ -0,0 +1,90 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package compose
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/wttech/pulumi-aem/sdk/go/aem/internal"
+)
+
+// Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+func ThreadDump(ctx *pulumi.Context, args *ThreadDumpArgs, opts ...pulumi.InvokeOption) (*ThreadDumpResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv ThreadDumpResult
+	err := ctx.Invoke("aem:compose:threadDump", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type ThreadDumpArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client Client `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose *Compose `pulumi:"compose"`
+	// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+	Instance_id string `pulumi:"instance_id"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System *System `pulumi:"system"`
+}
+
+type ThreadDumpResult struct {
+	// Thread dump of the AEM instance JVM.
+	Content string `pulumi:"content"`
+}
+
+func ThreadDumpOutput(ctx *pulumi.Context, args ThreadDumpOutputArgs, opts ...pulumi.InvokeOption) ThreadDumpResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (ThreadDumpResult, error) {
+			args := v.(ThreadDumpArgs)
+			r, err := ThreadDump(ctx, &args, opts...)
+			var s ThreadDumpResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(ThreadDumpResultOutput)
+}
+
+type ThreadDumpOutputArgs struct {
+	// Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	Client ClientInput `pulumi:"client"`
+	// AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+	Compose ComposePtrInput `pulumi:"compose"`
+	// Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+	Instance_id pulumi.StringInput `pulumi:"instance_id"`
+	// Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+	System SystemPtrInput `pulumi:"system"`
+}
+
+func (ThreadDumpOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ThreadDumpArgs)(nil)).Elem()
+}
+
+type ThreadDumpResultOutput struct{ *pulumi.OutputState }
+
+func (ThreadDumpResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ThreadDumpResult)(nil)).Elem()
+}
+
+func (o ThreadDumpResultOutput) ToThreadDumpResultOutput() ThreadDumpResultOutput {
+	return o
+}
+
+func (o ThreadDumpResultOutput) ToThreadDumpResultOutputWithContext(ctx context.Context) ThreadDumpResultOutput {
+	return o
+}
+
+// Thread dump of the AEM instance JVM.
+func (o ThreadDumpResultOutput) Content() pulumi.StringOutput {
+	return o.ApplyT(func(v ThreadDumpResult) string { return v.Content }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(ThreadDumpResultOutput{})
+}
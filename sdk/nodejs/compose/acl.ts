@@ -0,0 +1,159 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access control entry of the principal applied to the repository path on the AEM instances. Removed from the instances when the resource is deleted.
+ */
+export class Acl extends pulumi.CustomResource {
+    /**
+     * Get an existing Acl resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Acl {
+        return new Acl(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:Acl';
+
+    /**
+     * Returns true if the given object is an instance of Acl.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Acl {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Acl.__pulumiType;
+    }
+
+    /**
+     * Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+     */
+    public readonly allow!: pulumi.Output<string[] | undefined>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Privileges denied to the principal.
+     */
+    public readonly deny!: pulumi.Output<string[] | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the access control entry on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.AclInstanceModel[]>;
+    /**
+     * Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+     */
+    public readonly path!: pulumi.Output<string>;
+    /**
+     * Identifier of the user or group to which the access control entry applies.
+     */
+    public readonly principal!: pulumi.Output<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+
+    /**
+     * Create a Acl resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AclArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            if ((!args || args.path === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'path'");
+            }
+            if ((!args || args.principal === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'principal'");
+            }
+            resourceInputs["allow"] = args ? args.allow : undefined;
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["deny"] = args ? args.deny : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["path"] = args ? args.path : undefined;
+            resourceInputs["principal"] = args ? args.principal : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+        } else {
+            resourceInputs["allow"] = undefined /*out*/;
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["deny"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["principal"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["path", "principal"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(Acl.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Acl resource.
+ */
+export interface AclArgs {
+    /**
+     * Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+     */
+    allow?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Privileges denied to the principal.
+     */
+    deny?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+     */
+    path: pulumi.Input<string>;
+    /**
+     * Identifier of the user or group to which the access control entry applies.
+     */
+    principal: pulumi.Input<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
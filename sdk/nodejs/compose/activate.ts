@@ -0,0 +1,99 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.
+ */
+export function activate(args: ActivateArgs, opts?: pulumi.InvokeOptions): Promise<ActivateResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("aem:compose:activate", {
+        "agent": args.agent,
+        "client": args.client,
+        "compose": args.compose,
+        "instance_ids": args.instance_ids,
+        "paths": args.paths,
+        "system": args.system,
+        "tree": args.tree,
+    }, opts);
+}
+
+export interface ActivateArgs {
+    /**
+     * Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.
+     */
+    agent?: string;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: inputs.compose.Client;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: inputs.compose.Compose;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: string[];
+    /**
+     * Repository paths of the content to be replicated (e.g. '/content/acme').
+     */
+    paths: string[];
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: inputs.compose.System;
+    /**
+     * Replicate the whole content trees under the paths instead of the single nodes.
+     */
+    tree?: boolean;
+}
+
+export interface ActivateResult {
+    /**
+     * Replication results per instance and path.
+     */
+    readonly results: outputs.compose.ActivateResultItem[];
+}
+/**
+ * Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.
+ */
+export function activateOutput(args: ActivateOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<ActivateResult> {
+    return pulumi.output(args).apply((a: any) => activate(a, opts))
+}
+
+export interface ActivateOutputArgs {
+    /**
+     * Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.
+     */
+    agent?: pulumi.Input<string>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Repository paths of the content to be replicated (e.g. '/content/acme').
+     */
+    paths: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * Replicate the whole content trees under the paths instead of the single nodes.
+     */
+    tree?: pulumi.Input<boolean>;
+}
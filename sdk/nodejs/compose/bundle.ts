@@ -0,0 +1,169 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * OSGi bundle installed on the AEM instances in the desired state (e.g. hotfix). Uninstalled from the instances when the resource is deleted.
+ */
+export class Bundle extends pulumi.CustomResource {
+    /**
+     * Get an existing Bundle resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Bundle {
+        return new Bundle(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:Bundle';
+
+    /**
+     * Returns true if the given object is an instance of Bundle.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Bundle {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Bundle.__pulumiType;
+    }
+
+    /**
+     * SHA-256 checksum of the bundle file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force reinstallation.
+     */
+    public readonly checksum!: pulumi.Output<string | undefined>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Local path of the OSGi bundle JAR file to be installed.
+     */
+    public readonly file!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the bundle on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.BundleInstanceModel[]>;
+    /**
+     * Remote path of the bundle file on the machine.
+     */
+    public /*out*/ readonly remote_path!: pulumi.Output<string>;
+    /**
+     * Desired state of the bundle. Possible values are 'active', 'stopped' and 'uninstalled'.
+     */
+    public readonly state!: pulumi.Output<string | undefined>;
+    /**
+     * Symbolic name of the bundle read from its manifest.
+     */
+    public /*out*/ readonly symbolic_name!: pulumi.Output<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+    /**
+     * URL of the OSGi bundle JAR file to be downloaded on the machine and installed.
+     */
+    public readonly url!: pulumi.Output<string | undefined>;
+    /**
+     * Version of the bundle read from its manifest.
+     */
+    public /*out*/ readonly version!: pulumi.Output<string>;
+
+    /**
+     * Create a Bundle resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: BundleArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["checksum"] = args ? args.checksum : undefined;
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["file"] = args ? args.file : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["state"] = args ? args.state : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["url"] = args ? args.url : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["remote_path"] = undefined /*out*/;
+            resourceInputs["symbolic_name"] = undefined /*out*/;
+            resourceInputs["version"] = undefined /*out*/;
+        } else {
+            resourceInputs["checksum"] = undefined /*out*/;
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["file"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["remote_path"] = undefined /*out*/;
+            resourceInputs["state"] = undefined /*out*/;
+            resourceInputs["symbolic_name"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["version"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(Bundle.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Bundle resource.
+ */
+export interface BundleArgs {
+    /**
+     * SHA-256 checksum of the bundle file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force reinstallation.
+     */
+    checksum?: pulumi.Input<string>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Local path of the OSGi bundle JAR file to be installed.
+     */
+    file?: pulumi.Input<string>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Desired state of the bundle. Possible values are 'active', 'stopped' and 'uninstalled'.
+     */
+    state?: pulumi.Input<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * URL of the OSGi bundle JAR file to be downloaded on the machine and installed.
+     */
+    url?: pulumi.Input<string>;
+}
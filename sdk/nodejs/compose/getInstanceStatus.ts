@@ -0,0 +1,72 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+ */
+export function getInstanceStatus(args: GetInstanceStatusArgs, opts?: pulumi.InvokeOptions): Promise<GetInstanceStatusResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("aem:compose:getInstanceStatus", {
+        "client": args.client,
+        "compose": args.compose,
+        "instance_ids": args.instance_ids,
+        "system": args.system,
+    }, opts);
+}
+
+export interface GetInstanceStatusArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: inputs.compose.Client;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: inputs.compose.Compose;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: string[];
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: inputs.compose.System;
+}
+
+export interface GetInstanceStatusResult {
+    /**
+     * Current state of the AEM instances running on the machine.
+     */
+    readonly instances: outputs.compose.InstanceModel[];
+}
+/**
+ * Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+ */
+export function getInstanceStatusOutput(args: GetInstanceStatusOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetInstanceStatusResult> {
+    return pulumi.output(args).apply((a: any) => getInstanceStatus(a, opts))
+}
+
+export interface GetInstanceStatusOutputArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
@@ -0,0 +1,99 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+ */
+export function getLogs(args: GetLogsArgs, opts?: pulumi.InvokeOptions): Promise<GetLogsResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("aem:compose:getLogs", {
+        "client": args.client,
+        "compose": args.compose,
+        "file": args.file,
+        "filter": args.filter,
+        "instance_id": args.instance_id,
+        "lines": args.lines,
+        "system": args.system,
+    }, opts);
+}
+
+export interface GetLogsArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: inputs.compose.Client;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: inputs.compose.Compose;
+    /**
+     * Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+     */
+    file?: string;
+    /**
+     * Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+     */
+    filter?: string;
+    /**
+     * Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+     */
+    instance_id: string;
+    /**
+     * Number of the last lines to be returned. By default, 100 lines are returned.
+     */
+    lines?: number;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: inputs.compose.System;
+}
+
+export interface GetLogsResult {
+    /**
+     * Last lines of the log file.
+     */
+    readonly content: string;
+}
+/**
+ * Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+ */
+export function getLogsOutput(args: GetLogsOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetLogsResult> {
+    return pulumi.output(args).apply((a: any) => getLogs(a, opts))
+}
+
+export interface GetLogsOutputArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+     */
+    file?: pulumi.Input<string>;
+    /**
+     * Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+     */
+    filter?: pulumi.Input<string>;
+    /**
+     * Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+     */
+    instance_id: pulumi.Input<string>;
+    /**
+     * Number of the last lines to be returned. By default, 100 lines are returned.
+     */
+    lines?: pulumi.Input<number>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
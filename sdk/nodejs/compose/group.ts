@@ -0,0 +1,163 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Group created on the AEM instances along with its members. Deleted from the instances when the resource is deleted.
+ */
+export class Group extends pulumi.CustomResource {
+    /**
+     * Get an existing Group resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Group {
+        return new Group(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:Group';
+
+    /**
+     * Returns true if the given object is an instance of Group.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Group {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Group.__pulumiType;
+    }
+
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Description stored in the group profile.
+     */
+    public readonly description!: pulumi.Output<string | undefined>;
+    /**
+     * Display name stored in the group profile.
+     */
+    public readonly display_name!: pulumi.Output<string | undefined>;
+    /**
+     * Identifier of the group. By default, the resource name is used.
+     */
+    public readonly group_id!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the group on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.GroupInstanceModel[]>;
+    /**
+     * Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+     */
+    public readonly intermediate_path!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+     */
+    public readonly members!: pulumi.Output<string[] | undefined>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+
+    /**
+     * Create a Group resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: GroupArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["description"] = args ? args.description : undefined;
+            resourceInputs["display_name"] = args ? args.display_name : undefined;
+            resourceInputs["group_id"] = args ? args.group_id : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["intermediate_path"] = args ? args.intermediate_path : undefined;
+            resourceInputs["members"] = args ? args.members : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+        } else {
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["description"] = undefined /*out*/;
+            resourceInputs["display_name"] = undefined /*out*/;
+            resourceInputs["group_id"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["intermediate_path"] = undefined /*out*/;
+            resourceInputs["members"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["group_id", "intermediate_path"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(Group.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Group resource.
+ */
+export interface GroupArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Description stored in the group profile.
+     */
+    description?: pulumi.Input<string>;
+    /**
+     * Display name stored in the group profile.
+     */
+    display_name?: pulumi.Input<string>;
+    /**
+     * Identifier of the group. By default, the resource name is used.
+     */
+    group_id?: pulumi.Input<string>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+     */
+    intermediate_path?: pulumi.Input<string>;
+    /**
+     * Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+     */
+    members?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
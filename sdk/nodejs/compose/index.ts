@@ -5,18 +5,120 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "../utilities";
 
 // Export members:
+export { AclArgs } from "./acl";
+export type Acl = import("./acl").Acl;
+export const Acl: typeof import("./acl").Acl = null as any;
+utilities.lazyLoad(exports, ["Acl"], () => require("./acl"));
+
+export { ActivateArgs, ActivateResult, ActivateOutputArgs } from "./activate";
+export const activate: typeof import("./activate").activate = null as any;
+export const activateOutput: typeof import("./activate").activateOutput = null as any;
+utilities.lazyLoad(exports, ["activate","activateOutput"], () => require("./activate"));
+
+export { BundleArgs } from "./bundle";
+export type Bundle = import("./bundle").Bundle;
+export const Bundle: typeof import("./bundle").Bundle = null as any;
+utilities.lazyLoad(exports, ["Bundle"], () => require("./bundle"));
+
+export { GetInstanceStatusArgs, GetInstanceStatusResult, GetInstanceStatusOutputArgs } from "./getInstanceStatus";
+export const getInstanceStatus: typeof import("./getInstanceStatus").getInstanceStatus = null as any;
+export const getInstanceStatusOutput: typeof import("./getInstanceStatus").getInstanceStatusOutput = null as any;
+utilities.lazyLoad(exports, ["getInstanceStatus","getInstanceStatusOutput"], () => require("./getInstanceStatus"));
+
+export { GetLogsArgs, GetLogsResult, GetLogsOutputArgs } from "./getLogs";
+export const getLogs: typeof import("./getLogs").getLogs = null as any;
+export const getLogsOutput: typeof import("./getLogs").getLogsOutput = null as any;
+utilities.lazyLoad(exports, ["getLogs","getLogsOutput"], () => require("./getLogs"));
+
+export { GroupArgs } from "./group";
+export type Group = import("./group").Group;
+export const Group: typeof import("./group").Group = null as any;
+utilities.lazyLoad(exports, ["Group"], () => require("./group"));
+
 export { InstanceArgs } from "./instance";
 export type Instance = import("./instance").Instance;
 export const Instance: typeof import("./instance").Instance = null as any;
 utilities.lazyLoad(exports, ["Instance"], () => require("./instance"));
 
+export { OakIndexArgs } from "./oakIndex";
+export type OakIndex = import("./oakIndex").OakIndex;
+export const OakIndex: typeof import("./oakIndex").OakIndex = null as any;
+utilities.lazyLoad(exports, ["OakIndex"], () => require("./oakIndex"));
+
+export { OsgiConfigArgs } from "./osgiConfig";
+export type OsgiConfig = import("./osgiConfig").OsgiConfig;
+export const OsgiConfig: typeof import("./osgiConfig").OsgiConfig = null as any;
+utilities.lazyLoad(exports, ["OsgiConfig"], () => require("./osgiConfig"));
+
+export { PackageArgs } from "./package";
+export type Package = import("./package").Package;
+export const Package: typeof import("./package").Package = null as any;
+utilities.lazyLoad(exports, ["Package"], () => require("./package"));
+
+export { ReplicationAgentArgs } from "./replicationAgent";
+export type ReplicationAgent = import("./replicationAgent").ReplicationAgent;
+export const ReplicationAgent: typeof import("./replicationAgent").ReplicationAgent = null as any;
+utilities.lazyLoad(exports, ["ReplicationAgent"], () => require("./replicationAgent"));
+
+export { RepoNodeArgs } from "./repoNode";
+export type RepoNode = import("./repoNode").RepoNode;
+export const RepoNode: typeof import("./repoNode").RepoNode = null as any;
+utilities.lazyLoad(exports, ["RepoNode"], () => require("./repoNode"));
+
+export { RunArgs, RunResult, RunOutputArgs } from "./run";
+export const run: typeof import("./run").run = null as any;
+export const runOutput: typeof import("./run").runOutput = null as any;
+utilities.lazyLoad(exports, ["run","runOutput"], () => require("./run"));
+
+export { ScriptArgs } from "./script";
+export type Script = import("./script").Script;
+export const Script: typeof import("./script").Script = null as any;
+utilities.lazyLoad(exports, ["Script"], () => require("./script"));
+
+export { SmokeTestArgs } from "./smokeTest";
+export type SmokeTest = import("./smokeTest").SmokeTest;
+export const SmokeTest: typeof import("./smokeTest").SmokeTest = null as any;
+utilities.lazyLoad(exports, ["SmokeTest"], () => require("./smokeTest"));
+
+export { ThreadDumpArgs, ThreadDumpResult, ThreadDumpOutputArgs } from "./threadDump";
+export const threadDump: typeof import("./threadDump").threadDump = null as any;
+export const threadDumpOutput: typeof import("./threadDump").threadDumpOutput = null as any;
+utilities.lazyLoad(exports, ["threadDump","threadDumpOutput"], () => require("./threadDump"));
+
+export { UserArgs } from "./user";
+export type User = import("./user").User;
+export const User: typeof import("./user").User = null as any;
+utilities.lazyLoad(exports, ["User"], () => require("./user"));
+
 
 const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "aem:compose:Acl":
+                return new Acl(name, <any>undefined, { urn })
+            case "aem:compose:Bundle":
+                return new Bundle(name, <any>undefined, { urn })
+            case "aem:compose:Group":
+                return new Group(name, <any>undefined, { urn })
             case "aem:compose:Instance":
                 return new Instance(name, <any>undefined, { urn })
+            case "aem:compose:OakIndex":
+                return new OakIndex(name, <any>undefined, { urn })
+            case "aem:compose:OsgiConfig":
+                return new OsgiConfig(name, <any>undefined, { urn })
+            case "aem:compose:Package":
+                return new Package(name, <any>undefined, { urn })
+            case "aem:compose:ReplicationAgent":
+                return new ReplicationAgent(name, <any>undefined, { urn })
+            case "aem:compose:RepoNode":
+                return new RepoNode(name, <any>undefined, { urn })
+            case "aem:compose:Script":
+                return new Script(name, <any>undefined, { urn })
+            case "aem:compose:SmokeTest":
+                return new SmokeTest(name, <any>undefined, { urn })
+            case "aem:compose:User":
+                return new User(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -33,6 +33,10 @@ export class Instance extends pulumi.CustomResource {
         return obj['__pulumiType'] === Instance.__pulumiType;
     }
 
+    /**
+     * Backups of the AEM instance files kept in the target location.
+     */
+    public /*out*/ readonly backups!: pulumi.Output<outputs.compose.BackupModel[]>;
     /**
      * Connection settings used to access the machine on which the AEM instance will be running.
      */
@@ -41,6 +45,14 @@ export class Instance extends pulumi.CustomResource {
      * AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
      */
     public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+     */
+    public readonly diagnostics!: pulumi.Output<outputs.compose.Diagnostics | undefined>;
+    /**
+     * Contents of the AEM Compose YML configuration file actually written to the machine (after applying overrides).
+     */
+    public /*out*/ readonly effective_config!: pulumi.Output<string>;
     /**
      * Files or directories to be copied into the machine.
      */
@@ -49,6 +61,10 @@ export class Instance extends pulumi.CustomResource {
      * Current state of the configured AEM instances.
      */
     public /*out*/ readonly instances!: pulumi.Output<outputs.compose.InstanceModel[]>;
+    /**
+     * Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+     */
+    public readonly preflight!: pulumi.Output<outputs.compose.Preflight | undefined>;
     /**
      * Operating system configuration for the machine on which AEM instance will be running.
      */
@@ -70,17 +86,27 @@ export class Instance extends pulumi.CustomResource {
             }
             resourceInputs["client"] = args ? args.client : undefined;
             resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["diagnostics"] = args ? args.diagnostics : undefined;
             resourceInputs["files"] = args ? args.files : undefined;
+            resourceInputs["preflight"] = args ? args.preflight : undefined;
             resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["backups"] = undefined /*out*/;
+            resourceInputs["effective_config"] = undefined /*out*/;
             resourceInputs["instances"] = undefined /*out*/;
         } else {
+            resourceInputs["backups"] = undefined /*out*/;
             resourceInputs["client"] = undefined /*out*/;
             resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["diagnostics"] = undefined /*out*/;
+            resourceInputs["effective_config"] = undefined /*out*/;
             resourceInputs["files"] = undefined /*out*/;
             resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["preflight"] = undefined /*out*/;
             resourceInputs["system"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["effective_config"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Instance.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -97,10 +123,18 @@ export interface InstanceArgs {
      * AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
      */
     compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+     */
+    diagnostics?: pulumi.Input<inputs.compose.DiagnosticsArgs>;
     /**
      * Files or directories to be copied into the machine.
      */
     files?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+     */
+    preflight?: pulumi.Input<inputs.compose.PreflightArgs>;
     /**
      * Operating system configuration for the machine on which AEM instance will be running.
      */
@@ -0,0 +1,189 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Oak index definition saved on the AEM instances. Reindexing is triggered when the definition changes and awaited before the operation completes. Removed from the instances when the resource is deleted.
+ */
+export class OakIndex extends pulumi.CustomResource {
+    /**
+     * Get an existing OakIndex resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): OakIndex {
+        return new OakIndex(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:OakIndex';
+
+    /**
+     * Returns true if the given object is an instance of OakIndex.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is OakIndex {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === OakIndex.__pulumiType;
+    }
+
+    /**
+     * Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+     */
+    public readonly async!: pulumi.Output<string[] | undefined>;
+    /**
+     * Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+     */
+    public readonly children!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Repository paths covered by the index.
+     */
+    public readonly included_paths!: pulumi.Output<string[] | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the index on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.OakIndexInstanceModel[]>;
+    /**
+     * Name of the index definition node under '/oak:index'. By default, the resource name is used.
+     */
+    public readonly name!: pulumi.Output<string | undefined>;
+    /**
+     * Repository path of the index definition.
+     */
+    public /*out*/ readonly path!: pulumi.Output<string>;
+    /**
+     * Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+     */
+    public readonly properties!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * Maximum time to wait for reindexing to complete after the definition is changed.
+     */
+    public readonly reindex_timeout!: pulumi.Output<string | undefined>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+    /**
+     * Type of the index. Possible values are 'lucene' and 'property'.
+     */
+    public readonly type!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a OakIndex resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: OakIndexArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["async"] = args ? args.async : undefined;
+            resourceInputs["children"] = args ? args.children : undefined;
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["included_paths"] = args ? args.included_paths : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["properties"] = args ? args.properties : undefined;
+            resourceInputs["reindex_timeout"] = args ? args.reindex_timeout : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["type"] = args ? args.type : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+        } else {
+            resourceInputs["async"] = undefined /*out*/;
+            resourceInputs["children"] = undefined /*out*/;
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["included_paths"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["properties"] = undefined /*out*/;
+            resourceInputs["reindex_timeout"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["type"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["name"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(OakIndex.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a OakIndex resource.
+ */
+export interface OakIndexArgs {
+    /**
+     * Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+     */
+    async?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+     */
+    children?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Repository paths covered by the index.
+     */
+    included_paths?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Name of the index definition node under '/oak:index'. By default, the resource name is used.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+     */
+    properties?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Maximum time to wait for reindexing to complete after the definition is changed.
+     */
+    reindex_timeout?: pulumi.Input<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * Type of the index. Possible values are 'lucene' and 'property'.
+     */
+    type?: pulumi.Input<string>;
+}
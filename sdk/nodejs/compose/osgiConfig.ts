@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * OSGi configuration saved on the AEM instances. Deleted from the instances when the resource is deleted.
+ */
+export class OsgiConfig extends pulumi.CustomResource {
+    /**
+     * Get an existing OsgiConfig resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): OsgiConfig {
+        return new OsgiConfig(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:OsgiConfig';
+
+    /**
+     * Returns true if the given object is an instance of OsgiConfig.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is OsgiConfig {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === OsgiConfig.__pulumiType;
+    }
+
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Persistent identifier under which the OSGi configuration is saved (for factory configurations in format 'factory_pid~name').
+     */
+    public /*out*/ readonly effective_pid!: pulumi.Output<string>;
+    /**
+     * Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+     */
+    public readonly factory_pid!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the OSGi configuration on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.OsgiConfigInstanceModel[]>;
+    /**
+     * Name of the factory OSGi configuration instance. By default, the resource name is used.
+     */
+    public readonly name!: pulumi.Output<string | undefined>;
+    /**
+     * Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+     */
+    public readonly pid!: pulumi.Output<string | undefined>;
+    /**
+     * Properties of the OSGi configuration.
+     */
+    public readonly properties!: pulumi.Output<{[key: string]: any}>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+
+    /**
+     * Create a OsgiConfig resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: OsgiConfigArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            if ((!args || args.properties === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'properties'");
+            }
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["factory_pid"] = args ? args.factory_pid : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["pid"] = args ? args.pid : undefined;
+            resourceInputs["properties"] = args ? args.properties : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["effective_pid"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+        } else {
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["effective_pid"] = undefined /*out*/;
+            resourceInputs["factory_pid"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["pid"] = undefined /*out*/;
+            resourceInputs["properties"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["factory_pid", "name", "pid"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(OsgiConfig.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a OsgiConfig resource.
+ */
+export interface OsgiConfigArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+     */
+    factory_pid?: pulumi.Input<string>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Name of the factory OSGi configuration instance. By default, the resource name is used.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+     */
+    pid?: pulumi.Input<string>;
+    /**
+     * Properties of the OSGi configuration.
+     */
+    properties: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
@@ -0,0 +1,173 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Content package deployed to the AEM instances. Redeployed when the package file changes and uninstalled when the resource is deleted.
+ */
+export class Package extends pulumi.CustomResource {
+    /**
+     * Get an existing Package resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Package {
+        return new Package(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:Package';
+
+    /**
+     * Returns true if the given object is an instance of Package.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Package {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Package.__pulumiType;
+    }
+
+    /**
+     * SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+     */
+    public readonly checksum!: pulumi.Output<string | undefined>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Local path of the AEM package file to be deployed.
+     */
+    public readonly file!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the AEM package on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.PackageInstanceModel[]>;
+    /**
+     * Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+     */
+    public readonly maven!: pulumi.Output<string | undefined>;
+    /**
+     * URL of the Maven repository from which the AEM package is downloaded.
+     */
+    public readonly maven_repository!: pulumi.Output<string | undefined>;
+    /**
+     * Identifier of the AEM package (in format 'group:name:version').
+     */
+    public /*out*/ readonly pid!: pulumi.Output<string>;
+    /**
+     * Remote path of the AEM package file on the machine.
+     */
+    public /*out*/ readonly remote_path!: pulumi.Output<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+    /**
+     * URL of the AEM package file to be downloaded on the machine and deployed.
+     */
+    public readonly url!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a Package resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: PackageArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["checksum"] = args ? args.checksum : undefined;
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["file"] = args ? args.file : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["maven"] = args ? args.maven : undefined;
+            resourceInputs["maven_repository"] = args ? args.maven_repository : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["url"] = args ? args.url : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["pid"] = undefined /*out*/;
+            resourceInputs["remote_path"] = undefined /*out*/;
+        } else {
+            resourceInputs["checksum"] = undefined /*out*/;
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["file"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["maven"] = undefined /*out*/;
+            resourceInputs["maven_repository"] = undefined /*out*/;
+            resourceInputs["pid"] = undefined /*out*/;
+            resourceInputs["remote_path"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["url"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(Package.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Package resource.
+ */
+export interface PackageArgs {
+    /**
+     * SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+     */
+    checksum?: pulumi.Input<string>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Local path of the AEM package file to be deployed.
+     */
+    file?: pulumi.Input<string>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+     */
+    maven?: pulumi.Input<string>;
+    /**
+     * URL of the Maven repository from which the AEM package is downloaded.
+     */
+    maven_repository?: pulumi.Input<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * URL of the AEM package file to be downloaded on the machine and deployed.
+     */
+    url?: pulumi.Input<string>;
+}
@@ -0,0 +1,214 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Replication agent set up on the AEM instances. Deleted from the instances when the resource is deleted.
+ */
+export class ReplicationAgent extends pulumi.CustomResource {
+    /**
+     * Get an existing ReplicationAgent resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ReplicationAgent {
+        return new ReplicationAgent(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:ReplicationAgent';
+
+    /**
+     * Returns true if the given object is an instance of ReplicationAgent.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ReplicationAgent {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ReplicationAgent.__pulumiType;
+    }
+
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Toggle the replication agent.
+     */
+    public readonly enabled!: pulumi.Output<boolean | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the replication agent on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.ReplicationAgentInstanceModel[]>;
+    /**
+     * Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+     */
+    public readonly location!: pulumi.Output<string | undefined>;
+    /**
+     * Name of the replication agent. By default, the resource name is used.
+     */
+    public readonly name!: pulumi.Output<string | undefined>;
+    /**
+     * Repository path of the replication agent.
+     */
+    public /*out*/ readonly path!: pulumi.Output<string>;
+    /**
+     * Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+     */
+    public readonly properties!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+    /**
+     * Password used to authenticate on the replication target.
+     */
+    public readonly transport_password!: pulumi.Output<string | undefined>;
+    /**
+     * URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+     */
+    public readonly transport_uri!: pulumi.Output<string>;
+    /**
+     * User used to authenticate on the replication target.
+     */
+    public readonly transport_user!: pulumi.Output<string | undefined>;
+    /**
+     * Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+     */
+    public readonly type!: pulumi.Output<string | undefined>;
+    /**
+     * User whose permissions are used when replicating content. By default, the system user is used.
+     */
+    public readonly user_id!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a ReplicationAgent resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ReplicationAgentArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            if ((!args || args.transport_uri === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'transport_uri'");
+            }
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["enabled"] = args ? args.enabled : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["location"] = args ? args.location : undefined;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["properties"] = args ? args.properties : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["transport_password"] = args?.transport_password ? pulumi.secret(args.transport_password) : undefined;
+            resourceInputs["transport_uri"] = args ? args.transport_uri : undefined;
+            resourceInputs["transport_user"] = args?.transport_user ? pulumi.secret(args.transport_user) : undefined;
+            resourceInputs["type"] = args ? args.type : undefined;
+            resourceInputs["user_id"] = args ? args.user_id : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+        } else {
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["enabled"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["location"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["properties"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["transport_password"] = undefined /*out*/;
+            resourceInputs["transport_uri"] = undefined /*out*/;
+            resourceInputs["transport_user"] = undefined /*out*/;
+            resourceInputs["type"] = undefined /*out*/;
+            resourceInputs["user_id"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["transport_password", "transport_user"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        const replaceOnChanges = { replaceOnChanges: ["location", "name"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(ReplicationAgent.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ReplicationAgent resource.
+ */
+export interface ReplicationAgentArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Toggle the replication agent.
+     */
+    enabled?: pulumi.Input<boolean>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+     */
+    location?: pulumi.Input<string>;
+    /**
+     * Name of the replication agent. By default, the resource name is used.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+     */
+    properties?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * Password used to authenticate on the replication target.
+     */
+    transport_password?: pulumi.Input<string>;
+    /**
+     * URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+     */
+    transport_uri: pulumi.Input<string>;
+    /**
+     * User used to authenticate on the replication target.
+     */
+    transport_user?: pulumi.Input<string>;
+    /**
+     * Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+     */
+    type?: pulumi.Input<string>;
+    /**
+     * User whose permissions are used when replicating content. By default, the system user is used.
+     */
+    user_id?: pulumi.Input<string>;
+}
@@ -0,0 +1,159 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Repository node with properties and child nodes saved on the AEM instances (e.g. cloud service or context-aware configuration). Removed from the instances when the resource is deleted (in merge mode, only the managed properties and child nodes are removed).
+ */
+export class RepoNode extends pulumi.CustomResource {
+    /**
+     * Get an existing RepoNode resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): RepoNode {
+        return new RepoNode(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:RepoNode';
+
+    /**
+     * Returns true if the given object is an instance of RepoNode.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is RepoNode {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === RepoNode.__pulumiType;
+    }
+
+    /**
+     * Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+     */
+    public readonly children!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the repository node on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.RepoNodeInstanceModel[]>;
+    /**
+     * Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+     */
+    public readonly mode!: pulumi.Output<string | undefined>;
+    /**
+     * Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+     */
+    public readonly path!: pulumi.Output<string>;
+    /**
+     * Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+     */
+    public readonly properties!: pulumi.Output<{[key: string]: any}>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+
+    /**
+     * Create a RepoNode resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: RepoNodeArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            if ((!args || args.path === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'path'");
+            }
+            if ((!args || args.properties === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'properties'");
+            }
+            resourceInputs["children"] = args ? args.children : undefined;
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["mode"] = args ? args.mode : undefined;
+            resourceInputs["path"] = args ? args.path : undefined;
+            resourceInputs["properties"] = args ? args.properties : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+        } else {
+            resourceInputs["children"] = undefined /*out*/;
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["mode"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["properties"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const replaceOnChanges = { replaceOnChanges: ["path"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(RepoNode.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a RepoNode resource.
+ */
+export interface RepoNodeArgs {
+    /**
+     * Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+     */
+    children?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+     */
+    mode?: pulumi.Input<string>;
+    /**
+     * Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+     */
+    path: pulumi.Input<string>;
+    /**
+     * Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+     */
+    properties: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
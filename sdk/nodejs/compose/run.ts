@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).
+ */
+export function run(args: RunArgs, opts?: pulumi.InvokeOptions): Promise<RunResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("aem:compose:run", {
+        "client": args.client,
+        "command": args.command,
+        "dir": args.dir,
+        "script": args.script,
+        "sudo": args.sudo,
+        "system": args.system,
+        "timeout": args.timeout,
+    }, opts);
+}
+
+export interface RunArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: inputs.compose.Client;
+    /**
+     * Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.
+     */
+    command?: string;
+    /**
+     * Remote directory in which the command is executed. By default, the data directory is used.
+     */
+    dir?: string;
+    /**
+     * Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.
+     */
+    script?: inputs.compose.InstanceScript;
+    /**
+     * Execute the command as a superuser.
+     */
+    sudo?: boolean;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: inputs.compose.System;
+    /**
+     * Maximum time of the command execution. By default, the client action timeout is used.
+     */
+    timeout?: string;
+}
+
+export interface RunResult {
+    /**
+     * Exit code of the command. Equals 124 when the command timed out.
+     */
+    readonly exit_code: number;
+    /**
+     * Standard error of the command.
+     */
+    readonly stderr: string;
+    /**
+     * Standard output of the command.
+     */
+    readonly stdout: string;
+}
+/**
+ * Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).
+ */
+export function runOutput(args: RunOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<RunResult> {
+    return pulumi.output(args).apply((a: any) => run(a, opts))
+}
+
+export interface RunOutputArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.
+     */
+    command?: pulumi.Input<string>;
+    /**
+     * Remote directory in which the command is executed. By default, the data directory is used.
+     */
+    dir?: pulumi.Input<string>;
+    /**
+     * Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.
+     */
+    script?: pulumi.Input<inputs.compose.InstanceScriptArgs>;
+    /**
+     * Execute the command as a superuser.
+     */
+    sudo?: pulumi.Input<boolean>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * Maximum time of the command execution. By default, the client action timeout is used.
+     */
+    timeout?: pulumi.Input<string>;
+}
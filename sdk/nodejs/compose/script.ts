@@ -0,0 +1,151 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Groovy script or AEM Compose CLI command executed on the AEM instances once per trigger values (e.g. content migration). Deleting the resource does not revert the effects of the script.
+ */
+export class Script extends pulumi.CustomResource {
+    /**
+     * Get an existing Script resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Script {
+        return new Script(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:Script';
+
+    /**
+     * Returns true if the given object is an instance of Script.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Script {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Script.__pulumiType;
+    }
+
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+     */
+    public readonly command!: pulumi.Output<string[] | undefined>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Data passed to the Groovy script (available as 'data' variable).
+     */
+    public readonly data!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+     */
+    public readonly groovy!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Outputs recorded during the last execution of the script on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.ScriptInstanceModel[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+    /**
+     * Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: string} | undefined>;
+
+    /**
+     * Create a Script resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ScriptArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["command"] = args ? args.command : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["data"] = args ? args.data : undefined;
+            resourceInputs["groovy"] = args ? args.groovy : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+        } else {
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["command"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["data"] = undefined /*out*/;
+            resourceInputs["groovy"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(Script.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Script resource.
+ */
+export interface ScriptArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+     */
+    command?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Data passed to the Groovy script (available as 'data' variable).
+     */
+    data?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+     */
+    groovy?: pulumi.Input<string>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+     */
+    triggers?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
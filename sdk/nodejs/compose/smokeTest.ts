@@ -0,0 +1,140 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * HTTP checks performed against the AEM instances after provisioning. Fails the deployment when any of the checks does not pass.
+ */
+export class SmokeTest extends pulumi.CustomResource {
+    /**
+     * Get an existing SmokeTest resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SmokeTest {
+        return new SmokeTest(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:SmokeTest';
+
+    /**
+     * Returns true if the given object is an instance of SmokeTest.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SmokeTest {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SmokeTest.__pulumiType;
+    }
+
+    /**
+     * HTTP checks performed against each target AEM instance.
+     */
+    public readonly checks!: pulumi.Output<outputs.compose.SmokeTestCheck[]>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Indicates if all checks passed on all target instances.
+     */
+    public /*out*/ readonly passed!: pulumi.Output<boolean>;
+    /**
+     * Results of the checks recorded during the last run per instance.
+     */
+    public /*out*/ readonly results!: pulumi.Output<outputs.compose.SmokeTestResult[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<outputs.compose.System | undefined>;
+    /**
+     * Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: string} | undefined>;
+
+    /**
+     * Create a SmokeTest resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SmokeTestArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.checks === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'checks'");
+            }
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["checks"] = args ? args.checks : undefined;
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["passed"] = undefined /*out*/;
+            resourceInputs["results"] = undefined /*out*/;
+        } else {
+            resourceInputs["checks"] = undefined /*out*/;
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["passed"] = undefined /*out*/;
+            resourceInputs["results"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(SmokeTest.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SmokeTest resource.
+ */
+export interface SmokeTestArgs {
+    /**
+     * HTTP checks performed against each target AEM instance.
+     */
+    checks: pulumi.Input<pulumi.Input<inputs.compose.SmokeTestCheckArgs>[]>;
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+    /**
+     * Arbitrary values which cause the checks to be performed again when changed (e.g. outputs of the 'Instance' resource). Checks are also performed on any other change of the inputs.
+     */
+    triggers?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
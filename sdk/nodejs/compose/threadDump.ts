@@ -0,0 +1,72 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+ */
+export function threadDump(args: ThreadDumpArgs, opts?: pulumi.InvokeOptions): Promise<ThreadDumpResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("aem:compose:threadDump", {
+        "client": args.client,
+        "compose": args.compose,
+        "instance_id": args.instance_id,
+        "system": args.system,
+    }, opts);
+}
+
+export interface ThreadDumpArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: inputs.compose.Client;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: inputs.compose.Compose;
+    /**
+     * Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+     */
+    instance_id: string;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: inputs.compose.System;
+}
+
+export interface ThreadDumpResult {
+    /**
+     * Thread dump of the AEM instance JVM.
+     */
+    readonly content: string;
+}
+/**
+ * Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+ */
+export function threadDumpOutput(args: ThreadDumpOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<ThreadDumpResult> {
+    return pulumi.output(args).apply((a: any) => threadDump(a, opts))
+}
+
+export interface ThreadDumpOutputArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+     */
+    instance_id: pulumi.Input<string>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    system?: pulumi.Input<inputs.compose.SystemArgs>;
+}
@@ -0,0 +1,185 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * User created on the AEM instances along with its group membership. Deleted from the instances when the resource is deleted.
+ */
+export class User extends pulumi.CustomResource {
+    /**
+     * Get an existing User resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): User {
+        return new User(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'aem:compose:User';
+
+    /**
+     * Returns true if the given object is an instance of User.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is User {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === User.__pulumiType;
+    }
+
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly client!: pulumi.Output<outputs.compose.Client>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly compose!: pulumi.Output<outputs.compose.Compose | undefined>;
+    /**
+     * Email address stored in the user profile.
+     */
+    public readonly email!: pulumi.Output<string | undefined>;
+    /**
+     * Family name stored in the user profile.
+     */
+    public readonly family_name!: pulumi.Output<string | undefined>;
+    /**
+     * Given name stored in the user profile.
+     */
+    public readonly given_name!: pulumi.Output<string | undefined>;
+    /**
+     * Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+     */
+    public readonly groups!: pulumi.Output<string[] | undefined>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    public readonly instance_ids!: pulumi.Output<string[] | undefined>;
+    /**
+     * Current state of the user on the target AEM instances.
+     */
+    public /*out*/ readonly instances!: pulumi.Output<outputs.compose.UserInstanceModel[]>;
+    /**
+     * Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+     */
+    public readonly intermediate_path!: pulumi.Output<string | undefined>;
+    /**
+     * Password of the user. When empty, the user is not able to log in with a password.
+     */
+    public readonly password!: pulumi.Output<string | undefined>;
+    /**
+     * Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    public readonly system!: pulumi.Output<boolean | undefined>;
+    /**
+     * Identifier of the user. By default, the resource name is used.
+     */
+    public readonly user_id!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a User resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: UserArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.client === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'client'");
+            }
+            resourceInputs["client"] = args ? args.client : undefined;
+            resourceInputs["compose"] = args ? args.compose : undefined;
+            resourceInputs["email"] = args ? args.email : undefined;
+            resourceInputs["family_name"] = args ? args.family_name : undefined;
+            resourceInputs["given_name"] = args ? args.given_name : undefined;
+            resourceInputs["groups"] = args ? args.groups : undefined;
+            resourceInputs["instance_ids"] = args ? args.instance_ids : undefined;
+            resourceInputs["intermediate_path"] = args ? args.intermediate_path : undefined;
+            resourceInputs["password"] = args?.password ? pulumi.secret(args.password) : undefined;
+            resourceInputs["system"] = args ? args.system : undefined;
+            resourceInputs["user_id"] = args ? args.user_id : undefined;
+            resourceInputs["instances"] = undefined /*out*/;
+        } else {
+            resourceInputs["client"] = undefined /*out*/;
+            resourceInputs["compose"] = undefined /*out*/;
+            resourceInputs["email"] = undefined /*out*/;
+            resourceInputs["family_name"] = undefined /*out*/;
+            resourceInputs["given_name"] = undefined /*out*/;
+            resourceInputs["groups"] = undefined /*out*/;
+            resourceInputs["instance_ids"] = undefined /*out*/;
+            resourceInputs["instances"] = undefined /*out*/;
+            resourceInputs["intermediate_path"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
+            resourceInputs["system"] = undefined /*out*/;
+            resourceInputs["user_id"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["password"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        const replaceOnChanges = { replaceOnChanges: ["intermediate_path", "system", "user_id"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(User.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a User resource.
+ */
+export interface UserArgs {
+    /**
+     * Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+     */
+    client: pulumi.Input<inputs.compose.ClientArgs>;
+    /**
+     * AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+     */
+    compose?: pulumi.Input<inputs.compose.ComposeArgs>;
+    /**
+     * Email address stored in the user profile.
+     */
+    email?: pulumi.Input<string>;
+    /**
+     * Family name stored in the user profile.
+     */
+    family_name?: pulumi.Input<string>;
+    /**
+     * Given name stored in the user profile.
+     */
+    given_name?: pulumi.Input<string>;
+    /**
+     * Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+     */
+    groups?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+     */
+    instance_ids?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+     */
+    intermediate_path?: pulumi.Input<string>;
+    /**
+     * Password of the user. When empty, the user is not able to log in with a password.
+     */
+    password?: pulumi.Input<string>;
+    /**
+     * Creates the system (service) user, which has no password and is used by the code through service user mappings.
+     */
+    system?: pulumi.Input<boolean>;
+    /**
+     * Identifier of the user. By default, the resource name is used.
+     */
+    user_id?: pulumi.Input<string>;
+}
@@ -6,6 +6,79 @@ import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
 export namespace compose {
+    export interface Backup {
+        /**
+         * Toggle making a backup before deleting the instance.
+         */
+        before_delete?: boolean;
+        /**
+         * Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+         */
+        before_update?: boolean;
+        /**
+         * Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+         */
+        compression?: string;
+        /**
+         * Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+         */
+        retention?: number;
+        /**
+         * Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+         */
+        target: string;
+    }
+
+    export interface BackupArgs {
+        /**
+         * Toggle making a backup before deleting the instance.
+         */
+        before_delete?: pulumi.Input<boolean>;
+        /**
+         * Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+         */
+        before_update?: pulumi.Input<boolean>;
+        /**
+         * Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+         */
+        compression?: pulumi.Input<string>;
+        /**
+         * Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+         */
+        retention?: pulumi.Input<number>;
+        /**
+         * Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+         */
+        target: pulumi.Input<string>;
+    }
+
+    export interface Client {
+        /**
+         * Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+         */
+        action_timeout?: string;
+        /**
+         * Credentials for the connection type
+         */
+        credentials?: {[key: string]: string};
+        /**
+         * Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+         */
+        port_forward?: boolean;
+        /**
+         * Settings for the connection type
+         */
+        settings: {[key: string]: string};
+        /**
+         * Used when reading the AEM instance state when determining the plan.
+         */
+        state_timeout?: string;
+        /**
+         * Type of connection to use to connect to the machine on which AEM instance will be running.
+         */
+        type: string;
+    }
+
     export interface ClientArgs {
         /**
          * Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
@@ -15,6 +88,10 @@ export namespace compose {
          * Credentials for the connection type
          */
         credentials?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+        /**
+         * Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+         */
+        port_forward?: pulumi.Input<boolean>;
         /**
          * Settings for the connection type
          */
@@ -29,13 +106,84 @@ export namespace compose {
         type: pulumi.Input<string>;
     }
 
+    export interface Compose {
+        /**
+         * Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+         */
+        admin_password?: string;
+        /**
+         * Settings for backing up AEM instance files.
+         */
+        backup?: inputs.compose.Backup;
+        /**
+         * Contents of the AEM Compose YML configuration file.
+         */
+        config?: string;
+        /**
+         * Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+         */
+        config_lists_strategy?: string;
+        /**
+         * Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+         */
+        config_overrides?: any;
+        /**
+         * Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+         */
+        configure?: inputs.compose.InstanceScript;
+        /**
+         * Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+         */
+        create?: inputs.compose.InstanceScript;
+        /**
+         * Script(s) for deleting a stopped instance.
+         */
+        delete?: inputs.compose.InstanceScript;
+        /**
+         * Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+         */
+        download?: boolean;
+        /**
+         * Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+         */
+        instances?: inputs.compose.ComposeInstance[];
+        /**
+         * Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+         */
+        readiness?: inputs.compose.Readiness;
+        /**
+         * Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+         */
+        restore_from?: string;
+        /**
+         * Version of AEM Compose tool to use on remote machine.
+         */
+        version?: string;
+    }
+
     export interface ComposeArgs {
+        /**
+         * Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+         */
+        admin_password?: pulumi.Input<string>;
+        /**
+         * Settings for backing up AEM instance files.
+         */
+        backup?: pulumi.Input<inputs.compose.BackupArgs>;
         /**
          * Contents of the AEM Compose YML configuration file.
          */
         config?: pulumi.Input<string>;
         /**
-         * Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+         * Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+         */
+        config_lists_strategy?: pulumi.Input<string>;
+        /**
+         * Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+         */
+        config_overrides?: any;
+        /**
+         * Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
          */
         configure?: pulumi.Input<inputs.compose.InstanceScriptArgs>;
         /**
@@ -50,12 +198,140 @@ export namespace compose {
          * Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
          */
         download?: pulumi.Input<boolean>;
+        /**
+         * Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+         */
+        instances?: pulumi.Input<pulumi.Input<inputs.compose.ComposeInstanceArgs>[]>;
+        /**
+         * Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+         */
+        readiness?: pulumi.Input<inputs.compose.ReadinessArgs>;
+        /**
+         * Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+         */
+        restore_from?: pulumi.Input<string>;
         /**
          * Version of AEM Compose tool to use on remote machine.
          */
         version?: pulumi.Input<string>;
     }
 
+    export interface ComposeInstance {
+        /**
+         * Environment variables set for the AEM instance process (in format 'NAME=value').
+         */
+        env_vars?: string[];
+        /**
+         * The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+         */
+        http_url: string;
+        /**
+         * Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+         */
+        id: string;
+        /**
+         * JVM options passed to the AEM instance process.
+         */
+        jvm_opts?: string[];
+        /**
+         * Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+         */
+        password?: string;
+        /**
+         * Run modes of the AEM instance.
+         */
+        run_modes?: string[];
+        /**
+         * Secret variables set for the AEM instance process (in format 'NAME=value').
+         */
+        secret_vars?: string[];
+        /**
+         * Sling properties set for the AEM instance (in format 'name=value').
+         */
+        sling_props?: string[];
+        /**
+         * Options passed to the AEM instance start script.
+         */
+        start_opts?: string[];
+        /**
+         * User used to communicate with the AEM instance.
+         */
+        user?: string;
+    }
+
+    export interface ComposeInstanceArgs {
+        /**
+         * Environment variables set for the AEM instance process (in format 'NAME=value').
+         */
+        env_vars?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+         */
+        http_url: pulumi.Input<string>;
+        /**
+         * Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+         */
+        id: pulumi.Input<string>;
+        /**
+         * JVM options passed to the AEM instance process.
+         */
+        jvm_opts?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+         */
+        password?: pulumi.Input<string>;
+        /**
+         * Run modes of the AEM instance.
+         */
+        run_modes?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * Secret variables set for the AEM instance process (in format 'NAME=value').
+         */
+        secret_vars?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * Sling properties set for the AEM instance (in format 'name=value').
+         */
+        sling_props?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * Options passed to the AEM instance start script.
+         */
+        start_opts?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * User used to communicate with the AEM instance.
+         */
+        user?: pulumi.Input<string>;
+    }
+
+    export interface DiagnosticsArgs {
+        /**
+         * Number of the last lines of the AEM logs and system journal to be collected.
+         */
+        log_lines?: pulumi.Input<number>;
+        /**
+         * Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+         */
+        output_dir?: pulumi.Input<string>;
+        /**
+         * Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+         */
+        summary_lines?: pulumi.Input<number>;
+        /**
+         * Toggle collecting thread dumps of the running AEM instances.
+         */
+        thread_dump?: pulumi.Input<boolean>;
+    }
+
+    export interface InstanceScript {
+        /**
+         * Inline shell commands to be executed
+         */
+        inline?: string[];
+        /**
+         * Multiline shell script to be executed
+         */
+        script?: string;
+    }
+
     export interface InstanceScriptArgs {
         /**
          * Inline shell commands to be executed
@@ -67,6 +343,204 @@ export namespace compose {
         script?: pulumi.Input<string>;
     }
 
+    export interface PreflightArgs {
+        /**
+         * Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+         */
+        java_version?: pulumi.Input<string>;
+        /**
+         * Minimum free disk space in gigabytes required in the data directory.
+         */
+        min_disk_space?: pulumi.Input<number>;
+        /**
+         * Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+         */
+        ports?: pulumi.Input<boolean>;
+    }
+
+    export interface Readiness {
+        /**
+         * Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+         */
+        attributes?: string[];
+        /**
+         * Time between subsequent readiness checks.
+         */
+        interval?: string;
+        /**
+         * Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+         */
+        policy?: string;
+        /**
+         * HTTP requests performed against each instance which need to respond with the expected status.
+         */
+        probes?: inputs.compose.ReadinessProbe[];
+        /**
+         * Maximum time to wait for the instances to become ready.
+         */
+        timeout?: string;
+    }
+
+    export interface ReadinessArgs {
+        /**
+         * Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+         */
+        attributes?: pulumi.Input<pulumi.Input<string>[]>;
+        /**
+         * Time between subsequent readiness checks.
+         */
+        interval?: pulumi.Input<string>;
+        /**
+         * Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+         */
+        policy?: pulumi.Input<string>;
+        /**
+         * HTTP requests performed against each instance which need to respond with the expected status.
+         */
+        probes?: pulumi.Input<pulumi.Input<inputs.compose.ReadinessProbeArgs>[]>;
+        /**
+         * Maximum time to wait for the instances to become ready.
+         */
+        timeout?: pulumi.Input<string>;
+    }
+
+    export interface ReadinessProbe {
+        /**
+         * Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+         */
+        path: string;
+        /**
+         * Expected HTTP status of the response.
+         */
+        status?: number;
+    }
+
+    export interface ReadinessProbeArgs {
+        /**
+         * Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+         */
+        path: pulumi.Input<string>;
+        /**
+         * Expected HTTP status of the response.
+         */
+        status?: pulumi.Input<number>;
+    }
+
+    export interface Schedule {
+        /**
+         * Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+         */
+        builtin?: string;
+        /**
+         * Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+         */
+        name: string;
+        /**
+         * Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+         */
+        on_calendar: string;
+        /**
+         * Script(s) to be executed. Mutually exclusive with 'builtin'.
+         */
+        script?: inputs.compose.InstanceScript;
+    }
+
+    export interface ScheduleArgs {
+        /**
+         * Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+         */
+        builtin?: pulumi.Input<string>;
+        /**
+         * Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+         */
+        name: pulumi.Input<string>;
+        /**
+         * Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+         */
+        on_calendar: pulumi.Input<string>;
+        /**
+         * Script(s) to be executed. Mutually exclusive with 'builtin'.
+         */
+        script?: pulumi.Input<inputs.compose.InstanceScriptArgs>;
+    }
+
+    export interface SmokeTestAuthArgs {
+        /**
+         * Password of the user.
+         */
+        password?: pulumi.Input<string>;
+        /**
+         * Name of the user. When empty, the request is performed anonymously.
+         */
+        user?: pulumi.Input<string>;
+    }
+
+    export interface SmokeTestCheckArgs {
+        /**
+         * Credentials used to perform the request. By default, the credentials of the instance are used.
+         */
+        auth?: pulumi.Input<inputs.compose.SmokeTestAuthArgs>;
+        /**
+         * Regular expression which the response body needs to match.
+         */
+        expected_body?: pulumi.Input<string>;
+        /**
+         * Expected HTTP status of the response.
+         */
+        expected_status?: pulumi.Input<number>;
+        /**
+         * Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+         */
+        json_path?: pulumi.Input<string>;
+        /**
+         * Expected value found at the JSON path.
+         */
+        json_value?: pulumi.Input<string>;
+        /**
+         * HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+         */
+        method?: pulumi.Input<string>;
+        /**
+         * Name of the check used in the results. By default, the method and path are used.
+         */
+        name?: pulumi.Input<string>;
+        /**
+         * Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+         */
+        path: pulumi.Input<string>;
+    }
+
+    export interface System {
+        /**
+         * Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
+         */
+        bootstrap?: inputs.compose.InstanceScript;
+        /**
+         * Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
+         */
+        data_dir?: string;
+        /**
+         * Environment variables for AEM instances.
+         */
+        env?: {[key: string]: string};
+        /**
+         * Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+         */
+        schedules?: inputs.compose.Schedule[];
+        /**
+         * Contents of the AEM system service definition file (systemd).
+         */
+        service_config?: string;
+        /**
+         * System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
+         */
+        user?: string;
+        /**
+         * Remote root path where provider-related files will be stored.
+         */
+        work_dir?: string;
+    }
+
     export interface SystemArgs {
         /**
          * Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
@@ -80,6 +554,10 @@ export namespace compose {
          * Environment variables for AEM instances.
          */
         env?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+        /**
+         * Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+         */
+        schedules?: pulumi.Input<pulumi.Input<inputs.compose.ScheduleArgs>[]>;
         /**
          * Contents of the AEM system service definition file (systemd).
          */
@@ -93,4 +571,5 @@ export namespace compose {
          */
         work_dir?: pulumi.Input<string>;
     }
+
 }
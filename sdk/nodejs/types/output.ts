@@ -6,6 +6,105 @@ import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
 export namespace compose {
+    export interface AclInstanceModel {
+        /**
+         * Privileges granted to the principal on the AEM instance.
+         */
+        allow: string[];
+        /**
+         * Privileges denied to the principal on the AEM instance.
+         */
+        deny: string[];
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+    }
+
+    export interface ActivateResultItem {
+        /**
+         * Unique identifier of AEM instance on which the replication was requested.
+         */
+        instance_id: string;
+        /**
+         * Response message of the replication request.
+         */
+        message: string;
+        /**
+         * Repository path of the replicated content.
+         */
+        path: string;
+        /**
+         * HTTP status of the replication request.
+         */
+        status: number;
+        /**
+         * Indicates if the replication request was accepted.
+         */
+        succeeded: boolean;
+    }
+
+    export interface Backup {
+        /**
+         * Toggle making a backup before deleting the instance.
+         */
+        before_delete?: boolean;
+        /**
+         * Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+         */
+        before_update?: boolean;
+        /**
+         * Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+         */
+        compression?: string;
+        /**
+         * Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+         */
+        retention?: number;
+        /**
+         * Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+         */
+        target: string;
+    }
+
+    export interface BackupModel {
+        /**
+         * SHA-256 checksum of the backup file.
+         */
+        checksum: string;
+        /**
+         * Location of the uploaded backup file. Could be used as 'restore_from' value.
+         */
+        location: string;
+        /**
+         * Name of the backup file.
+         */
+        name: string;
+        /**
+         * Size of the backup file in bytes.
+         */
+        size: number;
+        /**
+         * Time at which the backup was made (RFC 3339, UTC).
+         */
+        timestamp: string;
+    }
+
+    export interface BundleInstanceModel {
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').
+         */
+        state: string;
+        /**
+         * Version of the bundle installed on the AEM instance.
+         */
+        version: string;
+    }
+
     export interface Client {
         /**
          * Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
@@ -15,6 +114,10 @@ export namespace compose {
          * Credentials for the connection type
          */
         credentials?: {[key: string]: string};
+        /**
+         * Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+         */
+        port_forward?: boolean;
         /**
          * Settings for the connection type
          */
@@ -30,12 +133,28 @@ export namespace compose {
     }
 
     export interface Compose {
+        /**
+         * Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+         */
+        admin_password?: string;
+        /**
+         * Settings for backing up AEM instance files.
+         */
+        backup?: outputs.compose.Backup;
         /**
          * Contents of the AEM Compose YML configuration file.
          */
         config?: string;
         /**
-         * Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+         * Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+         */
+        config_lists_strategy?: string;
+        /**
+         * Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+         */
+        config_overrides?: any;
+        /**
+         * Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
          */
         configure?: outputs.compose.InstanceScript;
         /**
@@ -50,12 +169,105 @@ export namespace compose {
          * Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
          */
         download?: boolean;
+        /**
+         * Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+         */
+        instances?: outputs.compose.ComposeInstance[];
+        /**
+         * Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+         */
+        readiness?: outputs.compose.Readiness;
+        /**
+         * Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+         */
+        restore_from?: string;
         /**
          * Version of AEM Compose tool to use on remote machine.
          */
         version?: string;
     }
 
+    export interface ComposeInstance {
+        /**
+         * Environment variables set for the AEM instance process (in format 'NAME=value').
+         */
+        env_vars?: string[];
+        /**
+         * The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+         */
+        http_url: string;
+        /**
+         * Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+         */
+        id: string;
+        /**
+         * JVM options passed to the AEM instance process.
+         */
+        jvm_opts?: string[];
+        /**
+         * Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+         */
+        password?: string;
+        /**
+         * Run modes of the AEM instance.
+         */
+        run_modes?: string[];
+        /**
+         * Secret variables set for the AEM instance process (in format 'NAME=value').
+         */
+        secret_vars?: string[];
+        /**
+         * Sling properties set for the AEM instance (in format 'name=value').
+         */
+        sling_props?: string[];
+        /**
+         * Options passed to the AEM instance start script.
+         */
+        start_opts?: string[];
+        /**
+         * User used to communicate with the AEM instance.
+         */
+        user?: string;
+    }
+
+    export interface Diagnostics {
+        /**
+         * Number of the last lines of the AEM logs and system journal to be collected.
+         */
+        log_lines?: number;
+        /**
+         * Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+         */
+        output_dir?: string;
+        /**
+         * Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+         */
+        summary_lines?: number;
+        /**
+         * Toggle collecting thread dumps of the running AEM instances.
+         */
+        thread_dump?: boolean;
+    }
+
+    export interface GroupInstanceModel {
+        /**
+         * Indicates if the group exists on the AEM instance.
+         */
+        exists: boolean;
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Identifiers of the direct members of the group on the AEM instance.
+         */
+        members: string[];
+        /**
+         * Repository path of the group on the AEM instance.
+         */
+        path: string;
+    }
+
     export interface InstanceModel {
         /**
          * Version of the AEM instance. Reflects service pack installations.
@@ -65,18 +277,46 @@ export namespace compose {
          * A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
          */
         attributes: string[];
+        /**
+         * Indicates if the AEM instance files are created on the machine.
+         */
+        created: boolean;
+        /**
+         * Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.
+         */
+        debug_port: number;
         /**
          * Remote path in which AEM instance is stored.
          */
         dir: string;
+        /**
+         * A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.
+         */
+        health_checks: string[];
+        /**
+         * Port on which the AEM instance accepts HTTP requests.
+         */
+        http_port: number;
         /**
          * Unique identifier of AEM instance defined in the configuration.
          */
         id: string;
+        /**
+         * Indicates if the AEM instance is running and responds to HTTP requests.
+         */
+        reachable: boolean;
         /**
          * A list of run modes for a specific AEM instance.
          */
         run_modes: string[];
+        /**
+         * Indicates if the AEM instance process is running.
+         */
+        running: boolean;
+        /**
+         * Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).
+         */
+        up_to_date: boolean;
         /**
          * The machine-internal HTTP URL address used for communication with the AEM instance.
          */
@@ -94,6 +334,233 @@ export namespace compose {
         script?: string;
     }
 
+    export interface OakIndexInstanceModel {
+        /**
+         * Indicates if the index definition exists on the AEM instance.
+         */
+        exists: boolean;
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Number of times the index was rebuilt on the AEM instance.
+         */
+        reindex_count: number;
+        /**
+         * Indicates if the index is being rebuilt on the AEM instance.
+         */
+        reindexing: boolean;
+    }
+
+    export interface OsgiConfigInstanceModel {
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Properties of the OSGi configuration read from the AEM instance.
+         */
+        properties: {[key: string]: any};
+    }
+
+    export interface PackageInstanceModel {
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Indicates if the package is installed on the AEM instance.
+         */
+        installed: boolean;
+        /**
+         * Time at which the package was installed on the AEM instance.
+         */
+        last_unpacked: string;
+    }
+
+    export interface Preflight {
+        /**
+         * Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+         */
+        java_version?: string;
+        /**
+         * Minimum free disk space in gigabytes required in the data directory.
+         */
+        min_disk_space?: number;
+        /**
+         * Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+         */
+        ports?: boolean;
+    }
+
+    export interface Readiness {
+        /**
+         * Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+         */
+        attributes?: string[];
+        /**
+         * Time between subsequent readiness checks.
+         */
+        interval?: string;
+        /**
+         * Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+         */
+        policy?: string;
+        /**
+         * HTTP requests performed against each instance which need to respond with the expected status.
+         */
+        probes?: outputs.compose.ReadinessProbe[];
+        /**
+         * Maximum time to wait for the instances to become ready.
+         */
+        timeout?: string;
+    }
+
+    export interface ReadinessProbe {
+        /**
+         * Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+         */
+        path: string;
+        /**
+         * Expected HTTP status of the response.
+         */
+        status?: number;
+    }
+
+    export interface ReplicationAgentInstanceModel {
+        /**
+         * Indicates if the replication agent is enabled on the AEM instance.
+         */
+        enabled: boolean;
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * URI of the replication target read from the AEM instance.
+         */
+        transport_uri: string;
+    }
+
+    export interface RepoNodeInstanceModel {
+        /**
+         * Indicates if the repository node exists on the AEM instance.
+         */
+        exists: boolean;
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Properties of the repository node read from the AEM instance.
+         */
+        properties: {[key: string]: any};
+    }
+
+    export interface Schedule {
+        /**
+         * Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+         */
+        builtin?: string;
+        /**
+         * Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+         */
+        name: string;
+        /**
+         * Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+         */
+        on_calendar: string;
+        /**
+         * Script(s) to be executed. Mutually exclusive with 'builtin'.
+         */
+        script?: outputs.compose.InstanceScript;
+    }
+
+    export interface ScriptInstanceModel {
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Standard output of the script executed on the AEM instance.
+         */
+        output: string;
+        /**
+         * Result returned by the Groovy script executed on the AEM instance.
+         */
+        result: string;
+    }
+
+    export interface SmokeTestAuth {
+        /**
+         * Password of the user.
+         */
+        password?: string;
+        /**
+         * Name of the user. When empty, the request is performed anonymously.
+         */
+        user?: string;
+    }
+
+    export interface SmokeTestCheck {
+        /**
+         * Credentials used to perform the request. By default, the credentials of the instance are used.
+         */
+        auth?: outputs.compose.SmokeTestAuth;
+        /**
+         * Regular expression which the response body needs to match.
+         */
+        expected_body?: string;
+        /**
+         * Expected HTTP status of the response.
+         */
+        expected_status?: number;
+        /**
+         * Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+         */
+        json_path?: string;
+        /**
+         * Expected value found at the JSON path.
+         */
+        json_value?: string;
+        /**
+         * HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+         */
+        method?: string;
+        /**
+         * Name of the check used in the results. By default, the method and path are used.
+         */
+        name?: string;
+        /**
+         * Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+         */
+        path: string;
+    }
+
+    export interface SmokeTestResult {
+        /**
+         * Name of the check.
+         */
+        check: string;
+        /**
+         * Unique identifier of AEM instance on which the check was performed.
+         */
+        instance_id: string;
+        /**
+         * Reason of the failure.
+         */
+        message: string;
+        /**
+         * Indicates if the response met the expectations.
+         */
+        passed: boolean;
+        /**
+         * HTTP status of the response.
+         */
+        status: number;
+    }
+
     export interface System {
         /**
          * Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
@@ -107,6 +574,10 @@ export namespace compose {
          * Environment variables for AEM instances.
          */
         env?: {[key: string]: string};
+        /**
+         * Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+         */
+        schedules?: outputs.compose.Schedule[];
         /**
          * Contents of the AEM system service definition file (systemd).
          */
@@ -121,4 +592,23 @@ export namespace compose {
         work_dir?: string;
     }
 
+    export interface UserInstanceModel {
+        /**
+         * Indicates if the user exists on the AEM instance.
+         */
+        exists: boolean;
+        /**
+         * Identifiers of the groups to which the user directly belongs on the AEM instance.
+         */
+        groups: string[];
+        /**
+         * Unique identifier of AEM instance defined in the configuration.
+         */
+        id: string;
+        /**
+         * Repository path of the user on the AEM instance.
+         */
+        path: string;
+    }
+
 }
@@ -22,7 +22,18 @@ _utilities.register(
   "mod": "compose",
   "fqn": "wttech_aem.compose",
   "classes": {
-   "aem:compose:Instance": "Instance"
+   "aem:compose:Acl": "Acl",
+   "aem:compose:Bundle": "Bundle",
+   "aem:compose:Group": "Group",
+   "aem:compose:Instance": "Instance",
+   "aem:compose:OakIndex": "OakIndex",
+   "aem:compose:OsgiConfig": "OsgiConfig",
+   "aem:compose:Package": "Package",
+   "aem:compose:ReplicationAgent": "ReplicationAgent",
+   "aem:compose:RepoNode": "RepoNode",
+   "aem:compose:Script": "Script",
+   "aem:compose:SmokeTest": "SmokeTest",
+   "aem:compose:User": "User"
   }
  }
 ]
@@ -5,6 +5,22 @@
 from .. import _utilities
 import typing
 # Export this package's modules as members:
+from .acl import *
+from .activate import *
+from .bundle import *
+from .get_instance_status import *
+from .get_logs import *
+from .group import *
 from .instance import *
+from .oak_index import *
+from .osgi_config import *
+from .package import *
+from .replication_agent import *
+from .repo_node import *
+from .run import *
+from .script import *
+from .smoke_test import *
+from .thread_dump import *
+from .user import *
 from ._inputs import *
 from . import outputs
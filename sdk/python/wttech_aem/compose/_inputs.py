@@ -10,12 +10,303 @@ from typing import Any, Mapping, Optional, Sequence, Union, overload
 from .. import _utilities
 
 __all__ = [
+    'Backup',
+    'BackupArgs',
+    'Client',
     'ClientArgs',
+    'Compose',
+    'ComposeInstance',
+    'ComposeInstanceArgs',
     'ComposeArgs',
+    'DiagnosticsArgs',
+    'InstanceScript',
     'InstanceScriptArgs',
+    'PreflightArgs',
+    'Readiness',
+    'ReadinessProbe',
+    'ReadinessProbeArgs',
+    'ReadinessArgs',
+    'Schedule',
+    'ScheduleArgs',
+    'SmokeTestAuthArgs',
+    'SmokeTestCheckArgs',
+    'System',
     'SystemArgs',
 ]
 
+@pulumi.input_type
+class Backup:
+    def __init__(__self__, *,
+                 target: str,
+                 before_delete: Optional[bool] = None,
+                 before_update: Optional[bool] = None,
+                 compression: Optional[str] = None,
+                 retention: Optional[int] = None):
+        """
+        :param str target: Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        :param bool before_delete: Toggle making a backup before deleting the instance.
+        :param bool before_update: Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        :param str compression: Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        :param int retention: Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        """
+        pulumi.set(__self__, "target", target)
+        if before_delete is not None:
+            pulumi.set(__self__, "before_delete", before_delete)
+        if before_update is not None:
+            pulumi.set(__self__, "before_update", before_update)
+        if compression is not None:
+            pulumi.set(__self__, "compression", compression)
+        if retention is not None:
+            pulumi.set(__self__, "retention", retention)
+
+    @property
+    @pulumi.getter
+    def target(self) -> str:
+        """
+        Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        """
+        return pulumi.get(self, "target")
+
+    @target.setter
+    def target(self, value: str):
+        pulumi.set(self, "target", value)
+
+    @property
+    @pulumi.getter
+    def before_delete(self) -> Optional[bool]:
+        """
+        Toggle making a backup before deleting the instance.
+        """
+        return pulumi.get(self, "before_delete")
+
+    @before_delete.setter
+    def before_delete(self, value: Optional[bool]):
+        pulumi.set(self, "before_delete", value)
+
+    @property
+    @pulumi.getter
+    def before_update(self) -> Optional[bool]:
+        """
+        Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        """
+        return pulumi.get(self, "before_update")
+
+    @before_update.setter
+    def before_update(self, value: Optional[bool]):
+        pulumi.set(self, "before_update", value)
+
+    @property
+    @pulumi.getter
+    def compression(self) -> Optional[str]:
+        """
+        Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        """
+        return pulumi.get(self, "compression")
+
+    @compression.setter
+    def compression(self, value: Optional[str]):
+        pulumi.set(self, "compression", value)
+
+    @property
+    @pulumi.getter
+    def retention(self) -> Optional[int]:
+        """
+        Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        """
+        return pulumi.get(self, "retention")
+
+    @retention.setter
+    def retention(self, value: Optional[int]):
+        pulumi.set(self, "retention", value)
+
+
+@pulumi.input_type
+class BackupArgs:
+    def __init__(__self__, *,
+                 target: pulumi.Input[str],
+                 before_delete: Optional[pulumi.Input[bool]] = None,
+                 before_update: Optional[pulumi.Input[bool]] = None,
+                 compression: Optional[pulumi.Input[str]] = None,
+                 retention: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[str] target: Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        :param pulumi.Input[bool] before_delete: Toggle making a backup before deleting the instance.
+        :param pulumi.Input[bool] before_update: Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        :param pulumi.Input[str] compression: Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        :param pulumi.Input[int] retention: Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        """
+        pulumi.set(__self__, "target", target)
+        if before_delete is not None:
+            pulumi.set(__self__, "before_delete", before_delete)
+        if before_update is not None:
+            pulumi.set(__self__, "before_update", before_update)
+        if compression is not None:
+            pulumi.set(__self__, "compression", compression)
+        if retention is not None:
+            pulumi.set(__self__, "retention", retention)
+
+    @property
+    @pulumi.getter
+    def target(self) -> pulumi.Input[str]:
+        """
+        Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        """
+        return pulumi.get(self, "target")
+
+    @target.setter
+    def target(self, value: pulumi.Input[str]):
+        pulumi.set(self, "target", value)
+
+    @property
+    @pulumi.getter
+    def before_delete(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Toggle making a backup before deleting the instance.
+        """
+        return pulumi.get(self, "before_delete")
+
+    @before_delete.setter
+    def before_delete(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "before_delete", value)
+
+    @property
+    @pulumi.getter
+    def before_update(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        """
+        return pulumi.get(self, "before_update")
+
+    @before_update.setter
+    def before_update(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "before_update", value)
+
+    @property
+    @pulumi.getter
+    def compression(self) -> Optional[pulumi.Input[str]]:
+        """
+        Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        """
+        return pulumi.get(self, "compression")
+
+    @compression.setter
+    def compression(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "compression", value)
+
+    @property
+    @pulumi.getter
+    def retention(self) -> Optional[pulumi.Input[int]]:
+        """
+        Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        """
+        return pulumi.get(self, "retention")
+
+    @retention.setter
+    def retention(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "retention", value)
+
+
+@pulumi.input_type
+class Client:
+    def __init__(__self__, *,
+                 settings: Mapping[str, str],
+                 type: str,
+                 action_timeout: Optional[str] = None,
+                 credentials: Optional[Mapping[str, str]] = None,
+                 port_forward: Optional[bool] = None,
+                 state_timeout: Optional[str] = None):
+        """
+        :param Mapping[str, str] settings: Settings for the connection type
+        :param str type: Type of connection to use to connect to the machine on which AEM instance will be running.
+        :param str action_timeout: Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+        :param Mapping[str, str] credentials: Credentials for the connection type
+        :param bool port_forward: Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        :param str state_timeout: Used when reading the AEM instance state when determining the plan.
+        """
+        pulumi.set(__self__, "settings", settings)
+        pulumi.set(__self__, "type", type)
+        if action_timeout is not None:
+            pulumi.set(__self__, "action_timeout", action_timeout)
+        if credentials is not None:
+            pulumi.set(__self__, "credentials", credentials)
+        if port_forward is not None:
+            pulumi.set(__self__, "port_forward", port_forward)
+        if state_timeout is not None:
+            pulumi.set(__self__, "state_timeout", state_timeout)
+
+    @property
+    @pulumi.getter
+    def settings(self) -> Mapping[str, str]:
+        """
+        Settings for the connection type
+        """
+        return pulumi.get(self, "settings")
+
+    @settings.setter
+    def settings(self, value: Mapping[str, str]):
+        pulumi.set(self, "settings", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        Type of connection to use to connect to the machine on which AEM instance will be running.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: str):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter
+    def action_timeout(self) -> Optional[str]:
+        """
+        Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+        """
+        return pulumi.get(self, "action_timeout")
+
+    @action_timeout.setter
+    def action_timeout(self, value: Optional[str]):
+        pulumi.set(self, "action_timeout", value)
+
+    @property
+    @pulumi.getter
+    def credentials(self) -> Optional[Mapping[str, str]]:
+        """
+        Credentials for the connection type
+        """
+        return pulumi.get(self, "credentials")
+
+    @credentials.setter
+    def credentials(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "credentials", value)
+
+    @property
+    @pulumi.getter
+    def port_forward(self) -> Optional[bool]:
+        """
+        Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        """
+        return pulumi.get(self, "port_forward")
+
+    @port_forward.setter
+    def port_forward(self, value: Optional[bool]):
+        pulumi.set(self, "port_forward", value)
+
+    @property
+    @pulumi.getter
+    def state_timeout(self) -> Optional[str]:
+        """
+        Used when reading the AEM instance state when determining the plan.
+        """
+        return pulumi.get(self, "state_timeout")
+
+    @state_timeout.setter
+    def state_timeout(self, value: Optional[str]):
+        pulumi.set(self, "state_timeout", value)
+
+
 @pulumi.input_type
 class ClientArgs:
     def __init__(__self__, *,
@@ -23,12 +314,14 @@ class ClientArgs:
                  type: pulumi.Input[str],
                  action_timeout: Optional[pulumi.Input[str]] = None,
                  credentials: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 port_forward: Optional[pulumi.Input[bool]] = None,
                  state_timeout: Optional[pulumi.Input[str]] = None):
         """
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] settings: Settings for the connection type
         :param pulumi.Input[str] type: Type of connection to use to connect to the machine on which AEM instance will be running.
         :param pulumi.Input[str] action_timeout: Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] credentials: Credentials for the connection type
+        :param pulumi.Input[bool] port_forward: Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
         :param pulumi.Input[str] state_timeout: Used when reading the AEM instance state when determining the plan.
         """
         pulumi.set(__self__, "settings", settings)
@@ -37,6 +330,8 @@ class ClientArgs:
             pulumi.set(__self__, "action_timeout", action_timeout)
         if credentials is not None:
             pulumi.set(__self__, "credentials", credentials)
+        if port_forward is not None:
+            pulumi.set(__self__, "port_forward", port_forward)
         if state_timeout is not None:
             pulumi.set(__self__, "state_timeout", state_timeout)
 
@@ -88,6 +383,18 @@ class ClientArgs:
     def credentials(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
         pulumi.set(self, "credentials", value)
 
+    @property
+    @pulumi.getter
+    def port_forward(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        """
+        return pulumi.get(self, "port_forward")
+
+    @port_forward.setter
+    def port_forward(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "port_forward", value)
+
     @property
     @pulumi.getter
     def state_timeout(self) -> Optional[pulumi.Input[str]]:
@@ -102,24 +409,46 @@ class ClientArgs:
 
 
 @pulumi.input_type
-class ComposeArgs:
+class Compose:
     def __init__(__self__, *,
-                 config: Optional[pulumi.Input[str]] = None,
-                 configure: Optional[pulumi.Input['InstanceScriptArgs']] = None,
-                 create: Optional[pulumi.Input['InstanceScriptArgs']] = None,
-                 delete: Optional[pulumi.Input['InstanceScriptArgs']] = None,
-                 download: Optional[pulumi.Input[bool]] = None,
-                 version: Optional[pulumi.Input[str]] = None):
+                 admin_password: Optional[str] = None,
+                 backup: Optional['Backup'] = None,
+                 config: Optional[str] = None,
+                 config_lists_strategy: Optional[str] = None,
+                 config_overrides: Optional[Any] = None,
+                 configure: Optional['InstanceScript'] = None,
+                 create: Optional['InstanceScript'] = None,
+                 delete: Optional['InstanceScript'] = None,
+                 download: Optional[bool] = None,
+                 instances: Optional[Sequence['ComposeInstance']] = None,
+                 readiness: Optional['Readiness'] = None,
+                 restore_from: Optional[str] = None,
+                 version: Optional[str] = None):
         """
-        :param pulumi.Input[str] config: Contents of the AEM Compose YML configuration file.
-        :param pulumi.Input['InstanceScriptArgs'] configure: Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
-        :param pulumi.Input['InstanceScriptArgs'] create: Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
-        :param pulumi.Input['InstanceScriptArgs'] delete: Script(s) for deleting a stopped instance.
-        :param pulumi.Input[bool] download: Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
-        :param pulumi.Input[str] version: Version of AEM Compose tool to use on remote machine.
+        :param str admin_password: Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        :param 'Backup' backup: Settings for backing up AEM instance files.
+        :param str config: Contents of the AEM Compose YML configuration file.
+        :param str config_lists_strategy: Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        :param Any config_overrides: Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        :param 'InstanceScript' configure: Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+        :param 'InstanceScript' create: Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        :param 'InstanceScript' delete: Script(s) for deleting a stopped instance.
+        :param bool download: Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        :param Sequence['ComposeInstance'] instances: Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        :param 'Readiness' readiness: Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        :param str restore_from: Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        :param str version: Version of AEM Compose tool to use on remote machine.
         """
+        if admin_password is not None:
+            pulumi.set(__self__, "admin_password", admin_password)
+        if backup is not None:
+            pulumi.set(__self__, "backup", backup)
         if config is not None:
             pulumi.set(__self__, "config", config)
+        if config_lists_strategy is not None:
+            pulumi.set(__self__, "config_lists_strategy", config_lists_strategy)
+        if config_overrides is not None:
+            pulumi.set(__self__, "config_overrides", config_overrides)
         if configure is not None:
             pulumi.set(__self__, "configure", configure)
         if create is not None:
@@ -128,156 +457,1640 @@ class ComposeArgs:
             pulumi.set(__self__, "delete", delete)
         if download is not None:
             pulumi.set(__self__, "download", download)
+        if instances is not None:
+            pulumi.set(__self__, "instances", instances)
+        if readiness is not None:
+            pulumi.set(__self__, "readiness", readiness)
+        if restore_from is not None:
+            pulumi.set(__self__, "restore_from", restore_from)
         if version is not None:
             pulumi.set(__self__, "version", version)
 
     @property
     @pulumi.getter
-    def config(self) -> Optional[pulumi.Input[str]]:
+    def admin_password(self) -> Optional[str]:
+        """
+        Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        """
+        return pulumi.get(self, "admin_password")
+
+    @admin_password.setter
+    def admin_password(self, value: Optional[str]):
+        pulumi.set(self, "admin_password", value)
+
+    @property
+    @pulumi.getter
+    def backup(self) -> Optional['Backup']:
+        """
+        Settings for backing up AEM instance files.
+        """
+        return pulumi.get(self, "backup")
+
+    @backup.setter
+    def backup(self, value: Optional['Backup']):
+        pulumi.set(self, "backup", value)
+
+    @property
+    @pulumi.getter
+    def config(self) -> Optional[str]:
         """
         Contents of the AEM Compose YML configuration file.
         """
         return pulumi.get(self, "config")
 
     @config.setter
-    def config(self, value: Optional[pulumi.Input[str]]):
+    def config(self, value: Optional[str]):
         pulumi.set(self, "config", value)
 
     @property
     @pulumi.getter
-    def configure(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+    def config_lists_strategy(self) -> Optional[str]:
+        """
+        Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
         """
-        Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+        return pulumi.get(self, "config_lists_strategy")
+
+    @config_lists_strategy.setter
+    def config_lists_strategy(self, value: Optional[str]):
+        pulumi.set(self, "config_lists_strategy", value)
+
+    @property
+    @pulumi.getter
+    def config_overrides(self) -> Optional[Any]:
+        """
+        Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        """
+        return pulumi.get(self, "config_overrides")
+
+    @config_overrides.setter
+    def config_overrides(self, value: Optional[Any]):
+        pulumi.set(self, "config_overrides", value)
+
+    @property
+    @pulumi.getter
+    def configure(self) -> Optional['InstanceScript']:
+        """
+        Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
         """
         return pulumi.get(self, "configure")
 
     @configure.setter
-    def configure(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+    def configure(self, value: Optional['InstanceScript']):
         pulumi.set(self, "configure", value)
 
     @property
     @pulumi.getter
-    def create(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+    def create(self) -> Optional['InstanceScript']:
         """
         Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
         """
         return pulumi.get(self, "create")
 
     @create.setter
-    def create(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+    def create(self, value: Optional['InstanceScript']):
         pulumi.set(self, "create", value)
 
     @property
     @pulumi.getter
-    def delete(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+    def delete(self) -> Optional['InstanceScript']:
         """
         Script(s) for deleting a stopped instance.
         """
         return pulumi.get(self, "delete")
 
     @delete.setter
-    def delete(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+    def delete(self, value: Optional['InstanceScript']):
         pulumi.set(self, "delete", value)
 
     @property
     @pulumi.getter
-    def download(self) -> Optional[pulumi.Input[bool]]:
+    def download(self) -> Optional[bool]:
         """
         Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
         """
         return pulumi.get(self, "download")
 
     @download.setter
-    def download(self, value: Optional[pulumi.Input[bool]]):
+    def download(self, value: Optional[bool]):
         pulumi.set(self, "download", value)
 
     @property
     @pulumi.getter
-    def version(self) -> Optional[pulumi.Input[str]]:
+    def instances(self) -> Optional[Sequence['ComposeInstance']]:
         """
-        Version of AEM Compose tool to use on remote machine.
+        Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
         """
-        return pulumi.get(self, "version")
-
-    @version.setter
-    def version(self, value: Optional[pulumi.Input[str]]):
-        pulumi.set(self, "version", value)
+        return pulumi.get(self, "instances")
 
+    @instances.setter
+    def instances(self, value: Optional[Sequence['ComposeInstance']]):
+        pulumi.set(self, "instances", value)
 
-@pulumi.input_type
-class InstanceScriptArgs:
-    def __init__(__self__, *,
-                 inline: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
-                 script: Optional[pulumi.Input[str]] = None):
+    @property
+    @pulumi.getter
+    def readiness(self) -> Optional['Readiness']:
         """
-        :param pulumi.Input[Sequence[pulumi.Input[str]]] inline: Inline shell commands to be executed
-        :param pulumi.Input[str] script: Multiline shell script to be executed
+        Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
         """
-        if inline is not None:
-            pulumi.set(__self__, "inline", inline)
-        if script is not None:
-            pulumi.set(__self__, "script", script)
+        return pulumi.get(self, "readiness")
+
+    @readiness.setter
+    def readiness(self, value: Optional['Readiness']):
+        pulumi.set(self, "readiness", value)
 
     @property
     @pulumi.getter
-    def inline(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+    def restore_from(self) -> Optional[str]:
         """
-        Inline shell commands to be executed
+        Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
         """
-        return pulumi.get(self, "inline")
+        return pulumi.get(self, "restore_from")
 
-    @inline.setter
-    def inline(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
-        pulumi.set(self, "inline", value)
+    @restore_from.setter
+    def restore_from(self, value: Optional[str]):
+        pulumi.set(self, "restore_from", value)
 
     @property
     @pulumi.getter
-    def script(self) -> Optional[pulumi.Input[str]]:
+    def version(self) -> Optional[str]:
         """
-        Multiline shell script to be executed
+        Version of AEM Compose tool to use on remote machine.
         """
-        return pulumi.get(self, "script")
+        return pulumi.get(self, "version")
 
-    @script.setter
-    def script(self, value: Optional[pulumi.Input[str]]):
-        pulumi.set(self, "script", value)
+    @version.setter
+    def version(self, value: Optional[str]):
+        pulumi.set(self, "version", value)
 
 
 @pulumi.input_type
-class SystemArgs:
+class ComposeInstance:
     def __init__(__self__, *,
-                 bootstrap: Optional[pulumi.Input['InstanceScriptArgs']] = None,
-                 data_dir: Optional[pulumi.Input[str]] = None,
-                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
-                 service_config: Optional[pulumi.Input[str]] = None,
-                 user: Optional[pulumi.Input[str]] = None,
-                 work_dir: Optional[pulumi.Input[str]] = None):
+                 http_url: str,
+                 id: str,
+                 env_vars: Optional[Sequence[str]] = None,
+                 jvm_opts: Optional[Sequence[str]] = None,
+                 password: Optional[str] = None,
+                 run_modes: Optional[Sequence[str]] = None,
+                 secret_vars: Optional[Sequence[str]] = None,
+                 sling_props: Optional[Sequence[str]] = None,
+                 start_opts: Optional[Sequence[str]] = None,
+                 user: Optional[str] = None):
         """
-        :param pulumi.Input['InstanceScriptArgs'] bootstrap: Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
-        :param pulumi.Input[str] data_dir: Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
-        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] env: Environment variables for AEM instances.
-        :param pulumi.Input[str] service_config: Contents of the AEM system service definition file (systemd).
-        :param pulumi.Input[str] user: System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
-        :param pulumi.Input[str] work_dir: Remote root path where provider-related files will be stored.
+        :param str http_url: The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        :param str id: Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        :param Sequence[str] env_vars: Environment variables set for the AEM instance process (in format 'NAME=value').
+        :param Sequence[str] jvm_opts: JVM options passed to the AEM instance process.
+        :param str password: Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        :param Sequence[str] run_modes: Run modes of the AEM instance.
+        :param Sequence[str] secret_vars: Secret variables set for the AEM instance process (in format 'NAME=value').
+        :param Sequence[str] sling_props: Sling properties set for the AEM instance (in format 'name=value').
+        :param Sequence[str] start_opts: Options passed to the AEM instance start script.
+        :param str user: User used to communicate with the AEM instance.
         """
-        if bootstrap is not None:
-            pulumi.set(__self__, "bootstrap", bootstrap)
-        if data_dir is not None:
-            pulumi.set(__self__, "data_dir", data_dir)
-        if env is not None:
-            pulumi.set(__self__, "env", env)
-        if service_config is not None:
-            pulumi.set(__self__, "service_config", service_config)
+        pulumi.set(__self__, "http_url", http_url)
+        pulumi.set(__self__, "id", id)
+        if env_vars is not None:
+            pulumi.set(__self__, "env_vars", env_vars)
+        if jvm_opts is not None:
+            pulumi.set(__self__, "jvm_opts", jvm_opts)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if run_modes is not None:
+            pulumi.set(__self__, "run_modes", run_modes)
+        if secret_vars is not None:
+            pulumi.set(__self__, "secret_vars", secret_vars)
+        if sling_props is not None:
+            pulumi.set(__self__, "sling_props", sling_props)
+        if start_opts is not None:
+            pulumi.set(__self__, "start_opts", start_opts)
         if user is not None:
             pulumi.set(__self__, "user", user)
-        if work_dir is not None:
-            pulumi.set(__self__, "work_dir", work_dir)
 
     @property
     @pulumi.getter
-    def bootstrap(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+    def http_url(self) -> str:
         """
-        Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
+        The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        """
+        return pulumi.get(self, "http_url")
+
+    @http_url.setter
+    def http_url(self, value: str):
+        pulumi.set(self, "http_url", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: str):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter
+    def env_vars(self) -> Optional[Sequence[str]]:
+        """
+        Environment variables set for the AEM instance process (in format 'NAME=value').
+        """
+        return pulumi.get(self, "env_vars")
+
+    @env_vars.setter
+    def env_vars(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "env_vars", value)
+
+    @property
+    @pulumi.getter
+    def jvm_opts(self) -> Optional[Sequence[str]]:
+        """
+        JVM options passed to the AEM instance process.
+        """
+        return pulumi.get(self, "jvm_opts")
+
+    @jvm_opts.setter
+    def jvm_opts(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "jvm_opts", value)
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[str]:
+        """
+        Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[str]):
+        pulumi.set(self, "password", value)
+
+    @property
+    @pulumi.getter
+    def run_modes(self) -> Optional[Sequence[str]]:
+        """
+        Run modes of the AEM instance.
+        """
+        return pulumi.get(self, "run_modes")
+
+    @run_modes.setter
+    def run_modes(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "run_modes", value)
+
+    @property
+    @pulumi.getter
+    def secret_vars(self) -> Optional[Sequence[str]]:
+        """
+        Secret variables set for the AEM instance process (in format 'NAME=value').
+        """
+        return pulumi.get(self, "secret_vars")
+
+    @secret_vars.setter
+    def secret_vars(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "secret_vars", value)
+
+    @property
+    @pulumi.getter
+    def sling_props(self) -> Optional[Sequence[str]]:
+        """
+        Sling properties set for the AEM instance (in format 'name=value').
+        """
+        return pulumi.get(self, "sling_props")
+
+    @sling_props.setter
+    def sling_props(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "sling_props", value)
+
+    @property
+    @pulumi.getter
+    def start_opts(self) -> Optional[Sequence[str]]:
+        """
+        Options passed to the AEM instance start script.
+        """
+        return pulumi.get(self, "start_opts")
+
+    @start_opts.setter
+    def start_opts(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "start_opts", value)
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[str]:
+        """
+        User used to communicate with the AEM instance.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[str]):
+        pulumi.set(self, "user", value)
+
+
+@pulumi.input_type
+class ComposeInstanceArgs:
+    def __init__(__self__, *,
+                 http_url: pulumi.Input[str],
+                 id: pulumi.Input[str],
+                 env_vars: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 jvm_opts: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 password: Optional[pulumi.Input[str]] = None,
+                 run_modes: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 secret_vars: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 sling_props: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 start_opts: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 user: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] http_url: The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        :param pulumi.Input[str] id: Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] env_vars: Environment variables set for the AEM instance process (in format 'NAME=value').
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] jvm_opts: JVM options passed to the AEM instance process.
+        :param pulumi.Input[str] password: Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] run_modes: Run modes of the AEM instance.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] secret_vars: Secret variables set for the AEM instance process (in format 'NAME=value').
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sling_props: Sling properties set for the AEM instance (in format 'name=value').
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] start_opts: Options passed to the AEM instance start script.
+        :param pulumi.Input[str] user: User used to communicate with the AEM instance.
+        """
+        pulumi.set(__self__, "http_url", http_url)
+        pulumi.set(__self__, "id", id)
+        if env_vars is not None:
+            pulumi.set(__self__, "env_vars", env_vars)
+        if jvm_opts is not None:
+            pulumi.set(__self__, "jvm_opts", jvm_opts)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if run_modes is not None:
+            pulumi.set(__self__, "run_modes", run_modes)
+        if secret_vars is not None:
+            pulumi.set(__self__, "secret_vars", secret_vars)
+        if sling_props is not None:
+            pulumi.set(__self__, "sling_props", sling_props)
+        if start_opts is not None:
+            pulumi.set(__self__, "start_opts", start_opts)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @property
+    @pulumi.getter
+    def http_url(self) -> pulumi.Input[str]:
+        """
+        The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        """
+        return pulumi.get(self, "http_url")
+
+    @http_url.setter
+    def http_url(self, value: pulumi.Input[str]):
+        pulumi.set(self, "http_url", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> pulumi.Input[str]:
+        """
+        Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter
+    def env_vars(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Environment variables set for the AEM instance process (in format 'NAME=value').
+        """
+        return pulumi.get(self, "env_vars")
+
+    @env_vars.setter
+    def env_vars(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "env_vars", value)
+
+    @property
+    @pulumi.getter
+    def jvm_opts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        JVM options passed to the AEM instance process.
+        """
+        return pulumi.get(self, "jvm_opts")
+
+    @jvm_opts.setter
+    def jvm_opts(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "jvm_opts", value)
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[pulumi.Input[str]]:
+        """
+        Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "password", value)
+
+    @property
+    @pulumi.getter
+    def run_modes(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Run modes of the AEM instance.
+        """
+        return pulumi.get(self, "run_modes")
+
+    @run_modes.setter
+    def run_modes(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "run_modes", value)
+
+    @property
+    @pulumi.getter
+    def secret_vars(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Secret variables set for the AEM instance process (in format 'NAME=value').
+        """
+        return pulumi.get(self, "secret_vars")
+
+    @secret_vars.setter
+    def secret_vars(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "secret_vars", value)
+
+    @property
+    @pulumi.getter
+    def sling_props(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Sling properties set for the AEM instance (in format 'name=value').
+        """
+        return pulumi.get(self, "sling_props")
+
+    @sling_props.setter
+    def sling_props(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "sling_props", value)
+
+    @property
+    @pulumi.getter
+    def start_opts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Options passed to the AEM instance start script.
+        """
+        return pulumi.get(self, "start_opts")
+
+    @start_opts.setter
+    def start_opts(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "start_opts", value)
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[pulumi.Input[str]]:
+        """
+        User used to communicate with the AEM instance.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user", value)
+
+
+@pulumi.input_type
+class ComposeArgs:
+    def __init__(__self__, *,
+                 admin_password: Optional[pulumi.Input[str]] = None,
+                 backup: Optional[pulumi.Input['BackupArgs']] = None,
+                 config: Optional[pulumi.Input[str]] = None,
+                 config_lists_strategy: Optional[pulumi.Input[str]] = None,
+                 config_overrides: Optional[Any] = None,
+                 configure: Optional[pulumi.Input['InstanceScriptArgs']] = None,
+                 create: Optional[pulumi.Input['InstanceScriptArgs']] = None,
+                 delete: Optional[pulumi.Input['InstanceScriptArgs']] = None,
+                 download: Optional[pulumi.Input[bool]] = None,
+                 instances: Optional[pulumi.Input[Sequence[pulumi.Input['ComposeInstanceArgs']]]] = None,
+                 readiness: Optional[pulumi.Input['ReadinessArgs']] = None,
+                 restore_from: Optional[pulumi.Input[str]] = None,
+                 version: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] admin_password: Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        :param pulumi.Input['BackupArgs'] backup: Settings for backing up AEM instance files.
+        :param pulumi.Input[str] config: Contents of the AEM Compose YML configuration file.
+        :param pulumi.Input[str] config_lists_strategy: Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        :param Any config_overrides: Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        :param pulumi.Input['InstanceScriptArgs'] configure: Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+        :param pulumi.Input['InstanceScriptArgs'] create: Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        :param pulumi.Input['InstanceScriptArgs'] delete: Script(s) for deleting a stopped instance.
+        :param pulumi.Input[bool] download: Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        :param pulumi.Input[Sequence[pulumi.Input['ComposeInstanceArgs']]] instances: Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        :param pulumi.Input['ReadinessArgs'] readiness: Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        :param pulumi.Input[str] restore_from: Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        :param pulumi.Input[str] version: Version of AEM Compose tool to use on remote machine.
+        """
+        if admin_password is not None:
+            pulumi.set(__self__, "admin_password", admin_password)
+        if backup is not None:
+            pulumi.set(__self__, "backup", backup)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if config_lists_strategy is not None:
+            pulumi.set(__self__, "config_lists_strategy", config_lists_strategy)
+        if config_overrides is not None:
+            pulumi.set(__self__, "config_overrides", config_overrides)
+        if configure is not None:
+            pulumi.set(__self__, "configure", configure)
+        if create is not None:
+            pulumi.set(__self__, "create", create)
+        if delete is not None:
+            pulumi.set(__self__, "delete", delete)
+        if download is not None:
+            pulumi.set(__self__, "download", download)
+        if instances is not None:
+            pulumi.set(__self__, "instances", instances)
+        if readiness is not None:
+            pulumi.set(__self__, "readiness", readiness)
+        if restore_from is not None:
+            pulumi.set(__self__, "restore_from", restore_from)
+        if version is not None:
+            pulumi.set(__self__, "version", version)
+
+    @property
+    @pulumi.getter
+    def admin_password(self) -> Optional[pulumi.Input[str]]:
+        """
+        Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        """
+        return pulumi.get(self, "admin_password")
+
+    @admin_password.setter
+    def admin_password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "admin_password", value)
+
+    @property
+    @pulumi.getter
+    def backup(self) -> Optional[pulumi.Input['BackupArgs']]:
+        """
+        Settings for backing up AEM instance files.
+        """
+        return pulumi.get(self, "backup")
+
+    @backup.setter
+    def backup(self, value: Optional[pulumi.Input['BackupArgs']]):
+        pulumi.set(self, "backup", value)
+
+    @property
+    @pulumi.getter
+    def config(self) -> Optional[pulumi.Input[str]]:
+        """
+        Contents of the AEM Compose YML configuration file.
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "config", value)
+
+    @property
+    @pulumi.getter
+    def config_lists_strategy(self) -> Optional[pulumi.Input[str]]:
+        """
+        Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        """
+        return pulumi.get(self, "config_lists_strategy")
+
+    @config_lists_strategy.setter
+    def config_lists_strategy(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "config_lists_strategy", value)
+
+    @property
+    @pulumi.getter
+    def config_overrides(self) -> Optional[Any]:
+        """
+        Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        """
+        return pulumi.get(self, "config_overrides")
+
+    @config_overrides.setter
+    def config_overrides(self, value: Optional[Any]):
+        pulumi.set(self, "config_overrides", value)
+
+    @property
+    @pulumi.getter
+    def configure(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+        """
+        Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+        """
+        return pulumi.get(self, "configure")
+
+    @configure.setter
+    def configure(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+        pulumi.set(self, "configure", value)
+
+    @property
+    @pulumi.getter
+    def create(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+        """
+        Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        """
+        return pulumi.get(self, "create")
+
+    @create.setter
+    def create(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+        pulumi.set(self, "create", value)
+
+    @property
+    @pulumi.getter
+    def delete(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+        """
+        Script(s) for deleting a stopped instance.
+        """
+        return pulumi.get(self, "delete")
+
+    @delete.setter
+    def delete(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+        pulumi.set(self, "delete", value)
+
+    @property
+    @pulumi.getter
+    def download(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        """
+        return pulumi.get(self, "download")
+
+    @download.setter
+    def download(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "download", value)
+
+    @property
+    @pulumi.getter
+    def instances(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ComposeInstanceArgs']]]]:
+        """
+        Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        """
+        return pulumi.get(self, "instances")
+
+    @instances.setter
+    def instances(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ComposeInstanceArgs']]]]):
+        pulumi.set(self, "instances", value)
+
+    @property
+    @pulumi.getter
+    def readiness(self) -> Optional[pulumi.Input['ReadinessArgs']]:
+        """
+        Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        """
+        return pulumi.get(self, "readiness")
+
+    @readiness.setter
+    def readiness(self, value: Optional[pulumi.Input['ReadinessArgs']]):
+        pulumi.set(self, "readiness", value)
+
+    @property
+    @pulumi.getter
+    def restore_from(self) -> Optional[pulumi.Input[str]]:
+        """
+        Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        """
+        return pulumi.get(self, "restore_from")
+
+    @restore_from.setter
+    def restore_from(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "restore_from", value)
+
+    @property
+    @pulumi.getter
+    def version(self) -> Optional[pulumi.Input[str]]:
+        """
+        Version of AEM Compose tool to use on remote machine.
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "version", value)
+
+
+@pulumi.input_type
+class DiagnosticsArgs:
+    def __init__(__self__, *,
+                 log_lines: Optional[pulumi.Input[int]] = None,
+                 output_dir: Optional[pulumi.Input[str]] = None,
+                 summary_lines: Optional[pulumi.Input[int]] = None,
+                 thread_dump: Optional[pulumi.Input[bool]] = None):
+        """
+        :param pulumi.Input[int] log_lines: Number of the last lines of the AEM logs and system journal to be collected.
+        :param pulumi.Input[str] output_dir: Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+        :param pulumi.Input[int] summary_lines: Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+        :param pulumi.Input[bool] thread_dump: Toggle collecting thread dumps of the running AEM instances.
+        """
+        if log_lines is not None:
+            pulumi.set(__self__, "log_lines", log_lines)
+        if output_dir is not None:
+            pulumi.set(__self__, "output_dir", output_dir)
+        if summary_lines is not None:
+            pulumi.set(__self__, "summary_lines", summary_lines)
+        if thread_dump is not None:
+            pulumi.set(__self__, "thread_dump", thread_dump)
+
+    @property
+    @pulumi.getter
+    def log_lines(self) -> Optional[pulumi.Input[int]]:
+        """
+        Number of the last lines of the AEM logs and system journal to be collected.
+        """
+        return pulumi.get(self, "log_lines")
+
+    @log_lines.setter
+    def log_lines(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "log_lines", value)
+
+    @property
+    @pulumi.getter
+    def output_dir(self) -> Optional[pulumi.Input[str]]:
+        """
+        Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+        """
+        return pulumi.get(self, "output_dir")
+
+    @output_dir.setter
+    def output_dir(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "output_dir", value)
+
+    @property
+    @pulumi.getter
+    def summary_lines(self) -> Optional[pulumi.Input[int]]:
+        """
+        Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+        """
+        return pulumi.get(self, "summary_lines")
+
+    @summary_lines.setter
+    def summary_lines(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "summary_lines", value)
+
+    @property
+    @pulumi.getter
+    def thread_dump(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Toggle collecting thread dumps of the running AEM instances.
+        """
+        return pulumi.get(self, "thread_dump")
+
+    @thread_dump.setter
+    def thread_dump(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "thread_dump", value)
+
+
+@pulumi.input_type
+class InstanceScript:
+    def __init__(__self__, *,
+                 inline: Optional[Sequence[str]] = None,
+                 script: Optional[str] = None):
+        """
+        :param Sequence[str] inline: Inline shell commands to be executed
+        :param str script: Multiline shell script to be executed
+        """
+        if inline is not None:
+            pulumi.set(__self__, "inline", inline)
+        if script is not None:
+            pulumi.set(__self__, "script", script)
+
+    @property
+    @pulumi.getter
+    def inline(self) -> Optional[Sequence[str]]:
+        """
+        Inline shell commands to be executed
+        """
+        return pulumi.get(self, "inline")
+
+    @inline.setter
+    def inline(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "inline", value)
+
+    @property
+    @pulumi.getter
+    def script(self) -> Optional[str]:
+        """
+        Multiline shell script to be executed
+        """
+        return pulumi.get(self, "script")
+
+    @script.setter
+    def script(self, value: Optional[str]):
+        pulumi.set(self, "script", value)
+
+
+@pulumi.input_type
+class InstanceScriptArgs:
+    def __init__(__self__, *,
+                 inline: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 script: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] inline: Inline shell commands to be executed
+        :param pulumi.Input[str] script: Multiline shell script to be executed
+        """
+        if inline is not None:
+            pulumi.set(__self__, "inline", inline)
+        if script is not None:
+            pulumi.set(__self__, "script", script)
+
+    @property
+    @pulumi.getter
+    def inline(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Inline shell commands to be executed
+        """
+        return pulumi.get(self, "inline")
+
+    @inline.setter
+    def inline(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "inline", value)
+
+    @property
+    @pulumi.getter
+    def script(self) -> Optional[pulumi.Input[str]]:
+        """
+        Multiline shell script to be executed
+        """
+        return pulumi.get(self, "script")
+
+    @script.setter
+    def script(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "script", value)
+
+
+@pulumi.input_type
+class PreflightArgs:
+    def __init__(__self__, *,
+                 java_version: Optional[pulumi.Input[str]] = None,
+                 min_disk_space: Optional[pulumi.Input[int]] = None,
+                 ports: Optional[pulumi.Input[bool]] = None):
+        """
+        :param pulumi.Input[str] java_version: Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+        :param pulumi.Input[int] min_disk_space: Minimum free disk space in gigabytes required in the data directory.
+        :param pulumi.Input[bool] ports: Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+        """
+        if java_version is not None:
+            pulumi.set(__self__, "java_version", java_version)
+        if min_disk_space is not None:
+            pulumi.set(__self__, "min_disk_space", min_disk_space)
+        if ports is not None:
+            pulumi.set(__self__, "ports", ports)
+
+    @property
+    @pulumi.getter
+    def java_version(self) -> Optional[pulumi.Input[str]]:
+        """
+        Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+        """
+        return pulumi.get(self, "java_version")
+
+    @java_version.setter
+    def java_version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "java_version", value)
+
+    @property
+    @pulumi.getter
+    def min_disk_space(self) -> Optional[pulumi.Input[int]]:
+        """
+        Minimum free disk space in gigabytes required in the data directory.
+        """
+        return pulumi.get(self, "min_disk_space")
+
+    @min_disk_space.setter
+    def min_disk_space(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "min_disk_space", value)
+
+    @property
+    @pulumi.getter
+    def ports(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+        """
+        return pulumi.get(self, "ports")
+
+    @ports.setter
+    def ports(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "ports", value)
+
+
+@pulumi.input_type
+class Readiness:
+    def __init__(__self__, *,
+                 attributes: Optional[Sequence[str]] = None,
+                 interval: Optional[str] = None,
+                 policy: Optional[str] = None,
+                 probes: Optional[Sequence['ReadinessProbe']] = None,
+                 timeout: Optional[str] = None):
+        """
+        :param Sequence[str] attributes: Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        :param str interval: Time between subsequent readiness checks.
+        :param str policy: Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        :param Sequence['ReadinessProbe'] probes: HTTP requests performed against each instance which need to respond with the expected status.
+        :param str timeout: Maximum time to wait for the instances to become ready.
+        """
+        if attributes is not None:
+            pulumi.set(__self__, "attributes", attributes)
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if policy is not None:
+            pulumi.set(__self__, "policy", policy)
+        if probes is not None:
+            pulumi.set(__self__, "probes", probes)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+
+    @property
+    @pulumi.getter
+    def attributes(self) -> Optional[Sequence[str]]:
+        """
+        Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        """
+        return pulumi.get(self, "attributes")
+
+    @attributes.setter
+    def attributes(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "attributes", value)
+
+    @property
+    @pulumi.getter
+    def interval(self) -> Optional[str]:
+        """
+        Time between subsequent readiness checks.
+        """
+        return pulumi.get(self, "interval")
+
+    @interval.setter
+    def interval(self, value: Optional[str]):
+        pulumi.set(self, "interval", value)
+
+    @property
+    @pulumi.getter
+    def policy(self) -> Optional[str]:
+        """
+        Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        """
+        return pulumi.get(self, "policy")
+
+    @policy.setter
+    def policy(self, value: Optional[str]):
+        pulumi.set(self, "policy", value)
+
+    @property
+    @pulumi.getter
+    def probes(self) -> Optional[Sequence['ReadinessProbe']]:
+        """
+        HTTP requests performed against each instance which need to respond with the expected status.
+        """
+        return pulumi.get(self, "probes")
+
+    @probes.setter
+    def probes(self, value: Optional[Sequence['ReadinessProbe']]):
+        pulumi.set(self, "probes", value)
+
+    @property
+    @pulumi.getter
+    def timeout(self) -> Optional[str]:
+        """
+        Maximum time to wait for the instances to become ready.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: Optional[str]):
+        pulumi.set(self, "timeout", value)
+
+
+@pulumi.input_type
+class ReadinessProbe:
+    def __init__(__self__, *,
+                 path: str,
+                 status: Optional[int] = None):
+        """
+        :param str path: Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        :param int status: Expected HTTP status of the response.
+        """
+        pulumi.set(__self__, "path", path)
+        if status is not None:
+            pulumi.set(__self__, "status", status)
+
+    @property
+    @pulumi.getter
+    def path(self) -> str:
+        """
+        Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: str):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def status(self) -> Optional[int]:
+        """
+        Expected HTTP status of the response.
+        """
+        return pulumi.get(self, "status")
+
+    @status.setter
+    def status(self, value: Optional[int]):
+        pulumi.set(self, "status", value)
+
+
+@pulumi.input_type
+class ReadinessProbeArgs:
+    def __init__(__self__, *,
+                 path: pulumi.Input[str],
+                 status: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[str] path: Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        :param pulumi.Input[int] status: Expected HTTP status of the response.
+        """
+        pulumi.set(__self__, "path", path)
+        if status is not None:
+            pulumi.set(__self__, "status", status)
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Input[str]:
+        """
+        Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: pulumi.Input[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def status(self) -> Optional[pulumi.Input[int]]:
+        """
+        Expected HTTP status of the response.
+        """
+        return pulumi.get(self, "status")
+
+    @status.setter
+    def status(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "status", value)
+
+
+@pulumi.input_type
+class ReadinessArgs:
+    def __init__(__self__, *,
+                 attributes: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 interval: Optional[pulumi.Input[str]] = None,
+                 policy: Optional[pulumi.Input[str]] = None,
+                 probes: Optional[pulumi.Input[Sequence[pulumi.Input['ReadinessProbeArgs']]]] = None,
+                 timeout: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] attributes: Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        :param pulumi.Input[str] interval: Time between subsequent readiness checks.
+        :param pulumi.Input[str] policy: Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        :param pulumi.Input[Sequence[pulumi.Input['ReadinessProbeArgs']]] probes: HTTP requests performed against each instance which need to respond with the expected status.
+        :param pulumi.Input[str] timeout: Maximum time to wait for the instances to become ready.
+        """
+        if attributes is not None:
+            pulumi.set(__self__, "attributes", attributes)
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if policy is not None:
+            pulumi.set(__self__, "policy", policy)
+        if probes is not None:
+            pulumi.set(__self__, "probes", probes)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+
+    @property
+    @pulumi.getter
+    def attributes(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        """
+        return pulumi.get(self, "attributes")
+
+    @attributes.setter
+    def attributes(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "attributes", value)
+
+    @property
+    @pulumi.getter
+    def interval(self) -> Optional[pulumi.Input[str]]:
+        """
+        Time between subsequent readiness checks.
+        """
+        return pulumi.get(self, "interval")
+
+    @interval.setter
+    def interval(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "interval", value)
+
+    @property
+    @pulumi.getter
+    def policy(self) -> Optional[pulumi.Input[str]]:
+        """
+        Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        """
+        return pulumi.get(self, "policy")
+
+    @policy.setter
+    def policy(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "policy", value)
+
+    @property
+    @pulumi.getter
+    def probes(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ReadinessProbeArgs']]]]:
+        """
+        HTTP requests performed against each instance which need to respond with the expected status.
+        """
+        return pulumi.get(self, "probes")
+
+    @probes.setter
+    def probes(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ReadinessProbeArgs']]]]):
+        pulumi.set(self, "probes", value)
+
+    @property
+    @pulumi.getter
+    def timeout(self) -> Optional[pulumi.Input[str]]:
+        """
+        Maximum time to wait for the instances to become ready.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "timeout", value)
+
+
+@pulumi.input_type
+class Schedule:
+    def __init__(__self__, *,
+                 name: str,
+                 on_calendar: str,
+                 builtin: Optional[str] = None,
+                 script: Optional['InstanceScript'] = None):
+        """
+        :param str name: Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        :param str on_calendar: Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        :param str builtin: Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        :param 'InstanceScript' script: Script(s) to be executed. Mutually exclusive with 'builtin'.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "on_calendar", on_calendar)
+        if builtin is not None:
+            pulumi.set(__self__, "builtin", builtin)
+        if script is not None:
+            pulumi.set(__self__, "script", script)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def on_calendar(self) -> str:
+        """
+        Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        """
+        return pulumi.get(self, "on_calendar")
+
+    @on_calendar.setter
+    def on_calendar(self, value: str):
+        pulumi.set(self, "on_calendar", value)
+
+    @property
+    @pulumi.getter
+    def builtin(self) -> Optional[str]:
+        """
+        Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        """
+        return pulumi.get(self, "builtin")
+
+    @builtin.setter
+    def builtin(self, value: Optional[str]):
+        pulumi.set(self, "builtin", value)
+
+    @property
+    @pulumi.getter
+    def script(self) -> Optional['InstanceScript']:
+        """
+        Script(s) to be executed. Mutually exclusive with 'builtin'.
+        """
+        return pulumi.get(self, "script")
+
+    @script.setter
+    def script(self, value: Optional['InstanceScript']):
+        pulumi.set(self, "script", value)
+
+
+@pulumi.input_type
+class ScheduleArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[str],
+                 on_calendar: pulumi.Input[str],
+                 builtin: Optional[pulumi.Input[str]] = None,
+                 script: Optional[pulumi.Input['InstanceScriptArgs']] = None):
+        """
+        :param pulumi.Input[str] name: Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        :param pulumi.Input[str] on_calendar: Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        :param pulumi.Input[str] builtin: Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        :param pulumi.Input['InstanceScriptArgs'] script: Script(s) to be executed. Mutually exclusive with 'builtin'.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "on_calendar", on_calendar)
+        if builtin is not None:
+            pulumi.set(__self__, "builtin", builtin)
+        if script is not None:
+            pulumi.set(__self__, "script", script)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def on_calendar(self) -> pulumi.Input[str]:
+        """
+        Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        """
+        return pulumi.get(self, "on_calendar")
+
+    @on_calendar.setter
+    def on_calendar(self, value: pulumi.Input[str]):
+        pulumi.set(self, "on_calendar", value)
+
+    @property
+    @pulumi.getter
+    def builtin(self) -> Optional[pulumi.Input[str]]:
+        """
+        Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        """
+        return pulumi.get(self, "builtin")
+
+    @builtin.setter
+    def builtin(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "builtin", value)
+
+    @property
+    @pulumi.getter
+    def script(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+        """
+        Script(s) to be executed. Mutually exclusive with 'builtin'.
+        """
+        return pulumi.get(self, "script")
+
+    @script.setter
+    def script(self, value: Optional[pulumi.Input['InstanceScriptArgs']]):
+        pulumi.set(self, "script", value)
+
+
+@pulumi.input_type
+class SmokeTestAuthArgs:
+    def __init__(__self__, *,
+                 password: Optional[pulumi.Input[str]] = None,
+                 user: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] password: Password of the user.
+        :param pulumi.Input[str] user: Name of the user. When empty, the request is performed anonymously.
+        """
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[pulumi.Input[str]]:
+        """
+        Password of the user.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "password", value)
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the user. When empty, the request is performed anonymously.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user", value)
+
+
+@pulumi.input_type
+class SmokeTestCheckArgs:
+    def __init__(__self__, *,
+                 path: pulumi.Input[str],
+                 auth: Optional[pulumi.Input['SmokeTestAuthArgs']] = None,
+                 expected_body: Optional[pulumi.Input[str]] = None,
+                 expected_status: Optional[pulumi.Input[int]] = None,
+                 json_path: Optional[pulumi.Input[str]] = None,
+                 json_value: Optional[pulumi.Input[str]] = None,
+                 method: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] path: Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+        :param pulumi.Input['SmokeTestAuthArgs'] auth: Credentials used to perform the request. By default, the credentials of the instance are used.
+        :param pulumi.Input[str] expected_body: Regular expression which the response body needs to match.
+        :param pulumi.Input[int] expected_status: Expected HTTP status of the response.
+        :param pulumi.Input[str] json_path: Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+        :param pulumi.Input[str] json_value: Expected value found at the JSON path.
+        :param pulumi.Input[str] method: HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+        :param pulumi.Input[str] name: Name of the check used in the results. By default, the method and path are used.
+        """
+        pulumi.set(__self__, "path", path)
+        if auth is not None:
+            pulumi.set(__self__, "auth", auth)
+        if expected_body is not None:
+            pulumi.set(__self__, "expected_body", expected_body)
+        if expected_status is not None:
+            pulumi.set(__self__, "expected_status", expected_status)
+        if json_path is not None:
+            pulumi.set(__self__, "json_path", json_path)
+        if json_value is not None:
+            pulumi.set(__self__, "json_value", json_value)
+        if method is not None:
+            pulumi.set(__self__, "method", method)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Input[str]:
+        """
+        Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: pulumi.Input[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def auth(self) -> Optional[pulumi.Input['SmokeTestAuthArgs']]:
+        """
+        Credentials used to perform the request. By default, the credentials of the instance are used.
+        """
+        return pulumi.get(self, "auth")
+
+    @auth.setter
+    def auth(self, value: Optional[pulumi.Input['SmokeTestAuthArgs']]):
+        pulumi.set(self, "auth", value)
+
+    @property
+    @pulumi.getter
+    def expected_body(self) -> Optional[pulumi.Input[str]]:
+        """
+        Regular expression which the response body needs to match.
+        """
+        return pulumi.get(self, "expected_body")
+
+    @expected_body.setter
+    def expected_body(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "expected_body", value)
+
+    @property
+    @pulumi.getter
+    def expected_status(self) -> Optional[pulumi.Input[int]]:
+        """
+        Expected HTTP status of the response.
+        """
+        return pulumi.get(self, "expected_status")
+
+    @expected_status.setter
+    def expected_status(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "expected_status", value)
+
+    @property
+    @pulumi.getter
+    def json_path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+        """
+        return pulumi.get(self, "json_path")
+
+    @json_path.setter
+    def json_path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "json_path", value)
+
+    @property
+    @pulumi.getter
+    def json_value(self) -> Optional[pulumi.Input[str]]:
+        """
+        Expected value found at the JSON path.
+        """
+        return pulumi.get(self, "json_value")
+
+    @json_value.setter
+    def json_value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "json_value", value)
+
+    @property
+    @pulumi.getter
+    def method(self) -> Optional[pulumi.Input[str]]:
+        """
+        HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+        """
+        return pulumi.get(self, "method")
+
+    @method.setter
+    def method(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "method", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the check used in the results. By default, the method and path are used.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+
+@pulumi.input_type
+class System:
+    def __init__(__self__, *,
+                 bootstrap: Optional['InstanceScript'] = None,
+                 data_dir: Optional[str] = None,
+                 env: Optional[Mapping[str, str]] = None,
+                 schedules: Optional[Sequence['Schedule']] = None,
+                 service_config: Optional[str] = None,
+                 user: Optional[str] = None,
+                 work_dir: Optional[str] = None):
+        """
+        :param 'InstanceScript' bootstrap: Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
+        :param str data_dir: Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
+        :param Mapping[str, str] env: Environment variables for AEM instances.
+        :param Sequence['Schedule'] schedules: Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        :param str service_config: Contents of the AEM system service definition file (systemd).
+        :param str user: System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
+        :param str work_dir: Remote root path where provider-related files will be stored.
+        """
+        if bootstrap is not None:
+            pulumi.set(__self__, "bootstrap", bootstrap)
+        if data_dir is not None:
+            pulumi.set(__self__, "data_dir", data_dir)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if schedules is not None:
+            pulumi.set(__self__, "schedules", schedules)
+        if service_config is not None:
+            pulumi.set(__self__, "service_config", service_config)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+        if work_dir is not None:
+            pulumi.set(__self__, "work_dir", work_dir)
+
+    @property
+    @pulumi.getter
+    def bootstrap(self) -> Optional['InstanceScript']:
+        """
+        Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
+        """
+        return pulumi.get(self, "bootstrap")
+
+    @bootstrap.setter
+    def bootstrap(self, value: Optional['InstanceScript']):
+        pulumi.set(self, "bootstrap", value)
+
+    @property
+    @pulumi.getter
+    def data_dir(self) -> Optional[str]:
+        """
+        Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
+        """
+        return pulumi.get(self, "data_dir")
+
+    @data_dir.setter
+    def data_dir(self, value: Optional[str]):
+        pulumi.set(self, "data_dir", value)
+
+    @property
+    @pulumi.getter
+    def env(self) -> Optional[Mapping[str, str]]:
+        """
+        Environment variables for AEM instances.
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "env", value)
+
+    @property
+    @pulumi.getter
+    def schedules(self) -> Optional[Sequence['Schedule']]:
+        """
+        Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        """
+        return pulumi.get(self, "schedules")
+
+    @schedules.setter
+    def schedules(self, value: Optional[Sequence['Schedule']]):
+        pulumi.set(self, "schedules", value)
+
+    @property
+    @pulumi.getter
+    def service_config(self) -> Optional[str]:
+        """
+        Contents of the AEM system service definition file (systemd).
+        """
+        return pulumi.get(self, "service_config")
+
+    @service_config.setter
+    def service_config(self, value: Optional[str]):
+        pulumi.set(self, "service_config", value)
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[str]:
+        """
+        System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[str]):
+        pulumi.set(self, "user", value)
+
+    @property
+    @pulumi.getter
+    def work_dir(self) -> Optional[str]:
+        """
+        Remote root path where provider-related files will be stored.
+        """
+        return pulumi.get(self, "work_dir")
+
+    @work_dir.setter
+    def work_dir(self, value: Optional[str]):
+        pulumi.set(self, "work_dir", value)
+
+
+@pulumi.input_type
+class SystemArgs:
+    def __init__(__self__, *,
+                 bootstrap: Optional[pulumi.Input['InstanceScriptArgs']] = None,
+                 data_dir: Optional[pulumi.Input[str]] = None,
+                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 schedules: Optional[pulumi.Input[Sequence[pulumi.Input['ScheduleArgs']]]] = None,
+                 service_config: Optional[pulumi.Input[str]] = None,
+                 user: Optional[pulumi.Input[str]] = None,
+                 work_dir: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input['InstanceScriptArgs'] bootstrap: Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
+        :param pulumi.Input[str] data_dir: Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] env: Environment variables for AEM instances.
+        :param pulumi.Input[Sequence[pulumi.Input['ScheduleArgs']]] schedules: Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        :param pulumi.Input[str] service_config: Contents of the AEM system service definition file (systemd).
+        :param pulumi.Input[str] user: System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
+        :param pulumi.Input[str] work_dir: Remote root path where provider-related files will be stored.
+        """
+        if bootstrap is not None:
+            pulumi.set(__self__, "bootstrap", bootstrap)
+        if data_dir is not None:
+            pulumi.set(__self__, "data_dir", data_dir)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if schedules is not None:
+            pulumi.set(__self__, "schedules", schedules)
+        if service_config is not None:
+            pulumi.set(__self__, "service_config", service_config)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+        if work_dir is not None:
+            pulumi.set(__self__, "work_dir", work_dir)
+
+    @property
+    @pulumi.getter
+    def bootstrap(self) -> Optional[pulumi.Input['InstanceScriptArgs']]:
+        """
+        Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
         """
         return pulumi.get(self, "bootstrap")
 
@@ -309,6 +2122,18 @@ class SystemArgs:
     def env(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
         pulumi.set(self, "env", value)
 
+    @property
+    @pulumi.getter
+    def schedules(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ScheduleArgs']]]]:
+        """
+        Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        """
+        return pulumi.get(self, "schedules")
+
+    @schedules.setter
+    def schedules(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ScheduleArgs']]]]):
+        pulumi.set(self, "schedules", value)
+
     @property
     @pulumi.getter
     def service_config(self) -> Optional[pulumi.Input[str]]:
@@ -0,0 +1,339 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['AclArgs', 'Acl']
+
+@pulumi.input_type
+class AclArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 path: pulumi.Input[str],
+                 principal: pulumi.Input[str],
+                 allow: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 deny: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None):
+        """
+        The set of arguments for constructing a Acl resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] path: Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+        :param pulumi.Input[str] principal: Identifier of the user or group to which the access control entry applies.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allow: Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] deny: Privileges denied to the principal.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        pulumi.set(__self__, "client", client)
+        pulumi.set(__self__, "path", path)
+        pulumi.set(__self__, "principal", principal)
+        if allow is not None:
+            pulumi.set(__self__, "allow", allow)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if deny is not None:
+            pulumi.set(__self__, "deny", deny)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Input[str]:
+        """
+        Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: pulumi.Input[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def principal(self) -> pulumi.Input[str]:
+        """
+        Identifier of the user or group to which the access control entry applies.
+        """
+        return pulumi.get(self, "principal")
+
+    @principal.setter
+    def principal(self, value: pulumi.Input[str]):
+        pulumi.set(self, "principal", value)
+
+    @property
+    @pulumi.getter
+    def allow(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+        """
+        return pulumi.get(self, "allow")
+
+    @allow.setter
+    def allow(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "allow", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def deny(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Privileges denied to the principal.
+        """
+        return pulumi.get(self, "deny")
+
+    @deny.setter
+    def deny(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "deny", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+
+class Acl(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 allow: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 deny: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 principal: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        """
+        Access control entry of the principal applied to the repository path on the AEM instances. Removed from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allow: Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] deny: Privileges denied to the principal.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] path: Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+        :param pulumi.Input[str] principal: Identifier of the user or group to which the access control entry applies.
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AclArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Access control entry of the principal applied to the repository path on the AEM instances. Removed from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param AclArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AclArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 allow: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 deny: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 principal: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AclArgs.__new__(AclArgs)
+
+            __props__.__dict__["allow"] = allow
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["deny"] = deny
+            __props__.__dict__["instance_ids"] = instance_ids
+            if path is None and not opts.urn:
+                raise TypeError("Missing required property 'path'")
+            __props__.__dict__["path"] = path
+            if principal is None and not opts.urn:
+                raise TypeError("Missing required property 'principal'")
+            __props__.__dict__["principal"] = principal
+            __props__.__dict__["system"] = system
+            __props__.__dict__["instances"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["path", "principal"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(Acl, __self__).__init__(
+            'aem:compose:Acl',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Acl':
+        """
+        Get an existing Acl resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = AclArgs.__new__(AclArgs)
+
+        __props__.__dict__["allow"] = None
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["deny"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["path"] = None
+        __props__.__dict__["principal"] = None
+        __props__.__dict__["system"] = None
+        return Acl(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def allow(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Privileges granted to the principal (e.g. 'jcr:read', 'rep:write', 'crx:replicate').
+        """
+        return pulumi.get(self, "allow")
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def deny(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Privileges denied to the principal.
+        """
+        return pulumi.get(self, "deny")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.AclInstanceModel']]:
+        """
+        Current state of the access control entry on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        Absolute repository path to which the access control entry is applied (e.g. '/content/acme').
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter
+    def principal(self) -> pulumi.Output[str]:
+        """
+        Identifier of the user or group to which the access control entry applies.
+        """
+        return pulumi.get(self, "principal")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
@@ -0,0 +1,102 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'ActivateResult',
+    'AwaitableActivateResult',
+    'activate',
+    'activate_output',
+]
+
+@pulumi.output_type
+class ActivateResult:
+    def __init__(__self__, results=None):
+        if results and not isinstance(results, list):
+            raise TypeError("Expected argument 'results' to be a list")
+        pulumi.set(__self__, "results", results)
+
+    @property
+    @pulumi.getter
+    def results(self) -> Sequence['outputs.ActivateResultItem']:
+        """
+        Replication results per instance and path.
+        """
+        return pulumi.get(self, "results")
+
+
+class AwaitableActivateResult(ActivateResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ActivateResult(
+            results=self.results)
+
+
+def activate(agent: Optional[str] = None,
+             client: Optional[pulumi.InputType['Client']] = None,
+             compose: Optional[pulumi.InputType['Compose']] = None,
+             instance_ids: Optional[Sequence[str]] = None,
+             paths: Optional[Sequence[str]] = None,
+             system: Optional[pulumi.InputType['System']] = None,
+             tree: Optional[bool] = None,
+             opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableActivateResult:
+    """
+    Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.
+
+
+    :param str agent: Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param Sequence[str] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+    :param Sequence[str] paths: Repository paths of the content to be replicated (e.g. '/content/acme').
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param bool tree: Replicate the whole content trees under the paths instead of the single nodes.
+    """
+    __args__ = dict()
+    __args__['agent'] = agent
+    __args__['client'] = client
+    __args__['compose'] = compose
+    __args__['instance_ids'] = instance_ids
+    __args__['paths'] = paths
+    __args__['system'] = system
+    __args__['tree'] = tree
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('aem:compose:activate', __args__, opts=opts, typ=ActivateResult).value
+
+    return AwaitableActivateResult(
+        results=pulumi.get(__ret__, 'results'))
+
+
+@_utilities.lift_output_func(activate)
+def activate_output(agent: Optional[pulumi.Input[Optional[str]]] = None,
+                    client: Optional[pulumi.Input[pulumi.InputType['Client']]] = None,
+                    compose: Optional[pulumi.Input[Optional[pulumi.InputType['Compose']]]] = None,
+                    instance_ids: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                    paths: Optional[pulumi.Input[Sequence[str]]] = None,
+                    system: Optional[pulumi.Input[Optional[pulumi.InputType['System']]]] = None,
+                    tree: Optional[pulumi.Input[Optional[bool]]] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[ActivateResult]:
+    """
+    Replicates (activates) content using the replication agents of the AEM instances, e.g. to publish seed content right after provisioning.
+
+
+    :param str agent: Name of the replication agent to use. By default, all enabled agents are used. Supported only when replicating single nodes.
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param Sequence[str] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+    :param Sequence[str] paths: Repository paths of the content to be replicated (e.g. '/content/acme').
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param bool tree: Replicate the whole content trees under the paths instead of the single nodes.
+    """
+    ...
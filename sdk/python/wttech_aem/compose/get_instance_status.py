@@ -0,0 +1,87 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetInstanceStatusResult',
+    'AwaitableGetInstanceStatusResult',
+    'get_instance_status',
+    'get_instance_status_output',
+]
+
+@pulumi.output_type
+class GetInstanceStatusResult:
+    def __init__(__self__, instances=None):
+        if instances and not isinstance(instances, list):
+            raise TypeError("Expected argument 'instances' to be a list")
+        pulumi.set(__self__, "instances", instances)
+
+    @property
+    @pulumi.getter
+    def instances(self) -> Sequence['outputs.InstanceModel']:
+        """
+        Current state of the AEM instances running on the machine.
+        """
+        return pulumi.get(self, "instances")
+
+
+class AwaitableGetInstanceStatusResult(GetInstanceStatusResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetInstanceStatusResult(
+            instances=self.instances)
+
+
+def get_instance_status(client: Optional[pulumi.InputType['Client']] = None,
+                        compose: Optional[pulumi.InputType['Compose']] = None,
+                        instance_ids: Optional[Sequence[str]] = None,
+                        system: Optional[pulumi.InputType['System']] = None,
+                        opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetInstanceStatusResult:
+    """
+    Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param Sequence[str] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    """
+    __args__ = dict()
+    __args__['client'] = client
+    __args__['compose'] = compose
+    __args__['instance_ids'] = instance_ids
+    __args__['system'] = system
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('aem:compose:getInstanceStatus', __args__, opts=opts, typ=GetInstanceStatusResult).value
+
+    return AwaitableGetInstanceStatusResult(
+        instances=pulumi.get(__ret__, 'instances'))
+
+
+@_utilities.lift_output_func(get_instance_status)
+def get_instance_status_output(client: Optional[pulumi.Input[pulumi.InputType['Client']]] = None,
+                               compose: Optional[pulumi.Input[Optional[pulumi.InputType['Compose']]]] = None,
+                               instance_ids: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                               system: Optional[pulumi.Input[Optional[pulumi.InputType['System']]]] = None,
+                               opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetInstanceStatusResult]:
+    """
+    Reads the status of the AEM instances running on the machine (e.g. to be displayed on dashboards or used by other stacks without owning the 'Instance' resource).
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param Sequence[str] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    """
+    ...
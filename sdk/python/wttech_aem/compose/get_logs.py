@@ -0,0 +1,101 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from ._inputs import *
+
+__all__ = [
+    'GetLogsResult',
+    'AwaitableGetLogsResult',
+    'get_logs',
+    'get_logs_output',
+]
+
+@pulumi.output_type
+class GetLogsResult:
+    def __init__(__self__, content=None):
+        if content and not isinstance(content, str):
+            raise TypeError("Expected argument 'content' to be a str")
+        pulumi.set(__self__, "content", content)
+
+    @property
+    @pulumi.getter
+    def content(self) -> str:
+        """
+        Last lines of the log file.
+        """
+        return pulumi.get(self, "content")
+
+
+class AwaitableGetLogsResult(GetLogsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetLogsResult(
+            content=self.content)
+
+
+def get_logs(client: Optional[pulumi.InputType['Client']] = None,
+             compose: Optional[pulumi.InputType['Compose']] = None,
+             file: Optional[str] = None,
+             filter: Optional[str] = None,
+             instance_id: Optional[str] = None,
+             lines: Optional[int] = None,
+             system: Optional[pulumi.InputType['System']] = None,
+             opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetLogsResult:
+    """
+    Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param str file: Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+    :param str filter: Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+    :param str instance_id: Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+    :param int lines: Number of the last lines to be returned. By default, 100 lines are returned.
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    """
+    __args__ = dict()
+    __args__['client'] = client
+    __args__['compose'] = compose
+    __args__['file'] = file
+    __args__['filter'] = filter
+    __args__['instance_id'] = instance_id
+    __args__['lines'] = lines
+    __args__['system'] = system
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('aem:compose:getLogs', __args__, opts=opts, typ=GetLogsResult).value
+
+    return AwaitableGetLogsResult(
+        content=pulumi.get(__ret__, 'content'))
+
+
+@_utilities.lift_output_func(get_logs)
+def get_logs_output(client: Optional[pulumi.Input[pulumi.InputType['Client']]] = None,
+                    compose: Optional[pulumi.Input[Optional[pulumi.InputType['Compose']]]] = None,
+                    file: Optional[pulumi.Input[Optional[str]]] = None,
+                    filter: Optional[pulumi.Input[Optional[str]]] = None,
+                    instance_id: Optional[pulumi.Input[str]] = None,
+                    lines: Optional[pulumi.Input[Optional[int]]] = None,
+                    system: Optional[pulumi.Input[Optional[pulumi.InputType['System']]]] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetLogsResult]:
+    """
+    Reads the last lines of the AEM instance log file using the connection to the machine (e.g. for troubleshooting without direct access to the machine).
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param str file: Name of the log file in the 'crx-quickstart/logs' directory of the instance. By default, 'error.log' is used.
+    :param str filter: Extended regular expression which the returned lines need to match (applied before limiting the number of lines).
+    :param str instance_id: Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+    :param int lines: Number of the last lines to be returned. By default, 100 lines are returned.
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    """
+    ...
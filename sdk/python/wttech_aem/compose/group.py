@@ -0,0 +1,366 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['GroupArgs', 'Group']
+
+@pulumi.input_type
+class GroupArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 group_id: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 intermediate_path: Optional[pulumi.Input[str]] = None,
+                 members: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None):
+        """
+        The set of arguments for constructing a Group resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] description: Description stored in the group profile.
+        :param pulumi.Input[str] display_name: Display name stored in the group profile.
+        :param pulumi.Input[str] group_id: Identifier of the group. By default, the resource name is used.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] intermediate_path: Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] members: Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        pulumi.set(__self__, "client", client)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if display_name is not None:
+            pulumi.set(__self__, "display_name", display_name)
+        if group_id is not None:
+            pulumi.set(__self__, "group_id", group_id)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if intermediate_path is not None:
+            pulumi.set(__self__, "intermediate_path", intermediate_path)
+        if members is not None:
+            pulumi.set(__self__, "members", members)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[pulumi.Input[str]]:
+        """
+        Description stored in the group profile.
+        """
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "description", value)
+
+    @property
+    @pulumi.getter
+    def display_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Display name stored in the group profile.
+        """
+        return pulumi.get(self, "display_name")
+
+    @display_name.setter
+    def display_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "display_name", value)
+
+    @property
+    @pulumi.getter
+    def group_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Identifier of the group. By default, the resource name is used.
+        """
+        return pulumi.get(self, "group_id")
+
+    @group_id.setter
+    def group_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "group_id", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def intermediate_path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+        """
+        return pulumi.get(self, "intermediate_path")
+
+    @intermediate_path.setter
+    def intermediate_path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "intermediate_path", value)
+
+    @property
+    @pulumi.getter
+    def members(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+        """
+        return pulumi.get(self, "members")
+
+    @members.setter
+    def members(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "members", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+
+class Group(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 group_id: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 intermediate_path: Optional[pulumi.Input[str]] = None,
+                 members: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        """
+        Group created on the AEM instances along with its members. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] description: Description stored in the group profile.
+        :param pulumi.Input[str] display_name: Display name stored in the group profile.
+        :param pulumi.Input[str] group_id: Identifier of the group. By default, the resource name is used.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] intermediate_path: Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] members: Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: GroupArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Group created on the AEM instances along with its members. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param GroupArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(GroupArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 group_id: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 intermediate_path: Optional[pulumi.Input[str]] = None,
+                 members: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = GroupArgs.__new__(GroupArgs)
+
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["description"] = description
+            __props__.__dict__["display_name"] = display_name
+            __props__.__dict__["group_id"] = group_id
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["intermediate_path"] = intermediate_path
+            __props__.__dict__["members"] = members
+            __props__.__dict__["system"] = system
+            __props__.__dict__["instances"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["group_id", "intermediate_path"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(Group, __self__).__init__(
+            'aem:compose:Group',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Group':
+        """
+        Get an existing Group resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = GroupArgs.__new__(GroupArgs)
+
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["description"] = None
+        __props__.__dict__["display_name"] = None
+        __props__.__dict__["group_id"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["intermediate_path"] = None
+        __props__.__dict__["members"] = None
+        __props__.__dict__["system"] = None
+        return Group(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[Optional[str]]:
+        """
+        Description stored in the group profile.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def display_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        Display name stored in the group profile.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter
+    def group_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        Identifier of the group. By default, the resource name is used.
+        """
+        return pulumi.get(self, "group_id")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.GroupInstanceModel']]:
+        """
+        Current state of the group on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def intermediate_path(self) -> pulumi.Output[Optional[str]]:
+        """
+        Path under which the group is created (e.g. '/home/groups/acme'). By default, the path is generated by the repository.
+        """
+        return pulumi.get(self, "intermediate_path")
+
+    @property
+    @pulumi.getter
+    def members(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of the users and groups being members of the group. Members not listed here are kept untouched.
+        """
+        return pulumi.get(self, "members")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
@@ -18,20 +18,28 @@ class InstanceArgs:
     def __init__(__self__, *,
                  client: pulumi.Input['ClientArgs'],
                  compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 diagnostics: Optional[pulumi.Input['DiagnosticsArgs']] = None,
                  files: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 preflight: Optional[pulumi.Input['PreflightArgs']] = None,
                  system: Optional[pulumi.Input['SystemArgs']] = None):
         """
         The set of arguments for constructing a Instance resource.
         :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance will be running.
         :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
+        :param pulumi.Input['DiagnosticsArgs'] diagnostics: Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] files: Files or directories to be copied into the machine.
+        :param pulumi.Input['PreflightArgs'] preflight: Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
         :param pulumi.Input['SystemArgs'] system: Operating system configuration for the machine on which AEM instance will be running.
         """
         pulumi.set(__self__, "client", client)
         if compose is not None:
             pulumi.set(__self__, "compose", compose)
+        if diagnostics is not None:
+            pulumi.set(__self__, "diagnostics", diagnostics)
         if files is not None:
             pulumi.set(__self__, "files", files)
+        if preflight is not None:
+            pulumi.set(__self__, "preflight", preflight)
         if system is not None:
             pulumi.set(__self__, "system", system)
 
@@ -59,6 +67,18 @@ class InstanceArgs:
     def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
         pulumi.set(self, "compose", value)
 
+    @property
+    @pulumi.getter
+    def diagnostics(self) -> Optional[pulumi.Input['DiagnosticsArgs']]:
+        """
+        Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+        """
+        return pulumi.get(self, "diagnostics")
+
+    @diagnostics.setter
+    def diagnostics(self, value: Optional[pulumi.Input['DiagnosticsArgs']]):
+        pulumi.set(self, "diagnostics", value)
+
     @property
     @pulumi.getter
     def files(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
@@ -71,6 +91,18 @@ class InstanceArgs:
     def files(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
         pulumi.set(self, "files", value)
 
+    @property
+    @pulumi.getter
+    def preflight(self) -> Optional[pulumi.Input['PreflightArgs']]:
+        """
+        Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+        """
+        return pulumi.get(self, "preflight")
+
+    @preflight.setter
+    def preflight(self, value: Optional[pulumi.Input['PreflightArgs']]):
+        pulumi.set(self, "preflight", value)
+
     @property
     @pulumi.getter
     def system(self) -> Optional[pulumi.Input['SystemArgs']]:
@@ -91,7 +123,9 @@ class Instance(pulumi.CustomResource):
                  opts: Optional[pulumi.ResourceOptions] = None,
                  client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
                  compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 diagnostics: Optional[pulumi.Input[pulumi.InputType['DiagnosticsArgs']]] = None,
                  files: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 preflight: Optional[pulumi.Input[pulumi.InputType['PreflightArgs']]] = None,
                  system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
                  __props__=None):
         """
@@ -100,7 +134,9 @@ class Instance(pulumi.CustomResource):
         :param pulumi.ResourceOptions opts: Options for the resource.
         :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance will be running.
         :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration. See documentation(https://github.com/wttech/aemc#configuration).
+        :param pulumi.Input[pulumi.InputType['DiagnosticsArgs']] diagnostics: Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] files: Files or directories to be copied into the machine.
+        :param pulumi.Input[pulumi.InputType['PreflightArgs']] preflight: Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
         :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration for the machine on which AEM instance will be running.
         """
         ...
@@ -128,7 +164,9 @@ class Instance(pulumi.CustomResource):
                  opts: Optional[pulumi.ResourceOptions] = None,
                  client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
                  compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 diagnostics: Optional[pulumi.Input[pulumi.InputType['DiagnosticsArgs']]] = None,
                  files: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 preflight: Optional[pulumi.Input[pulumi.InputType['PreflightArgs']]] = None,
                  system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -143,9 +181,15 @@ class Instance(pulumi.CustomResource):
                 raise TypeError("Missing required property 'client'")
             __props__.__dict__["client"] = client
             __props__.__dict__["compose"] = compose
+            __props__.__dict__["diagnostics"] = diagnostics
             __props__.__dict__["files"] = files
+            __props__.__dict__["preflight"] = preflight
             __props__.__dict__["system"] = system
+            __props__.__dict__["backups"] = None
+            __props__.__dict__["effective_config"] = None
             __props__.__dict__["instances"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["effective_config"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Instance, __self__).__init__(
             'aem:compose:Instance',
             resource_name,
@@ -168,13 +212,25 @@ class Instance(pulumi.CustomResource):
 
         __props__ = InstanceArgs.__new__(InstanceArgs)
 
+        __props__.__dict__["backups"] = None
         __props__.__dict__["client"] = None
         __props__.__dict__["compose"] = None
+        __props__.__dict__["diagnostics"] = None
+        __props__.__dict__["effective_config"] = None
         __props__.__dict__["files"] = None
         __props__.__dict__["instances"] = None
+        __props__.__dict__["preflight"] = None
         __props__.__dict__["system"] = None
         return Instance(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter
+    def backups(self) -> pulumi.Output[Sequence['outputs.BackupModel']]:
+        """
+        Backups of the AEM instance files kept in the target location.
+        """
+        return pulumi.get(self, "backups")
+
     @property
     @pulumi.getter
     def client(self) -> pulumi.Output['outputs.Client']:
@@ -191,6 +247,22 @@ class Instance(pulumi.CustomResource):
         """
         return pulumi.get(self, "compose")
 
+    @property
+    @pulumi.getter
+    def diagnostics(self) -> pulumi.Output[Optional['outputs.Diagnostics']]:
+        """
+        Diagnostics collected from the machine when creating or launching the AEM instance fails. Disabled when not set.
+        """
+        return pulumi.get(self, "diagnostics")
+
+    @property
+    @pulumi.getter
+    def effective_config(self) -> pulumi.Output[str]:
+        """
+        Contents of the AEM Compose YML configuration file actually written to the machine (after applying overrides).
+        """
+        return pulumi.get(self, "effective_config")
+
     @property
     @pulumi.getter
     def files(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
@@ -207,6 +279,14 @@ class Instance(pulumi.CustomResource):
         """
         return pulumi.get(self, "instances")
 
+    @property
+    @pulumi.getter
+    def preflight(self) -> pulumi.Output[Optional['outputs.Preflight']]:
+        """
+        Checks of the machine performed before creating the AEM instance, so that problems are reported at once instead of failing later. Disabled when not set.
+        """
+        return pulumi.get(self, "preflight")
+
     @property
     @pulumi.getter
     def system(self) -> pulumi.Output[Optional['outputs.System']]:
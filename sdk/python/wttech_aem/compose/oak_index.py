@@ -0,0 +1,434 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['OakIndexArgs', 'OakIndex']
+
+@pulumi.input_type
+class OakIndexArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 async_: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 children: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 included_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 reindex_timeout: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None,
+                 type: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a OakIndex resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] async_: Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+        :param pulumi.Input[Mapping[str, Any]] children: Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] included_paths: Repository paths covered by the index.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] name: Name of the index definition node under '/oak:index'. By default, the resource name is used.
+        :param pulumi.Input[Mapping[str, Any]] properties: Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+        :param pulumi.Input[str] reindex_timeout: Maximum time to wait for reindexing to complete after the definition is changed.
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] type: Type of the index. Possible values are 'lucene' and 'property'.
+        """
+        pulumi.set(__self__, "client", client)
+        if async_ is not None:
+            pulumi.set(__self__, "async_", async_)
+        if children is not None:
+            pulumi.set(__self__, "children", children)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if included_paths is not None:
+            pulumi.set(__self__, "included_paths", included_paths)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if properties is not None:
+            pulumi.set(__self__, "properties", properties)
+        if reindex_timeout is not None:
+            pulumi.set(__self__, "reindex_timeout", reindex_timeout)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter(name="async")
+    def async_(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+        """
+        return pulumi.get(self, "async_")
+
+    @async_.setter
+    def async_(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "async_", value)
+
+    @property
+    @pulumi.getter
+    def children(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+        """
+        return pulumi.get(self, "children")
+
+    @children.setter
+    def children(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "children", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def included_paths(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Repository paths covered by the index.
+        """
+        return pulumi.get(self, "included_paths")
+
+    @included_paths.setter
+    def included_paths(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "included_paths", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the index definition node under '/oak:index'. By default, the resource name is used.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def properties(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+        """
+        return pulumi.get(self, "properties")
+
+    @properties.setter
+    def properties(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "properties", value)
+
+    @property
+    @pulumi.getter
+    def reindex_timeout(self) -> Optional[pulumi.Input[str]]:
+        """
+        Maximum time to wait for reindexing to complete after the definition is changed.
+        """
+        return pulumi.get(self, "reindex_timeout")
+
+    @reindex_timeout.setter
+    def reindex_timeout(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "reindex_timeout", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[pulumi.Input[str]]:
+        """
+        Type of the index. Possible values are 'lucene' and 'property'.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "type", value)
+
+
+class OakIndex(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 async_: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 children: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 included_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 reindex_timeout: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Oak index definition saved on the AEM instances. Reindexing is triggered when the definition changes and awaited before the operation completes. Removed from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] async_: Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+        :param pulumi.Input[Mapping[str, Any]] children: Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] included_paths: Repository paths covered by the index.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] name: Name of the index definition node under '/oak:index'. By default, the resource name is used.
+        :param pulumi.Input[Mapping[str, Any]] properties: Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+        :param pulumi.Input[str] reindex_timeout: Maximum time to wait for reindexing to complete after the definition is changed.
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] type: Type of the index. Possible values are 'lucene' and 'property'.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: OakIndexArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Oak index definition saved on the AEM instances. Reindexing is triggered when the definition changes and awaited before the operation completes. Removed from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param OakIndexArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(OakIndexArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 async_: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 children: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 included_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 reindex_timeout: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = OakIndexArgs.__new__(OakIndexArgs)
+
+            __props__.__dict__["async_"] = async_
+            __props__.__dict__["children"] = children
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["included_paths"] = included_paths
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["name"] = name
+            __props__.__dict__["properties"] = properties
+            __props__.__dict__["reindex_timeout"] = reindex_timeout
+            __props__.__dict__["system"] = system
+            __props__.__dict__["type"] = type
+            __props__.__dict__["instances"] = None
+            __props__.__dict__["path"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["name"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(OakIndex, __self__).__init__(
+            'aem:compose:OakIndex',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'OakIndex':
+        """
+        Get an existing OakIndex resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = OakIndexArgs.__new__(OakIndexArgs)
+
+        __props__.__dict__["async_"] = None
+        __props__.__dict__["children"] = None
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["included_paths"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["path"] = None
+        __props__.__dict__["properties"] = None
+        __props__.__dict__["reindex_timeout"] = None
+        __props__.__dict__["system"] = None
+        __props__.__dict__["type"] = None
+        return OakIndex(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="async")
+    def async_(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Asynchronous indexing lanes (e.g. ['async', 'nrt']). Set to empty list for synchronous indexes.
+        """
+        return pulumi.get(self, "async_")
+
+    @property
+    @pulumi.getter
+    def children(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Child nodes of the index definition keyed by their relative names (e.g. 'indexRules', 'aggregates').
+        """
+        return pulumi.get(self, "children")
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def included_paths(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Repository paths covered by the index.
+        """
+        return pulumi.get(self, "included_paths")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.OakIndexInstanceModel']]:
+        """
+        Current state of the index on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[str]]:
+        """
+        Name of the index definition node under '/oak:index'. By default, the resource name is used.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        Repository path of the index definition.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter
+    def properties(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Additional properties of the index definition (e.g. 'compatVersion', 'evaluatePathRestrictions', 'propertyNames').
+        """
+        return pulumi.get(self, "properties")
+
+    @property
+    @pulumi.getter
+    def reindex_timeout(self) -> pulumi.Output[Optional[str]]:
+        """
+        Maximum time to wait for reindexing to complete after the definition is changed.
+        """
+        return pulumi.get(self, "reindex_timeout")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Output[Optional[str]]:
+        """
+        Type of the index. Possible values are 'lucene' and 'property'.
+        """
+        return pulumi.get(self, "type")
+
@@ -0,0 +1,348 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['OsgiConfigArgs', 'OsgiConfig']
+
+@pulumi.input_type
+class OsgiConfigArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 properties: pulumi.Input[Mapping[str, Any]],
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 factory_pid: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 pid: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None):
+        """
+        The set of arguments for constructing a OsgiConfig resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Mapping[str, Any]] properties: Properties of the OSGi configuration.
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] factory_pid: Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] name: Name of the factory OSGi configuration instance. By default, the resource name is used.
+        :param pulumi.Input[str] pid: Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        pulumi.set(__self__, "client", client)
+        pulumi.set(__self__, "properties", properties)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if factory_pid is not None:
+            pulumi.set(__self__, "factory_pid", factory_pid)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if pid is not None:
+            pulumi.set(__self__, "pid", pid)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def properties(self) -> pulumi.Input[Mapping[str, Any]]:
+        """
+        Properties of the OSGi configuration.
+        """
+        return pulumi.get(self, "properties")
+
+    @properties.setter
+    def properties(self, value: pulumi.Input[Mapping[str, Any]]):
+        pulumi.set(self, "properties", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def factory_pid(self) -> Optional[pulumi.Input[str]]:
+        """
+        Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+        """
+        return pulumi.get(self, "factory_pid")
+
+    @factory_pid.setter
+    def factory_pid(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "factory_pid", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the factory OSGi configuration instance. By default, the resource name is used.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def pid(self) -> Optional[pulumi.Input[str]]:
+        """
+        Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+        """
+        return pulumi.get(self, "pid")
+
+    @pid.setter
+    def pid(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "pid", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+
+class OsgiConfig(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 factory_pid: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 pid: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        """
+        OSGi configuration saved on the AEM instances. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] factory_pid: Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] name: Name of the factory OSGi configuration instance. By default, the resource name is used.
+        :param pulumi.Input[str] pid: Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+        :param pulumi.Input[Mapping[str, Any]] properties: Properties of the OSGi configuration.
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: OsgiConfigArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        OSGi configuration saved on the AEM instances. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param OsgiConfigArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(OsgiConfigArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 factory_pid: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 pid: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = OsgiConfigArgs.__new__(OsgiConfigArgs)
+
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["factory_pid"] = factory_pid
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["name"] = name
+            __props__.__dict__["pid"] = pid
+            if properties is None and not opts.urn:
+                raise TypeError("Missing required property 'properties'")
+            __props__.__dict__["properties"] = properties
+            __props__.__dict__["system"] = system
+            __props__.__dict__["effective_pid"] = None
+            __props__.__dict__["instances"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["factory_pid", "name", "pid"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(OsgiConfig, __self__).__init__(
+            'aem:compose:OsgiConfig',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'OsgiConfig':
+        """
+        Get an existing OsgiConfig resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = OsgiConfigArgs.__new__(OsgiConfigArgs)
+
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["effective_pid"] = None
+        __props__.__dict__["factory_pid"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["pid"] = None
+        __props__.__dict__["properties"] = None
+        __props__.__dict__["system"] = None
+        return OsgiConfig(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def effective_pid(self) -> pulumi.Output[str]:
+        """
+        Persistent identifier under which the OSGi configuration is saved (for factory configurations in format 'factory_pid~name').
+        """
+        return pulumi.get(self, "effective_pid")
+
+    @property
+    @pulumi.getter
+    def factory_pid(self) -> pulumi.Output[Optional[str]]:
+        """
+        Factory persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.commons.log.LogManager.factory.config'). Mutually exclusive with 'pid'.
+        """
+        return pulumi.get(self, "factory_pid")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.OsgiConfigInstanceModel']]:
+        """
+        Current state of the OSGi configuration on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[str]]:
+        """
+        Name of the factory OSGi configuration instance. By default, the resource name is used.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def pid(self) -> pulumi.Output[Optional[str]]:
+        """
+        Persistent identifier of the OSGi configuration (e.g. 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'). Mutually exclusive with 'factory_pid'.
+        """
+        return pulumi.get(self, "pid")
+
+    @property
+    @pulumi.getter
+    def properties(self) -> pulumi.Output[Mapping[str, Any]]:
+        """
+        Properties of the OSGi configuration.
+        """
+        return pulumi.get(self, "properties")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
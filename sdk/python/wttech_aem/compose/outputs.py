@@ -11,13 +11,305 @@ from .. import _utilities
 from . import outputs
 
 __all__ = [
+    'AclInstanceModel',
+    'ActivateResultItem',
+    'Backup',
+    'BackupModel',
+    'BundleInstanceModel',
     'Client',
     'Compose',
+    'ComposeInstance',
+    'Diagnostics',
+    'GroupInstanceModel',
     'InstanceModel',
     'InstanceScript',
+    'OakIndexInstanceModel',
+    'OsgiConfigInstanceModel',
+    'PackageInstanceModel',
+    'Preflight',
+    'Readiness',
+    'ReadinessProbe',
+    'ReplicationAgentInstanceModel',
+    'RepoNodeInstanceModel',
+    'Schedule',
+    'ScriptInstanceModel',
+    'SmokeTestAuth',
+    'SmokeTestCheck',
+    'SmokeTestResult',
     'System',
+    'UserInstanceModel',
 ]
 
+@pulumi.output_type
+class AclInstanceModel(dict):
+    def __init__(__self__, *,
+                 allow: Sequence[str],
+                 deny: Sequence[str],
+                 id: str):
+        """
+        :param Sequence[str] allow: Privileges granted to the principal on the AEM instance.
+        :param Sequence[str] deny: Privileges denied to the principal on the AEM instance.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        """
+        pulumi.set(__self__, "allow", allow)
+        pulumi.set(__self__, "deny", deny)
+        pulumi.set(__self__, "id", id)
+
+    @property
+    @pulumi.getter
+    def allow(self) -> Sequence[str]:
+        """
+        Privileges granted to the principal on the AEM instance.
+        """
+        return pulumi.get(self, "allow")
+
+    @property
+    @pulumi.getter
+    def deny(self) -> Sequence[str]:
+        """
+        Privileges denied to the principal on the AEM instance.
+        """
+        return pulumi.get(self, "deny")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+
+@pulumi.output_type
+class ActivateResultItem(dict):
+    def __init__(__self__, *,
+                 instance_id: str,
+                 message: str,
+                 path: str,
+                 status: int,
+                 succeeded: bool):
+        """
+        :param str instance_id: Unique identifier of AEM instance on which the replication was requested.
+        :param str message: Response message of the replication request.
+        :param str path: Repository path of the replicated content.
+        :param int status: HTTP status of the replication request.
+        :param bool succeeded: Indicates if the replication request was accepted.
+        """
+        pulumi.set(__self__, "instance_id", instance_id)
+        pulumi.set(__self__, "message", message)
+        pulumi.set(__self__, "path", path)
+        pulumi.set(__self__, "status", status)
+        pulumi.set(__self__, "succeeded", succeeded)
+
+    @property
+    @pulumi.getter
+    def instance_id(self) -> str:
+        """
+        Unique identifier of AEM instance on which the replication was requested.
+        """
+        return pulumi.get(self, "instance_id")
+
+    @property
+    @pulumi.getter
+    def message(self) -> str:
+        """
+        Response message of the replication request.
+        """
+        return pulumi.get(self, "message")
+
+    @property
+    @pulumi.getter
+    def path(self) -> str:
+        """
+        Repository path of the replicated content.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter
+    def status(self) -> int:
+        """
+        HTTP status of the replication request.
+        """
+        return pulumi.get(self, "status")
+
+    @property
+    @pulumi.getter
+    def succeeded(self) -> bool:
+        """
+        Indicates if the replication request was accepted.
+        """
+        return pulumi.get(self, "succeeded")
+
+
+@pulumi.output_type
+class Backup(dict):
+    def __init__(__self__, *,
+                 target: str,
+                 before_delete: Optional[bool] = None,
+                 before_update: Optional[bool] = None,
+                 compression: Optional[str] = None,
+                 retention: Optional[int] = None):
+        """
+        :param str target: Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        :param bool before_delete: Toggle making a backup before deleting the instance.
+        :param bool before_update: Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        :param str compression: Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        :param int retention: Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        """
+        pulumi.set(__self__, "target", target)
+        if before_delete is not None:
+            pulumi.set(__self__, "before_delete", before_delete)
+        if before_update is not None:
+            pulumi.set(__self__, "before_update", before_update)
+        if compression is not None:
+            pulumi.set(__self__, "compression", compression)
+        if retention is not None:
+            pulumi.set(__self__, "retention", retention)
+
+    @property
+    @pulumi.getter
+    def target(self) -> str:
+        """
+        Location to which backup files are uploaded. Could be a remote path on the machine, AWS S3 URL (s3://bucket/path) or Azure Blob Storage URL (https://account.blob.core.windows.net/container/path).
+        """
+        return pulumi.get(self, "target")
+
+    @property
+    @pulumi.getter
+    def before_delete(self) -> Optional[bool]:
+        """
+        Toggle making a backup before deleting the instance.
+        """
+        return pulumi.get(self, "before_delete")
+
+    @property
+    @pulumi.getter
+    def before_update(self) -> Optional[bool]:
+        """
+        Toggle making a backup before applying changes to the instance. Note that the instance is stopped while the backup is made.
+        """
+        return pulumi.get(self, "before_update")
+
+    @property
+    @pulumi.getter
+    def compression(self) -> Optional[str]:
+        """
+        Compression of the backup files. Possible values are 'gzip', 'zstd' and 'none'.
+        """
+        return pulumi.get(self, "compression")
+
+    @property
+    @pulumi.getter
+    def retention(self) -> Optional[int]:
+        """
+        Number of the most recent backups to keep. Older ones are deleted from the target. Set to 0 to keep all of them.
+        """
+        return pulumi.get(self, "retention")
+
+
+@pulumi.output_type
+class BackupModel(dict):
+    def __init__(__self__, *,
+                 checksum: str,
+                 location: str,
+                 name: str,
+                 size: int,
+                 timestamp: str):
+        """
+        :param str checksum: SHA-256 checksum of the backup file.
+        :param str location: Location of the uploaded backup file. Could be used as 'restore_from' value.
+        :param str name: Name of the backup file.
+        :param int size: Size of the backup file in bytes.
+        :param str timestamp: Time at which the backup was made (RFC 3339, UTC).
+        """
+        pulumi.set(__self__, "checksum", checksum)
+        pulumi.set(__self__, "location", location)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "size", size)
+        pulumi.set(__self__, "timestamp", timestamp)
+
+    @property
+    @pulumi.getter
+    def checksum(self) -> str:
+        """
+        SHA-256 checksum of the backup file.
+        """
+        return pulumi.get(self, "checksum")
+
+    @property
+    @pulumi.getter
+    def location(self) -> str:
+        """
+        Location of the uploaded backup file. Could be used as 'restore_from' value.
+        """
+        return pulumi.get(self, "location")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        Name of the backup file.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def size(self) -> int:
+        """
+        Size of the backup file in bytes.
+        """
+        return pulumi.get(self, "size")
+
+    @property
+    @pulumi.getter
+    def timestamp(self) -> str:
+        """
+        Time at which the backup was made (RFC 3339, UTC).
+        """
+        return pulumi.get(self, "timestamp")
+
+
+@pulumi.output_type
+class BundleInstanceModel(dict):
+    def __init__(__self__, *,
+                 id: str,
+                 state: str,
+                 version: str):
+        """
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param str state: State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').
+        :param str version: Version of the bundle installed on the AEM instance.
+        """
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "state", state)
+        pulumi.set(__self__, "version", version)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def state(self) -> str:
+        """
+        State of the bundle on the AEM instance ('active', 'stopped' or 'uninstalled').
+        """
+        return pulumi.get(self, "state")
+
+    @property
+    @pulumi.getter
+    def version(self) -> str:
+        """
+        Version of the bundle installed on the AEM instance.
+        """
+        return pulumi.get(self, "version")
+
+
 @pulumi.output_type
 class Client(dict):
     def __init__(__self__, *,
@@ -25,245 +317,1277 @@ class Client(dict):
                  type: str,
                  action_timeout: Optional[str] = None,
                  credentials: Optional[Mapping[str, str]] = None,
+                 port_forward: Optional[bool] = None,
                  state_timeout: Optional[str] = None):
         """
-        :param Mapping[str, str] settings: Settings for the connection type
-        :param str type: Type of connection to use to connect to the machine on which AEM instance will be running.
-        :param str action_timeout: Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
-        :param Mapping[str, str] credentials: Credentials for the connection type
-        :param str state_timeout: Used when reading the AEM instance state when determining the plan.
+        :param Mapping[str, str] settings: Settings for the connection type
+        :param str type: Type of connection to use to connect to the machine on which AEM instance will be running.
+        :param str action_timeout: Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+        :param Mapping[str, str] credentials: Credentials for the connection type
+        :param bool port_forward: Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        :param str state_timeout: Used when reading the AEM instance state when determining the plan.
+        """
+        pulumi.set(__self__, "settings", settings)
+        pulumi.set(__self__, "type", type)
+        if action_timeout is not None:
+            pulumi.set(__self__, "action_timeout", action_timeout)
+        if credentials is not None:
+            pulumi.set(__self__, "credentials", credentials)
+        if port_forward is not None:
+            pulumi.set(__self__, "port_forward", port_forward)
+        if state_timeout is not None:
+            pulumi.set(__self__, "state_timeout", state_timeout)
+
+    @property
+    @pulumi.getter
+    def settings(self) -> Mapping[str, str]:
+        """
+        Settings for the connection type
+        """
+        return pulumi.get(self, "settings")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        Type of connection to use to connect to the machine on which AEM instance will be running.
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def action_timeout(self) -> Optional[str]:
+        """
+        Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+        """
+        return pulumi.get(self, "action_timeout")
+
+    @property
+    @pulumi.getter
+    def credentials(self) -> Optional[Mapping[str, str]]:
+        """
+        Credentials for the connection type
+        """
+        return pulumi.get(self, "credentials")
+
+    @property
+    @pulumi.getter
+    def port_forward(self) -> Optional[bool]:
+        """
+        Perform HTTP requests to AEM directly from the provider through the port forwarded by the connection (SSH tunnel or SSM port forwarding session) instead of using curl on the machine. AWS SSM requires Session Manager plugin installed locally.
+        """
+        return pulumi.get(self, "port_forward")
+
+    @property
+    @pulumi.getter
+    def state_timeout(self) -> Optional[str]:
+        """
+        Used when reading the AEM instance state when determining the plan.
+        """
+        return pulumi.get(self, "state_timeout")
+
+
+@pulumi.output_type
+class Compose(dict):
+    def __init__(__self__, *,
+                 admin_password: Optional[str] = None,
+                 backup: Optional['outputs.Backup'] = None,
+                 config: Optional[str] = None,
+                 config_lists_strategy: Optional[str] = None,
+                 config_overrides: Optional[Any] = None,
+                 configure: Optional['outputs.InstanceScript'] = None,
+                 create: Optional['outputs.InstanceScript'] = None,
+                 delete: Optional['outputs.InstanceScript'] = None,
+                 download: Optional[bool] = None,
+                 instances: Optional[Sequence['outputs.ComposeInstance']] = None,
+                 readiness: Optional['outputs.Readiness'] = None,
+                 restore_from: Optional[str] = None,
+                 version: Optional[str] = None):
+        """
+        :param str admin_password: Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        :param 'Backup' backup: Settings for backing up AEM instance files.
+        :param str config: Contents of the AEM Compose YML configuration file.
+        :param str config_lists_strategy: Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        :param Any config_overrides: Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        :param 'InstanceScript' configure: Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+        :param 'InstanceScript' create: Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        :param 'InstanceScript' delete: Script(s) for deleting a stopped instance.
+        :param bool download: Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        :param Sequence['ComposeInstance'] instances: Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        :param 'Readiness' readiness: Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        :param str restore_from: Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        :param str version: Version of AEM Compose tool to use on remote machine.
+        """
+        if admin_password is not None:
+            pulumi.set(__self__, "admin_password", admin_password)
+        if backup is not None:
+            pulumi.set(__self__, "backup", backup)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if config_lists_strategy is not None:
+            pulumi.set(__self__, "config_lists_strategy", config_lists_strategy)
+        if config_overrides is not None:
+            pulumi.set(__self__, "config_overrides", config_overrides)
+        if configure is not None:
+            pulumi.set(__self__, "configure", configure)
+        if create is not None:
+            pulumi.set(__self__, "create", create)
+        if delete is not None:
+            pulumi.set(__self__, "delete", delete)
+        if download is not None:
+            pulumi.set(__self__, "download", download)
+        if instances is not None:
+            pulumi.set(__self__, "instances", instances)
+        if readiness is not None:
+            pulumi.set(__self__, "readiness", readiness)
+        if restore_from is not None:
+            pulumi.set(__self__, "restore_from", restore_from)
+        if version is not None:
+            pulumi.set(__self__, "version", version)
+
+    @property
+    @pulumi.getter
+    def admin_password(self) -> Optional[str]:
+        """
+        Password of the 'admin' user set for all AEM instances defined in the configuration which communicate using this user (also when no user is set). Instances using other users keep their passwords. Takes precedence over the passwords defined in 'config' and 'config_overrides', and cannot be combined with the admin ones in 'instances'. Defined in 'compose' (not directly on the instance), so that the resources using the same compose settings (e.g. 'Package', 'OsgiConfig') authenticate with it too. When changed (here or in any of these places), the password is rotated on the running instances before the configuration file is rewritten, so that the instances do not need to be recreated.
+        """
+        return pulumi.get(self, "admin_password")
+
+    @property
+    @pulumi.getter
+    def backup(self) -> Optional['outputs.Backup']:
+        """
+        Settings for backing up AEM instance files.
+        """
+        return pulumi.get(self, "backup")
+
+    @property
+    @pulumi.getter
+    def config(self) -> Optional[str]:
+        """
+        Contents of the AEM Compose YML configuration file.
+        """
+        return pulumi.get(self, "config")
+
+    @property
+    @pulumi.getter
+    def config_lists_strategy(self) -> Optional[str]:
+        """
+        Strategy of merging lists when applying configuration overrides. Possible values are 'replace' (lists from overrides replace the original ones) and 'append' (items from overrides are added to the original lists).
+        """
+        return pulumi.get(self, "config_lists_strategy")
+
+    @property
+    @pulumi.getter
+    def config_overrides(self) -> Optional[Any]:
+        """
+        Partial AEM Compose YML configuration (YAML string or structured map) deep-merged over the default configuration (or the one set by 'config'). Allows to change only selected options while keeping the remaining defaults up-to-date.
+        """
+        return pulumi.get(self, "config_overrides")
+
+    @property
+    @pulumi.getter
+    def configure(self) -> Optional['outputs.InstanceScript']:
+        """
+        Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc. OSGi configurations and replication agents could be also managed using the 'OsgiConfig' and 'ReplicationAgent' resources.
+        """
+        return pulumi.get(self, "configure")
+
+    @property
+    @pulumi.getter
+    def create(self) -> Optional['outputs.InstanceScript']:
+        """
+        Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        """
+        return pulumi.get(self, "create")
+
+    @property
+    @pulumi.getter
+    def delete(self) -> Optional['outputs.InstanceScript']:
+        """
+        Script(s) for deleting a stopped instance.
+        """
+        return pulumi.get(self, "delete")
+
+    @property
+    @pulumi.getter
+    def download(self) -> Optional[bool]:
+        """
+        Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        """
+        return pulumi.get(self, "download")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> Optional[Sequence['outputs.ComposeInstance']]:
+        """
+        Definitions of AEM instances rendered into the 'instance.config' section of the AEM Compose YML configuration. If set, they replace instances defined in 'config' and 'config_overrides'.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def readiness(self) -> Optional['outputs.Readiness']:
+        """
+        Conditions which need to be met by the instances after launching them for the operation to be considered successful. By default, the instances are not awaited.
+        """
+        return pulumi.get(self, "readiness")
+
+    @property
+    @pulumi.getter
+    def restore_from(self) -> Optional[str]:
+        """
+        Location of the backup file (local path, AWS S3 or Azure Blob Storage URL) from which the instance is restored. If set, it is used instead of the 'create' script. Only used when the instance is created, so changing it later does not affect the existing instance.
+        """
+        return pulumi.get(self, "restore_from")
+
+    @property
+    @pulumi.getter
+    def version(self) -> Optional[str]:
+        """
+        Version of AEM Compose tool to use on remote machine.
+        """
+        return pulumi.get(self, "version")
+
+
+@pulumi.output_type
+class ComposeInstance(dict):
+    def __init__(__self__, *,
+                 http_url: str,
+                 id: str,
+                 env_vars: Optional[Sequence[str]] = None,
+                 jvm_opts: Optional[Sequence[str]] = None,
+                 password: Optional[str] = None,
+                 run_modes: Optional[Sequence[str]] = None,
+                 secret_vars: Optional[Sequence[str]] = None,
+                 sling_props: Optional[Sequence[str]] = None,
+                 start_opts: Optional[Sequence[str]] = None,
+                 user: Optional[str] = None):
+        """
+        :param str http_url: The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        :param str id: Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        :param Sequence[str] env_vars: Environment variables set for the AEM instance process (in format 'NAME=value').
+        :param Sequence[str] jvm_opts: JVM options passed to the AEM instance process.
+        :param str password: Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        :param Sequence[str] run_modes: Run modes of the AEM instance.
+        :param Sequence[str] secret_vars: Secret variables set for the AEM instance process (in format 'NAME=value').
+        :param Sequence[str] sling_props: Sling properties set for the AEM instance (in format 'name=value').
+        :param Sequence[str] start_opts: Options passed to the AEM instance start script.
+        :param str user: User used to communicate with the AEM instance.
+        """
+        pulumi.set(__self__, "http_url", http_url)
+        pulumi.set(__self__, "id", id)
+        if env_vars is not None:
+            pulumi.set(__self__, "env_vars", env_vars)
+        if jvm_opts is not None:
+            pulumi.set(__self__, "jvm_opts", jvm_opts)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if run_modes is not None:
+            pulumi.set(__self__, "run_modes", run_modes)
+        if secret_vars is not None:
+            pulumi.set(__self__, "secret_vars", secret_vars)
+        if sling_props is not None:
+            pulumi.set(__self__, "sling_props", sling_props)
+        if start_opts is not None:
+            pulumi.set(__self__, "start_opts", start_opts)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @property
+    @pulumi.getter
+    def http_url(self) -> str:
+        """
+        The machine-internal HTTP URL address used for communication with the AEM instance (e.g. 'http://127.0.0.1:4502').
+        """
+        return pulumi.get(self, "http_url")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance (e.g. 'local_author', 'local_publish').
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def env_vars(self) -> Optional[Sequence[str]]:
+        """
+        Environment variables set for the AEM instance process (in format 'NAME=value').
+        """
+        return pulumi.get(self, "env_vars")
+
+    @property
+    @pulumi.getter
+    def jvm_opts(self) -> Optional[Sequence[str]]:
+        """
+        JVM options passed to the AEM instance process.
+        """
+        return pulumi.get(self, "jvm_opts")
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[str]:
+        """
+        Password of the user used to communicate with the AEM instance. Cannot be set for the admin user when 'admin_password' is set.
+        """
+        return pulumi.get(self, "password")
+
+    @property
+    @pulumi.getter
+    def run_modes(self) -> Optional[Sequence[str]]:
+        """
+        Run modes of the AEM instance.
+        """
+        return pulumi.get(self, "run_modes")
+
+    @property
+    @pulumi.getter
+    def secret_vars(self) -> Optional[Sequence[str]]:
+        """
+        Secret variables set for the AEM instance process (in format 'NAME=value').
+        """
+        return pulumi.get(self, "secret_vars")
+
+    @property
+    @pulumi.getter
+    def sling_props(self) -> Optional[Sequence[str]]:
+        """
+        Sling properties set for the AEM instance (in format 'name=value').
+        """
+        return pulumi.get(self, "sling_props")
+
+    @property
+    @pulumi.getter
+    def start_opts(self) -> Optional[Sequence[str]]:
+        """
+        Options passed to the AEM instance start script.
+        """
+        return pulumi.get(self, "start_opts")
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[str]:
+        """
+        User used to communicate with the AEM instance.
+        """
+        return pulumi.get(self, "user")
+
+
+@pulumi.output_type
+class Diagnostics(dict):
+    def __init__(__self__, *,
+                 log_lines: Optional[int] = None,
+                 output_dir: Optional[str] = None,
+                 summary_lines: Optional[int] = None,
+                 thread_dump: Optional[bool] = None):
+        """
+        :param int log_lines: Number of the last lines of the AEM logs and system journal to be collected.
+        :param str output_dir: Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+        :param int summary_lines: Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+        :param bool thread_dump: Toggle collecting thread dumps of the running AEM instances.
+        """
+        if log_lines is not None:
+            pulumi.set(__self__, "log_lines", log_lines)
+        if output_dir is not None:
+            pulumi.set(__self__, "output_dir", output_dir)
+        if summary_lines is not None:
+            pulumi.set(__self__, "summary_lines", summary_lines)
+        if thread_dump is not None:
+            pulumi.set(__self__, "thread_dump", thread_dump)
+
+    @property
+    @pulumi.getter
+    def log_lines(self) -> Optional[int]:
+        """
+        Number of the last lines of the AEM logs and system journal to be collected.
+        """
+        return pulumi.get(self, "log_lines")
+
+    @property
+    @pulumi.getter
+    def output_dir(self) -> Optional[str]:
+        """
+        Local directory in which the tarball with all collected diagnostics is saved (e.g. to be archived by CI). When not set, only the summary is reported.
+        """
+        return pulumi.get(self, "output_dir")
+
+    @property
+    @pulumi.getter
+    def summary_lines(self) -> Optional[int]:
+        """
+        Number of the last lines of the AEM error log per instance to be included in the error message. Set to 0 to skip the summary.
+        """
+        return pulumi.get(self, "summary_lines")
+
+    @property
+    @pulumi.getter
+    def thread_dump(self) -> Optional[bool]:
+        """
+        Toggle collecting thread dumps of the running AEM instances.
+        """
+        return pulumi.get(self, "thread_dump")
+
+
+@pulumi.output_type
+class GroupInstanceModel(dict):
+    def __init__(__self__, *,
+                 exists: bool,
+                 id: str,
+                 members: Sequence[str],
+                 path: str):
+        """
+        :param bool exists: Indicates if the group exists on the AEM instance.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param Sequence[str] members: Identifiers of the direct members of the group on the AEM instance.
+        :param str path: Repository path of the group on the AEM instance.
+        """
+        pulumi.set(__self__, "exists", exists)
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "members", members)
+        pulumi.set(__self__, "path", path)
+
+    @property
+    @pulumi.getter
+    def exists(self) -> bool:
+        """
+        Indicates if the group exists on the AEM instance.
+        """
+        return pulumi.get(self, "exists")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def members(self) -> Sequence[str]:
+        """
+        Identifiers of the direct members of the group on the AEM instance.
+        """
+        return pulumi.get(self, "members")
+
+    @property
+    @pulumi.getter
+    def path(self) -> str:
+        """
+        Repository path of the group on the AEM instance.
+        """
+        return pulumi.get(self, "path")
+
+
+@pulumi.output_type
+class InstanceModel(dict):
+    def __init__(__self__, *,
+                 aem_version: str,
+                 attributes: Sequence[str],
+                 created: bool,
+                 debug_port: int,
+                 dir: str,
+                 health_checks: Sequence[str],
+                 http_port: int,
+                 id: str,
+                 reachable: bool,
+                 run_modes: Sequence[str],
+                 running: bool,
+                 up_to_date: bool,
+                 url: str):
+        """
+        :param str aem_version: Version of the AEM instance. Reflects service pack installations.
+        :param Sequence[str] attributes: A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
+        :param bool created: Indicates if the AEM instance files are created on the machine.
+        :param int debug_port: Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.
+        :param str dir: Remote path in which AEM instance is stored.
+        :param Sequence[str] health_checks: A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.
+        :param int http_port: Port on which the AEM instance accepts HTTP requests.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param bool reachable: Indicates if the AEM instance is running and responds to HTTP requests.
+        :param Sequence[str] run_modes: A list of run modes for a specific AEM instance.
+        :param bool running: Indicates if the AEM instance process is running.
+        :param bool up_to_date: Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).
+        :param str url: The machine-internal HTTP URL address used for communication with the AEM instance.
+        """
+        pulumi.set(__self__, "aem_version", aem_version)
+        pulumi.set(__self__, "attributes", attributes)
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "debug_port", debug_port)
+        pulumi.set(__self__, "dir", dir)
+        pulumi.set(__self__, "health_checks", health_checks)
+        pulumi.set(__self__, "http_port", http_port)
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "reachable", reachable)
+        pulumi.set(__self__, "run_modes", run_modes)
+        pulumi.set(__self__, "running", running)
+        pulumi.set(__self__, "up_to_date", up_to_date)
+        pulumi.set(__self__, "url", url)
+
+    @property
+    @pulumi.getter
+    def aem_version(self) -> str:
+        """
+        Version of the AEM instance. Reflects service pack installations.
+        """
+        return pulumi.get(self, "aem_version")
+
+    @property
+    @pulumi.getter
+    def attributes(self) -> Sequence[str]:
+        """
+        A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
+        """
+        return pulumi.get(self, "attributes")
+
+    @property
+    @pulumi.getter
+    def created(self) -> bool:
+        """
+        Indicates if the AEM instance files are created on the machine.
+        """
+        return pulumi.get(self, "created")
+
+    @property
+    @pulumi.getter
+    def debug_port(self) -> int:
+        """
+        Port on which the AEM instance accepts Java debugger connections. Equals 0 if debugging is not enabled in JVM options.
+        """
+        return pulumi.get(self, "debug_port")
+
+    @property
+    @pulumi.getter
+    def dir(self) -> str:
+        """
+        Remote path in which AEM instance is stored.
+        """
+        return pulumi.get(self, "dir")
+
+    @property
+    @pulumi.getter
+    def health_checks(self) -> Sequence[str]:
+        """
+        A list of failed health checks of a specific AEM instance (e.g. inactive bundles, unstable events). Empty when the instance is healthy.
+        """
+        return pulumi.get(self, "health_checks")
+
+    @property
+    @pulumi.getter
+    def http_port(self) -> int:
+        """
+        Port on which the AEM instance accepts HTTP requests.
+        """
+        return pulumi.get(self, "http_port")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def reachable(self) -> bool:
+        """
+        Indicates if the AEM instance is running and responds to HTTP requests.
+        """
+        return pulumi.get(self, "reachable")
+
+    @property
+    @pulumi.getter
+    def run_modes(self) -> Sequence[str]:
+        """
+        A list of run modes for a specific AEM instance.
+        """
+        return pulumi.get(self, "run_modes")
+
+    @property
+    @pulumi.getter
+    def running(self) -> bool:
+        """
+        Indicates if the AEM instance process is running.
+        """
+        return pulumi.get(self, "running")
+
+    @property
+    @pulumi.getter
+    def up_to_date(self) -> bool:
+        """
+        Indicates if the AEM instance is running with the current configuration (e.g. JVM options, run modes).
+        """
+        return pulumi.get(self, "up_to_date")
+
+    @property
+    @pulumi.getter
+    def url(self) -> str:
+        """
+        The machine-internal HTTP URL address used for communication with the AEM instance.
+        """
+        return pulumi.get(self, "url")
+
+
+@pulumi.output_type
+class InstanceScript(dict):
+    def __init__(__self__, *,
+                 inline: Optional[Sequence[str]] = None,
+                 script: Optional[str] = None):
+        """
+        :param Sequence[str] inline: Inline shell commands to be executed
+        :param str script: Multiline shell script to be executed
+        """
+        if inline is not None:
+            pulumi.set(__self__, "inline", inline)
+        if script is not None:
+            pulumi.set(__self__, "script", script)
+
+    @property
+    @pulumi.getter
+    def inline(self) -> Optional[Sequence[str]]:
+        """
+        Inline shell commands to be executed
+        """
+        return pulumi.get(self, "inline")
+
+    @property
+    @pulumi.getter
+    def script(self) -> Optional[str]:
+        """
+        Multiline shell script to be executed
+        """
+        return pulumi.get(self, "script")
+
+
+@pulumi.output_type
+class OakIndexInstanceModel(dict):
+    def __init__(__self__, *,
+                 exists: bool,
+                 id: str,
+                 reindex_count: int,
+                 reindexing: bool):
+        """
+        :param bool exists: Indicates if the index definition exists on the AEM instance.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param int reindex_count: Number of times the index was rebuilt on the AEM instance.
+        :param bool reindexing: Indicates if the index is being rebuilt on the AEM instance.
+        """
+        pulumi.set(__self__, "exists", exists)
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "reindex_count", reindex_count)
+        pulumi.set(__self__, "reindexing", reindexing)
+
+    @property
+    @pulumi.getter
+    def exists(self) -> bool:
+        """
+        Indicates if the index definition exists on the AEM instance.
+        """
+        return pulumi.get(self, "exists")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def reindex_count(self) -> int:
+        """
+        Number of times the index was rebuilt on the AEM instance.
+        """
+        return pulumi.get(self, "reindex_count")
+
+    @property
+    @pulumi.getter
+    def reindexing(self) -> bool:
+        """
+        Indicates if the index is being rebuilt on the AEM instance.
+        """
+        return pulumi.get(self, "reindexing")
+
+
+@pulumi.output_type
+class OsgiConfigInstanceModel(dict):
+    def __init__(__self__, *,
+                 id: str,
+                 properties: Mapping[str, Any]):
+        """
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param Mapping[str, Any] properties: Properties of the OSGi configuration read from the AEM instance.
+        """
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "properties", properties)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def properties(self) -> Mapping[str, Any]:
+        """
+        Properties of the OSGi configuration read from the AEM instance.
+        """
+        return pulumi.get(self, "properties")
+
+
+@pulumi.output_type
+class PackageInstanceModel(dict):
+    def __init__(__self__, *,
+                 id: str,
+                 installed: bool,
+                 last_unpacked: str):
+        """
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param bool installed: Indicates if the package is installed on the AEM instance.
+        :param str last_unpacked: Time at which the package was installed on the AEM instance.
+        """
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "installed", installed)
+        pulumi.set(__self__, "last_unpacked", last_unpacked)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def installed(self) -> bool:
+        """
+        Indicates if the package is installed on the AEM instance.
+        """
+        return pulumi.get(self, "installed")
+
+    @property
+    @pulumi.getter
+    def last_unpacked(self) -> str:
+        """
+        Time at which the package was installed on the AEM instance.
+        """
+        return pulumi.get(self, "last_unpacked")
+
+
+@pulumi.output_type
+class Preflight(dict):
+    def __init__(__self__, *,
+                 java_version: Optional[str] = None,
+                 min_disk_space: Optional[int] = None,
+                 ports: Optional[bool] = None):
+        """
+        :param str java_version: Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+        :param int min_disk_space: Minimum free disk space in gigabytes required in the data directory.
+        :param bool ports: Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+        """
+        if java_version is not None:
+            pulumi.set(__self__, "java_version", java_version)
+        if min_disk_space is not None:
+            pulumi.set(__self__, "min_disk_space", min_disk_space)
+        if ports is not None:
+            pulumi.set(__self__, "ports", ports)
+
+    @property
+    @pulumi.getter
+    def java_version(self) -> Optional[str]:
+        """
+        Major version of Java runtime required to be available on the machine (e.g. '11'). Not checked when not set, e.g. when Java is downloaded by AEM Compose CLI.
+        """
+        return pulumi.get(self, "java_version")
+
+    @property
+    @pulumi.getter
+    def min_disk_space(self) -> Optional[int]:
+        """
+        Minimum free disk space in gigabytes required in the data directory.
+        """
+        return pulumi.get(self, "min_disk_space")
+
+    @property
+    @pulumi.getter
+    def ports(self) -> Optional[bool]:
+        """
+        Toggle checking if the ports of the local AEM instances defined in the configuration are free.
+        """
+        return pulumi.get(self, "ports")
+
+
+@pulumi.output_type
+class Readiness(dict):
+    def __init__(__self__, *,
+                 attributes: Optional[Sequence[str]] = None,
+                 interval: Optional[str] = None,
+                 policy: Optional[str] = None,
+                 probes: Optional[Sequence['outputs.ReadinessProbe']] = None,
+                 timeout: Optional[str] = None):
+        """
+        :param Sequence[str] attributes: Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        :param str interval: Time between subsequent readiness checks.
+        :param str policy: Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        :param Sequence['ReadinessProbe'] probes: HTTP requests performed against each instance which need to respond with the expected status.
+        :param str timeout: Maximum time to wait for the instances to become ready.
+        """
+        if attributes is not None:
+            pulumi.set(__self__, "attributes", attributes)
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if policy is not None:
+            pulumi.set(__self__, "policy", policy)
+        if probes is not None:
+            pulumi.set(__self__, "probes", probes)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+
+    @property
+    @pulumi.getter
+    def attributes(self) -> Optional[Sequence[str]]:
+        """
+        Attributes required to be met by each instance. Possible values are 'created', 'running', 'reachable', 'up-to-date' and 'healthy' (no failed health checks).
+        """
+        return pulumi.get(self, "attributes")
+
+    @property
+    @pulumi.getter
+    def interval(self) -> Optional[str]:
+        """
+        Time between subsequent readiness checks.
+        """
+        return pulumi.get(self, "interval")
+
+    @property
+    @pulumi.getter
+    def policy(self) -> Optional[str]:
+        """
+        Determines what happens when the instances do not become ready in time. Possible values are 'fail' (operation fails) and 'warn' (only a warning is logged).
+        """
+        return pulumi.get(self, "policy")
+
+    @property
+    @pulumi.getter
+    def probes(self) -> Optional[Sequence['outputs.ReadinessProbe']]:
+        """
+        HTTP requests performed against each instance which need to respond with the expected status.
+        """
+        return pulumi.get(self, "probes")
+
+    @property
+    @pulumi.getter
+    def timeout(self) -> Optional[str]:
+        """
+        Maximum time to wait for the instances to become ready.
+        """
+        return pulumi.get(self, "timeout")
+
+
+@pulumi.output_type
+class ReadinessProbe(dict):
+    def __init__(__self__, *,
+                 path: str,
+                 status: Optional[int] = None):
+        """
+        :param str path: Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
+        :param int status: Expected HTTP status of the response.
         """
-        pulumi.set(__self__, "settings", settings)
-        pulumi.set(__self__, "type", type)
-        if action_timeout is not None:
-            pulumi.set(__self__, "action_timeout", action_timeout)
-        if credentials is not None:
-            pulumi.set(__self__, "credentials", credentials)
-        if state_timeout is not None:
-            pulumi.set(__self__, "state_timeout", state_timeout)
+        pulumi.set(__self__, "path", path)
+        if status is not None:
+            pulumi.set(__self__, "status", status)
 
     @property
     @pulumi.getter
-    def settings(self) -> Mapping[str, str]:
+    def path(self) -> str:
         """
-        Settings for the connection type
+        Path requested on the instance (e.g. '/libs/granite/core/content/login.html').
         """
-        return pulumi.get(self, "settings")
+        return pulumi.get(self, "path")
 
     @property
     @pulumi.getter
-    def type(self) -> str:
+    def status(self) -> Optional[int]:
         """
-        Type of connection to use to connect to the machine on which AEM instance will be running.
+        Expected HTTP status of the response.
         """
-        return pulumi.get(self, "type")
+        return pulumi.get(self, "status")
+
+
+@pulumi.output_type
+class ReplicationAgentInstanceModel(dict):
+    def __init__(__self__, *,
+                 enabled: bool,
+                 id: str,
+                 transport_uri: str):
+        """
+        :param bool enabled: Indicates if the replication agent is enabled on the AEM instance.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param str transport_uri: URI of the replication target read from the AEM instance.
+        """
+        pulumi.set(__self__, "enabled", enabled)
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "transport_uri", transport_uri)
 
     @property
     @pulumi.getter
-    def action_timeout(self) -> Optional[str]:
+    def enabled(self) -> bool:
         """
-        Used when trying to connect to the AEM instance machine (often right after creating it). Need to be enough long because various types of connections (like AWS SSM or SSH) may need some time to boot up the agent.
+        Indicates if the replication agent is enabled on the AEM instance.
         """
-        return pulumi.get(self, "action_timeout")
+        return pulumi.get(self, "enabled")
 
     @property
     @pulumi.getter
-    def credentials(self) -> Optional[Mapping[str, str]]:
+    def id(self) -> str:
         """
-        Credentials for the connection type
+        Unique identifier of AEM instance defined in the configuration.
         """
-        return pulumi.get(self, "credentials")
+        return pulumi.get(self, "id")
 
     @property
     @pulumi.getter
-    def state_timeout(self) -> Optional[str]:
+    def transport_uri(self) -> str:
         """
-        Used when reading the AEM instance state when determining the plan.
+        URI of the replication target read from the AEM instance.
         """
-        return pulumi.get(self, "state_timeout")
+        return pulumi.get(self, "transport_uri")
 
 
 @pulumi.output_type
-class Compose(dict):
+class RepoNodeInstanceModel(dict):
     def __init__(__self__, *,
-                 config: Optional[str] = None,
-                 configure: Optional['outputs.InstanceScript'] = None,
-                 create: Optional['outputs.InstanceScript'] = None,
-                 delete: Optional['outputs.InstanceScript'] = None,
-                 download: Optional[bool] = None,
-                 version: Optional[str] = None):
+                 exists: bool,
+                 id: str,
+                 properties: Mapping[str, Any]):
         """
-        :param str config: Contents of the AEM Compose YML configuration file.
-        :param 'InstanceScript' configure: Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
-        :param 'InstanceScript' create: Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
-        :param 'InstanceScript' delete: Script(s) for deleting a stopped instance.
-        :param bool download: Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
-        :param str version: Version of AEM Compose tool to use on remote machine.
+        :param bool exists: Indicates if the repository node exists on the AEM instance.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param Mapping[str, Any] properties: Properties of the repository node read from the AEM instance.
         """
-        if config is not None:
-            pulumi.set(__self__, "config", config)
-        if configure is not None:
-            pulumi.set(__self__, "configure", configure)
-        if create is not None:
-            pulumi.set(__self__, "create", create)
-        if delete is not None:
-            pulumi.set(__self__, "delete", delete)
-        if download is not None:
-            pulumi.set(__self__, "download", download)
-        if version is not None:
-            pulumi.set(__self__, "version", version)
+        pulumi.set(__self__, "exists", exists)
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "properties", properties)
 
     @property
     @pulumi.getter
-    def config(self) -> Optional[str]:
+    def exists(self) -> bool:
         """
-        Contents of the AEM Compose YML configuration file.
+        Indicates if the repository node exists on the AEM instance.
         """
-        return pulumi.get(self, "config")
+        return pulumi.get(self, "exists")
 
     @property
     @pulumi.getter
-    def configure(self) -> Optional['outputs.InstanceScript']:
+    def id(self) -> str:
         """
-        Script(s) for configuring a launched instance. Must be idempotent as it is executed always when changed. Typically used for installing AEM service packs, setting up replication agents, etc.
+        Unique identifier of AEM instance defined in the configuration.
         """
-        return pulumi.get(self, "configure")
+        return pulumi.get(self, "id")
 
     @property
     @pulumi.getter
-    def create(self) -> Optional['outputs.InstanceScript']:
+    def properties(self) -> Mapping[str, Any]:
         """
-        Script(s) for creating an instance or restoring it from a backup. Typically customized to provide AEM library files (quickstart.jar, license.properties, service packs) from alternative sources (e.g., AWS S3, Azure Blob Storage). Instance recreation is forced if changed.
+        Properties of the repository node read from the AEM instance.
         """
-        return pulumi.get(self, "create")
+        return pulumi.get(self, "properties")
+
+
+@pulumi.output_type
+class Schedule(dict):
+    def __init__(__self__, *,
+                 name: str,
+                 on_calendar: str,
+                 builtin: Optional[str] = None,
+                 script: Optional['outputs.InstanceScript'] = None):
+        """
+        :param str name: Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
+        :param str on_calendar: Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
+        :param str builtin: Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
+        :param 'InstanceScript' script: Script(s) to be executed. Mutually exclusive with 'builtin'.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "on_calendar", on_calendar)
+        if builtin is not None:
+            pulumi.set(__self__, "builtin", builtin)
+        if script is not None:
+            pulumi.set(__self__, "script", script)
 
     @property
     @pulumi.getter
-    def delete(self) -> Optional['outputs.InstanceScript']:
+    def name(self) -> str:
         """
-        Script(s) for deleting a stopped instance.
+        Unique name of the task. Used to name the system timer. May contain only lowercase letters, digits and dashes.
         """
-        return pulumi.get(self, "delete")
+        return pulumi.get(self, "name")
 
     @property
     @pulumi.getter
-    def download(self) -> Optional[bool]:
+    def on_calendar(self) -> str:
         """
-        Toggle automatic AEM Compose CLI wrapper download. If set to false, assume the wrapper is present in the data directory.
+        Time at which the task is executed in systemd calendar event syntax (e.g. 'daily', 'Sun *-*-* 02:00:00'). See documentation(https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html#Calendar%20Events).
         """
-        return pulumi.get(self, "download")
+        return pulumi.get(self, "on_calendar")
 
     @property
     @pulumi.getter
-    def version(self) -> Optional[str]:
+    def builtin(self) -> Optional[str]:
         """
-        Version of AEM Compose tool to use on remote machine.
+        Built-in task to be executed. Possible values are 'backup' (requires compose backup settings), 'revision-cleanup' and 'datastore-gc'. Mutually exclusive with 'script'.
         """
-        return pulumi.get(self, "version")
+        return pulumi.get(self, "builtin")
+
+    @property
+    @pulumi.getter
+    def script(self) -> Optional['outputs.InstanceScript']:
+        """
+        Script(s) to be executed. Mutually exclusive with 'builtin'.
+        """
+        return pulumi.get(self, "script")
 
 
 @pulumi.output_type
-class InstanceModel(dict):
+class ScriptInstanceModel(dict):
     def __init__(__self__, *,
-                 aem_version: str,
-                 attributes: Sequence[str],
-                 dir: str,
                  id: str,
-                 run_modes: Sequence[str],
-                 url: str):
+                 output: str,
+                 result: str):
         """
-        :param str aem_version: Version of the AEM instance. Reflects service pack installations.
-        :param Sequence[str] attributes: A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
-        :param str dir: Remote path in which AEM instance is stored.
         :param str id: Unique identifier of AEM instance defined in the configuration.
-        :param Sequence[str] run_modes: A list of run modes for a specific AEM instance.
-        :param str url: The machine-internal HTTP URL address used for communication with the AEM instance.
+        :param str output: Standard output of the script executed on the AEM instance.
+        :param str result: Result returned by the Groovy script executed on the AEM instance.
         """
-        pulumi.set(__self__, "aem_version", aem_version)
-        pulumi.set(__self__, "attributes", attributes)
-        pulumi.set(__self__, "dir", dir)
         pulumi.set(__self__, "id", id)
-        pulumi.set(__self__, "run_modes", run_modes)
-        pulumi.set(__self__, "url", url)
+        pulumi.set(__self__, "output", output)
+        pulumi.set(__self__, "result", result)
 
     @property
     @pulumi.getter
-    def aem_version(self) -> str:
+    def id(self) -> str:
         """
-        Version of the AEM instance. Reflects service pack installations.
+        Unique identifier of AEM instance defined in the configuration.
         """
-        return pulumi.get(self, "aem_version")
+        return pulumi.get(self, "id")
 
     @property
     @pulumi.getter
-    def attributes(self) -> Sequence[str]:
+    def output(self) -> str:
         """
-        A brief description of the state details for a specific AEM instance. Possible states include 'created', 'uncreated', 'running', 'unreachable', 'up-to-date', and 'out-of-date'.
+        Standard output of the script executed on the AEM instance.
         """
-        return pulumi.get(self, "attributes")
+        return pulumi.get(self, "output")
 
     @property
     @pulumi.getter
-    def dir(self) -> str:
+    def result(self) -> str:
         """
-        Remote path in which AEM instance is stored.
+        Result returned by the Groovy script executed on the AEM instance.
         """
-        return pulumi.get(self, "dir")
+        return pulumi.get(self, "result")
+
+
+@pulumi.output_type
+class SmokeTestAuth(dict):
+    def __init__(__self__, *,
+                 password: Optional[str] = None,
+                 user: Optional[str] = None):
+        """
+        :param str password: Password of the user.
+        :param str user: Name of the user. When empty, the request is performed anonymously.
+        """
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
 
     @property
     @pulumi.getter
-    def id(self) -> str:
+    def password(self) -> Optional[str]:
         """
-        Unique identifier of AEM instance defined in the configuration.
+        Password of the user.
         """
-        return pulumi.get(self, "id")
+        return pulumi.get(self, "password")
 
     @property
     @pulumi.getter
-    def run_modes(self) -> Sequence[str]:
+    def user(self) -> Optional[str]:
         """
-        A list of run modes for a specific AEM instance.
+        Name of the user. When empty, the request is performed anonymously.
         """
-        return pulumi.get(self, "run_modes")
+        return pulumi.get(self, "user")
+
+
+@pulumi.output_type
+class SmokeTestCheck(dict):
+    def __init__(__self__, *,
+                 path: str,
+                 auth: Optional['outputs.SmokeTestAuth'] = None,
+                 expected_body: Optional[str] = None,
+                 expected_status: Optional[int] = None,
+                 json_path: Optional[str] = None,
+                 json_value: Optional[str] = None,
+                 method: Optional[str] = None,
+                 name: Optional[str] = None):
+        """
+        :param str path: Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
+        :param 'SmokeTestAuth' auth: Credentials used to perform the request. By default, the credentials of the instance are used.
+        :param str expected_body: Regular expression which the response body needs to match.
+        :param int expected_status: Expected HTTP status of the response.
+        :param str json_path: Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+        :param str json_value: Expected value found at the JSON path.
+        :param str method: HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+        :param str name: Name of the check used in the results. By default, the method and path are used.
+        """
+        pulumi.set(__self__, "path", path)
+        if auth is not None:
+            pulumi.set(__self__, "auth", auth)
+        if expected_body is not None:
+            pulumi.set(__self__, "expected_body", expected_body)
+        if expected_status is not None:
+            pulumi.set(__self__, "expected_status", expected_status)
+        if json_path is not None:
+            pulumi.set(__self__, "json_path", json_path)
+        if json_value is not None:
+            pulumi.set(__self__, "json_value", json_value)
+        if method is not None:
+            pulumi.set(__self__, "method", method)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
 
     @property
     @pulumi.getter
-    def url(self) -> str:
+    def path(self) -> str:
         """
-        The machine-internal HTTP URL address used for communication with the AEM instance.
+        Path of the HTTP request including query string (e.g. '/content/acme/us/en.html').
         """
-        return pulumi.get(self, "url")
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter
+    def auth(self) -> Optional['outputs.SmokeTestAuth']:
+        """
+        Credentials used to perform the request. By default, the credentials of the instance are used.
+        """
+        return pulumi.get(self, "auth")
+
+    @property
+    @pulumi.getter
+    def expected_body(self) -> Optional[str]:
+        """
+        Regular expression which the response body needs to match.
+        """
+        return pulumi.get(self, "expected_body")
+
+    @property
+    @pulumi.getter
+    def expected_status(self) -> Optional[int]:
+        """
+        Expected HTTP status of the response.
+        """
+        return pulumi.get(self, "expected_status")
+
+    @property
+    @pulumi.getter
+    def json_path(self) -> Optional[str]:
+        """
+        Path to the value in the JSON response body which needs to exist (e.g. 'items[0].title').
+        """
+        return pulumi.get(self, "json_path")
+
+    @property
+    @pulumi.getter
+    def json_value(self) -> Optional[str]:
+        """
+        Expected value found at the JSON path.
+        """
+        return pulumi.get(self, "json_value")
+
+    @property
+    @pulumi.getter
+    def method(self) -> Optional[str]:
+        """
+        HTTP method of the request. Possible values are 'GET', 'HEAD' and 'POST'.
+        """
+        return pulumi.get(self, "method")
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[str]:
+        """
+        Name of the check used in the results. By default, the method and path are used.
+        """
+        return pulumi.get(self, "name")
 
 
 @pulumi.output_type
-class InstanceScript(dict):
+class SmokeTestResult(dict):
     def __init__(__self__, *,
-                 inline: Optional[Sequence[str]] = None,
-                 script: Optional[str] = None):
+                 check: str,
+                 instance_id: str,
+                 message: str,
+                 passed: bool,
+                 status: int):
         """
-        :param Sequence[str] inline: Inline shell commands to be executed
-        :param str script: Multiline shell script to be executed
+        :param str check: Name of the check.
+        :param str instance_id: Unique identifier of AEM instance on which the check was performed.
+        :param str message: Reason of the failure.
+        :param bool passed: Indicates if the response met the expectations.
+        :param int status: HTTP status of the response.
         """
-        if inline is not None:
-            pulumi.set(__self__, "inline", inline)
-        if script is not None:
-            pulumi.set(__self__, "script", script)
+        pulumi.set(__self__, "check", check)
+        pulumi.set(__self__, "instance_id", instance_id)
+        pulumi.set(__self__, "message", message)
+        pulumi.set(__self__, "passed", passed)
+        pulumi.set(__self__, "status", status)
 
     @property
     @pulumi.getter
-    def inline(self) -> Optional[Sequence[str]]:
+    def check(self) -> str:
         """
-        Inline shell commands to be executed
+        Name of the check.
         """
-        return pulumi.get(self, "inline")
+        return pulumi.get(self, "check")
 
     @property
     @pulumi.getter
-    def script(self) -> Optional[str]:
+    def instance_id(self) -> str:
         """
-        Multiline shell script to be executed
+        Unique identifier of AEM instance on which the check was performed.
         """
-        return pulumi.get(self, "script")
+        return pulumi.get(self, "instance_id")
+
+    @property
+    @pulumi.getter
+    def message(self) -> str:
+        """
+        Reason of the failure.
+        """
+        return pulumi.get(self, "message")
+
+    @property
+    @pulumi.getter
+    def passed(self) -> bool:
+        """
+        Indicates if the response met the expectations.
+        """
+        return pulumi.get(self, "passed")
+
+    @property
+    @pulumi.getter
+    def status(self) -> int:
+        """
+        HTTP status of the response.
+        """
+        return pulumi.get(self, "status")
 
 
 @pulumi.output_type
@@ -272,6 +1596,7 @@ class System(dict):
                  bootstrap: Optional['outputs.InstanceScript'] = None,
                  data_dir: Optional[str] = None,
                  env: Optional[Mapping[str, str]] = None,
+                 schedules: Optional[Sequence['outputs.Schedule']] = None,
                  service_config: Optional[str] = None,
                  user: Optional[str] = None,
                  work_dir: Optional[str] = None):
@@ -279,6 +1604,7 @@ class System(dict):
         :param 'InstanceScript' bootstrap: Script executed once upon instance connection, often for mounting on VM data volumes from attached disks (e.g., AWS EBS, Azure Disk Storage). This script runs only once, even during instance recreation, as changes are typically persistent and system-wide. If re-execution is needed, it is recommended to set up a new machine.
         :param str data_dir: Remote root path in which AEM Compose files and unpacked AEM instances will be stored.
         :param Mapping[str, str] env: Environment variables for AEM instances.
+        :param Sequence['Schedule'] schedules: Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
         :param str service_config: Contents of the AEM system service definition file (systemd).
         :param str user: System user under which AEM instance will be running. By default, the same as the user used to connect to the machine.
         :param str work_dir: Remote root path where provider-related files will be stored.
@@ -289,6 +1615,8 @@ class System(dict):
             pulumi.set(__self__, "data_dir", data_dir)
         if env is not None:
             pulumi.set(__self__, "env", env)
+        if schedules is not None:
+            pulumi.set(__self__, "schedules", schedules)
         if service_config is not None:
             pulumi.set(__self__, "service_config", service_config)
         if user is not None:
@@ -320,6 +1648,14 @@ class System(dict):
         """
         return pulumi.get(self, "env")
 
+    @property
+    @pulumi.getter
+    def schedules(self) -> Optional[Sequence['outputs.Schedule']]:
+        """
+        Tasks executed periodically on the machine (e.g. backups, maintenance). Installed as system timers (systemd). Timers removed from the list are uninstalled.
+        """
+        return pulumi.get(self, "schedules")
+
     @property
     @pulumi.getter
     def service_config(self) -> Optional[str]:
@@ -345,3 +1681,54 @@ class System(dict):
         return pulumi.get(self, "work_dir")
 
 
+@pulumi.output_type
+class UserInstanceModel(dict):
+    def __init__(__self__, *,
+                 exists: bool,
+                 groups: Sequence[str],
+                 id: str,
+                 path: str):
+        """
+        :param bool exists: Indicates if the user exists on the AEM instance.
+        :param Sequence[str] groups: Identifiers of the groups to which the user directly belongs on the AEM instance.
+        :param str id: Unique identifier of AEM instance defined in the configuration.
+        :param str path: Repository path of the user on the AEM instance.
+        """
+        pulumi.set(__self__, "exists", exists)
+        pulumi.set(__self__, "groups", groups)
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "path", path)
+
+    @property
+    @pulumi.getter
+    def exists(self) -> bool:
+        """
+        Indicates if the user exists on the AEM instance.
+        """
+        return pulumi.get(self, "exists")
+
+    @property
+    @pulumi.getter
+    def groups(self) -> Sequence[str]:
+        """
+        Identifiers of the groups to which the user directly belongs on the AEM instance.
+        """
+        return pulumi.get(self, "groups")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        Unique identifier of AEM instance defined in the configuration.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def path(self) -> str:
+        """
+        Repository path of the user on the AEM instance.
+        """
+        return pulumi.get(self, "path")
+
+
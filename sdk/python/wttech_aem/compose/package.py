@@ -0,0 +1,384 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['PackageArgs', 'Package']
+
+@pulumi.input_type
+class PackageArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 checksum: Optional[pulumi.Input[str]] = None,
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 file: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 maven: Optional[pulumi.Input[str]] = None,
+                 maven_repository: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None,
+                 url: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Package resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] checksum: SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] file: Local path of the AEM package file to be deployed.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] maven: Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+        :param pulumi.Input[str] maven_repository: URL of the Maven repository from which the AEM package is downloaded.
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] url: URL of the AEM package file to be downloaded on the machine and deployed.
+        """
+        pulumi.set(__self__, "client", client)
+        if checksum is not None:
+            pulumi.set(__self__, "checksum", checksum)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if file is not None:
+            pulumi.set(__self__, "file", file)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if maven is not None:
+            pulumi.set(__self__, "maven", maven)
+        if maven_repository is not None:
+            pulumi.set(__self__, "maven_repository", maven_repository)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+        if url is not None:
+            pulumi.set(__self__, "url", url)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def checksum(self) -> Optional[pulumi.Input[str]]:
+        """
+        SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+        """
+        return pulumi.get(self, "checksum")
+
+    @checksum.setter
+    def checksum(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "checksum", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def file(self) -> Optional[pulumi.Input[str]]:
+        """
+        Local path of the AEM package file to be deployed.
+        """
+        return pulumi.get(self, "file")
+
+    @file.setter
+    def file(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "file", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def maven(self) -> Optional[pulumi.Input[str]]:
+        """
+        Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+        """
+        return pulumi.get(self, "maven")
+
+    @maven.setter
+    def maven(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "maven", value)
+
+    @property
+    @pulumi.getter
+    def maven_repository(self) -> Optional[pulumi.Input[str]]:
+        """
+        URL of the Maven repository from which the AEM package is downloaded.
+        """
+        return pulumi.get(self, "maven_repository")
+
+    @maven_repository.setter
+    def maven_repository(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "maven_repository", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+    @property
+    @pulumi.getter
+    def url(self) -> Optional[pulumi.Input[str]]:
+        """
+        URL of the AEM package file to be downloaded on the machine and deployed.
+        """
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "url", value)
+
+
+class Package(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 checksum: Optional[pulumi.Input[str]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 file: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 maven: Optional[pulumi.Input[str]] = None,
+                 maven_repository: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Content package deployed to the AEM instances. Redeployed when the package file changes and uninstalled when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] checksum: SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] file: Local path of the AEM package file to be deployed.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] maven: Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+        :param pulumi.Input[str] maven_repository: URL of the Maven repository from which the AEM package is downloaded.
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] url: URL of the AEM package file to be downloaded on the machine and deployed.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: PackageArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Content package deployed to the AEM instances. Redeployed when the package file changes and uninstalled when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param PackageArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(PackageArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 checksum: Optional[pulumi.Input[str]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 file: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 maven: Optional[pulumi.Input[str]] = None,
+                 maven_repository: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = PackageArgs.__new__(PackageArgs)
+
+            __props__.__dict__["checksum"] = checksum
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["file"] = file
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["maven"] = maven
+            __props__.__dict__["maven_repository"] = maven_repository
+            __props__.__dict__["system"] = system
+            __props__.__dict__["url"] = url
+            __props__.__dict__["instances"] = None
+            __props__.__dict__["pid"] = None
+            __props__.__dict__["remote_path"] = None
+        super(Package, __self__).__init__(
+            'aem:compose:Package',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Package':
+        """
+        Get an existing Package resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = PackageArgs.__new__(PackageArgs)
+
+        __props__.__dict__["checksum"] = None
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["file"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["maven"] = None
+        __props__.__dict__["maven_repository"] = None
+        __props__.__dict__["pid"] = None
+        __props__.__dict__["remote_path"] = None
+        __props__.__dict__["system"] = None
+        __props__.__dict__["url"] = None
+        return Package(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def checksum(self) -> pulumi.Output[Optional[str]]:
+        """
+        SHA-256 checksum of the AEM package file. Computed automatically for local files. For remote files, it is verified after downloading and could be changed to force redeployment.
+        """
+        return pulumi.get(self, "checksum")
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def file(self) -> pulumi.Output[Optional[str]]:
+        """
+        Local path of the AEM package file to be deployed.
+        """
+        return pulumi.get(self, "file")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.PackageInstanceModel']]:
+        """
+        Current state of the AEM package on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def maven(self) -> pulumi.Output[Optional[str]]:
+        """
+        Maven coordinates of the AEM package to be downloaded on the machine and deployed (in format 'group:artifact:version[:classifier]').
+        """
+        return pulumi.get(self, "maven")
+
+    @property
+    @pulumi.getter
+    def maven_repository(self) -> pulumi.Output[Optional[str]]:
+        """
+        URL of the Maven repository from which the AEM package is downloaded.
+        """
+        return pulumi.get(self, "maven_repository")
+
+    @property
+    @pulumi.getter
+    def pid(self) -> pulumi.Output[str]:
+        """
+        Identifier of the AEM package (in format 'group:name:version').
+        """
+        return pulumi.get(self, "pid")
+
+    @property
+    @pulumi.getter
+    def remote_path(self) -> pulumi.Output[str]:
+        """
+        Remote path of the AEM package file on the machine.
+        """
+        return pulumi.get(self, "remote_path")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Output[Optional[str]]:
+        """
+        URL of the AEM package file to be downloaded on the machine and deployed.
+        """
+        return pulumi.get(self, "url")
+
@@ -0,0 +1,495 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ReplicationAgentArgs', 'ReplicationAgent']
+
+@pulumi.input_type
+class ReplicationAgentArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 transport_uri: pulumi.Input[str],
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 location: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None,
+                 transport_password: Optional[pulumi.Input[str]] = None,
+                 transport_user: Optional[pulumi.Input[str]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 user_id: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a ReplicationAgent resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] transport_uri: URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[bool] enabled: Toggle the replication agent.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] location: Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+        :param pulumi.Input[str] name: Name of the replication agent. By default, the resource name is used.
+        :param pulumi.Input[Mapping[str, Any]] properties: Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] transport_password: Password used to authenticate on the replication target.
+        :param pulumi.Input[str] transport_user: User used to authenticate on the replication target.
+        :param pulumi.Input[str] type: Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+        :param pulumi.Input[str] user_id: User whose permissions are used when replicating content. By default, the system user is used.
+        """
+        pulumi.set(__self__, "client", client)
+        pulumi.set(__self__, "transport_uri", transport_uri)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if location is not None:
+            pulumi.set(__self__, "location", location)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if properties is not None:
+            pulumi.set(__self__, "properties", properties)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+        if transport_password is not None:
+            pulumi.set(__self__, "transport_password", transport_password)
+        if transport_user is not None:
+            pulumi.set(__self__, "transport_user", transport_user)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+        if user_id is not None:
+            pulumi.set(__self__, "user_id", user_id)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def transport_uri(self) -> pulumi.Input[str]:
+        """
+        URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+        """
+        return pulumi.get(self, "transport_uri")
+
+    @transport_uri.setter
+    def transport_uri(self, value: pulumi.Input[str]):
+        pulumi.set(self, "transport_uri", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Toggle the replication agent.
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def location(self) -> Optional[pulumi.Input[str]]:
+        """
+        Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+        """
+        return pulumi.get(self, "location")
+
+    @location.setter
+    def location(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "location", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the replication agent. By default, the resource name is used.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def properties(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+        """
+        return pulumi.get(self, "properties")
+
+    @properties.setter
+    def properties(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "properties", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+    @property
+    @pulumi.getter
+    def transport_password(self) -> Optional[pulumi.Input[str]]:
+        """
+        Password used to authenticate on the replication target.
+        """
+        return pulumi.get(self, "transport_password")
+
+    @transport_password.setter
+    def transport_password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "transport_password", value)
+
+    @property
+    @pulumi.getter
+    def transport_user(self) -> Optional[pulumi.Input[str]]:
+        """
+        User used to authenticate on the replication target.
+        """
+        return pulumi.get(self, "transport_user")
+
+    @transport_user.setter
+    def transport_user(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "transport_user", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[pulumi.Input[str]]:
+        """
+        Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter
+    def user_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        User whose permissions are used when replicating content. By default, the system user is used.
+        """
+        return pulumi.get(self, "user_id")
+
+    @user_id.setter
+    def user_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user_id", value)
+
+
+class ReplicationAgent(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 location: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 transport_password: Optional[pulumi.Input[str]] = None,
+                 transport_uri: Optional[pulumi.Input[str]] = None,
+                 transport_user: Optional[pulumi.Input[str]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 user_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Replication agent set up on the AEM instances. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[bool] enabled: Toggle the replication agent.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] location: Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+        :param pulumi.Input[str] name: Name of the replication agent. By default, the resource name is used.
+        :param pulumi.Input[Mapping[str, Any]] properties: Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] transport_password: Password used to authenticate on the replication target.
+        :param pulumi.Input[str] transport_uri: URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+        :param pulumi.Input[str] transport_user: User used to authenticate on the replication target.
+        :param pulumi.Input[str] type: Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+        :param pulumi.Input[str] user_id: User whose permissions are used when replicating content. By default, the system user is used.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ReplicationAgentArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Replication agent set up on the AEM instances. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param ReplicationAgentArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ReplicationAgentArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 location: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 transport_password: Optional[pulumi.Input[str]] = None,
+                 transport_uri: Optional[pulumi.Input[str]] = None,
+                 transport_user: Optional[pulumi.Input[str]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 user_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ReplicationAgentArgs.__new__(ReplicationAgentArgs)
+
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["enabled"] = enabled
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["location"] = location
+            __props__.__dict__["name"] = name
+            __props__.__dict__["properties"] = properties
+            __props__.__dict__["system"] = system
+            __props__.__dict__["transport_password"] = None if transport_password is None else pulumi.Output.secret(transport_password)
+            if transport_uri is None and not opts.urn:
+                raise TypeError("Missing required property 'transport_uri'")
+            __props__.__dict__["transport_uri"] = transport_uri
+            __props__.__dict__["transport_user"] = None if transport_user is None else pulumi.Output.secret(transport_user)
+            __props__.__dict__["type"] = type
+            __props__.__dict__["user_id"] = user_id
+            __props__.__dict__["instances"] = None
+            __props__.__dict__["path"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["transport_password", "transport_user"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["location", "name"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(ReplicationAgent, __self__).__init__(
+            'aem:compose:ReplicationAgent',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ReplicationAgent':
+        """
+        Get an existing ReplicationAgent resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ReplicationAgentArgs.__new__(ReplicationAgentArgs)
+
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["enabled"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["location"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["path"] = None
+        __props__.__dict__["properties"] = None
+        __props__.__dict__["system"] = None
+        __props__.__dict__["transport_password"] = None
+        __props__.__dict__["transport_uri"] = None
+        __props__.__dict__["transport_user"] = None
+        __props__.__dict__["type"] = None
+        __props__.__dict__["user_id"] = None
+        return ReplicationAgent(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Toggle the replication agent.
+        """
+        return pulumi.get(self, "enabled")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.ReplicationAgentInstanceModel']]:
+        """
+        Current state of the replication agent on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def location(self) -> pulumi.Output[Optional[str]]:
+        """
+        Location of the replication agent ('author' or 'publish'). Determines the agents root path (e.g. '/etc/replication/agents.author').
+        """
+        return pulumi.get(self, "location")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[str]]:
+        """
+        Name of the replication agent. By default, the resource name is used.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        Repository path of the replication agent.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter
+    def properties(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Additional properties of the replication agent (e.g. 'logLevel', 'retryDelay').
+        """
+        return pulumi.get(self, "properties")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @property
+    @pulumi.getter
+    def transport_password(self) -> pulumi.Output[Optional[str]]:
+        """
+        Password used to authenticate on the replication target.
+        """
+        return pulumi.get(self, "transport_password")
+
+    @property
+    @pulumi.getter
+    def transport_uri(self) -> pulumi.Output[str]:
+        """
+        URI of the replication target (e.g. 'http://localhost:4503/bin/receive?sling:authRequestLogin=1').
+        """
+        return pulumi.get(self, "transport_uri")
+
+    @property
+    @pulumi.getter
+    def transport_user(self) -> pulumi.Output[Optional[str]]:
+        """
+        User used to authenticate on the replication target.
+        """
+        return pulumi.get(self, "transport_user")
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Output[Optional[str]]:
+        """
+        Type of the replication agent. Possible values are 'publish' (replicates content to publish instance), 'flush' (invalidates dispatcher cache) and 'reverse' (replicates content from publish instance outbox).
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def user_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        User whose permissions are used when replicating content. By default, the system user is used.
+        """
+        return pulumi.get(self, "user_id")
+
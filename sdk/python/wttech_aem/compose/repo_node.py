@@ -0,0 +1,339 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['RepoNodeArgs', 'RepoNode']
+
+@pulumi.input_type
+class RepoNodeArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 path: pulumi.Input[str],
+                 properties: pulumi.Input[Mapping[str, Any]],
+                 children: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 mode: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None):
+        """
+        The set of arguments for constructing a RepoNode resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] path: Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+        :param pulumi.Input[Mapping[str, Any]] properties: Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+        :param pulumi.Input[Mapping[str, Any]] children: Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] mode: Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        pulumi.set(__self__, "client", client)
+        pulumi.set(__self__, "path", path)
+        pulumi.set(__self__, "properties", properties)
+        if children is not None:
+            pulumi.set(__self__, "children", children)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if mode is not None:
+            pulumi.set(__self__, "mode", mode)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Input[str]:
+        """
+        Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: pulumi.Input[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def properties(self) -> pulumi.Input[Mapping[str, Any]]:
+        """
+        Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+        """
+        return pulumi.get(self, "properties")
+
+    @properties.setter
+    def properties(self, value: pulumi.Input[Mapping[str, Any]]):
+        pulumi.set(self, "properties", value)
+
+    @property
+    @pulumi.getter
+    def children(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+        """
+        return pulumi.get(self, "children")
+
+    @children.setter
+    def children(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "children", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def mode(self) -> Optional[pulumi.Input[str]]:
+        """
+        Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+        """
+        return pulumi.get(self, "mode")
+
+    @mode.setter
+    def mode(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "mode", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+
+class RepoNode(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 children: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 mode: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        """
+        Repository node with properties and child nodes saved on the AEM instances (e.g. cloud service or context-aware configuration). Removed from the instances when the resource is deleted (in merge mode, only the managed properties and child nodes are removed).
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Mapping[str, Any]] children: Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] mode: Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+        :param pulumi.Input[str] path: Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+        :param pulumi.Input[Mapping[str, Any]] properties: Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: RepoNodeArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Repository node with properties and child nodes saved on the AEM instances (e.g. cloud service or context-aware configuration). Removed from the instances when the resource is deleted (in merge mode, only the managed properties and child nodes are removed).
+
+        :param str resource_name: The name of the resource.
+        :param RepoNodeArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(RepoNodeArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 children: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 mode: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 properties: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = RepoNodeArgs.__new__(RepoNodeArgs)
+
+            __props__.__dict__["children"] = children
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["mode"] = mode
+            if path is None and not opts.urn:
+                raise TypeError("Missing required property 'path'")
+            __props__.__dict__["path"] = path
+            if properties is None and not opts.urn:
+                raise TypeError("Missing required property 'properties'")
+            __props__.__dict__["properties"] = properties
+            __props__.__dict__["system"] = system
+            __props__.__dict__["instances"] = None
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["path"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(RepoNode, __self__).__init__(
+            'aem:compose:RepoNode',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'RepoNode':
+        """
+        Get an existing RepoNode resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = RepoNodeArgs.__new__(RepoNodeArgs)
+
+        __props__.__dict__["children"] = None
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["mode"] = None
+        __props__.__dict__["path"] = None
+        __props__.__dict__["properties"] = None
+        __props__.__dict__["system"] = None
+        return RepoNode(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def children(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Child nodes keyed by their relative names. Values are properties of the child nodes; nested objects are saved as deeper child nodes.
+        """
+        return pulumi.get(self, "children")
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.RepoNodeInstanceModel']]:
+        """
+        Current state of the repository node on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def mode(self) -> pulumi.Output[Optional[str]]:
+        """
+        Determines how the node is saved. Possible values are 'merge' (only managed properties are set, other ones are kept) and 'replace' (the node is recreated with the managed properties only).
+        """
+        return pulumi.get(self, "mode")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        Absolute JCR path of the repository node (e.g. '/conf/acme/sling:configs/com.acme.FeatureToggles').
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter
+    def properties(self) -> pulumi.Output[Mapping[str, Any]]:
+        """
+        Properties of the repository node (e.g. 'jcr:primaryType', 'enabled').
+        """
+        return pulumi.get(self, "properties")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
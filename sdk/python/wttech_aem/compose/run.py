@@ -0,0 +1,127 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from ._inputs import *
+
+__all__ = [
+    'RunResult',
+    'AwaitableRunResult',
+    'run',
+    'run_output',
+]
+
+@pulumi.output_type
+class RunResult:
+    def __init__(__self__, exit_code=None, stderr=None, stdout=None):
+        if exit_code and not isinstance(exit_code, int):
+            raise TypeError("Expected argument 'exit_code' to be a int")
+        pulumi.set(__self__, "exit_code", exit_code)
+        if stderr and not isinstance(stderr, str):
+            raise TypeError("Expected argument 'stderr' to be a str")
+        pulumi.set(__self__, "stderr", stderr)
+        if stdout and not isinstance(stdout, str):
+            raise TypeError("Expected argument 'stdout' to be a str")
+        pulumi.set(__self__, "stdout", stdout)
+
+    @property
+    @pulumi.getter
+    def exit_code(self) -> int:
+        """
+        Exit code of the command. Equals 124 when the command timed out.
+        """
+        return pulumi.get(self, "exit_code")
+
+    @property
+    @pulumi.getter
+    def stderr(self) -> str:
+        """
+        Standard error of the command.
+        """
+        return pulumi.get(self, "stderr")
+
+    @property
+    @pulumi.getter
+    def stdout(self) -> str:
+        """
+        Standard output of the command.
+        """
+        return pulumi.get(self, "stdout")
+
+
+class AwaitableRunResult(RunResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return RunResult(
+            exit_code=self.exit_code,
+            stderr=self.stderr,
+            stdout=self.stdout)
+
+
+def run(client: Optional[pulumi.InputType['Client']] = None,
+        command: Optional[str] = None,
+        dir: Optional[str] = None,
+        script: Optional[pulumi.InputType['InstanceScript']] = None,
+        sudo: Optional[bool] = None,
+        system: Optional[pulumi.InputType['System']] = None,
+        timeout: Optional[str] = None,
+        opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableRunResult:
+    """
+    Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param str command: Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.
+    :param str dir: Remote directory in which the command is executed. By default, the data directory is used.
+    :param pulumi.InputType['InstanceScript'] script: Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.
+    :param bool sudo: Execute the command as a superuser.
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param str timeout: Maximum time of the command execution. By default, the client action timeout is used.
+    """
+    __args__ = dict()
+    __args__['client'] = client
+    __args__['command'] = command
+    __args__['dir'] = dir
+    __args__['script'] = script
+    __args__['sudo'] = sudo
+    __args__['system'] = system
+    __args__['timeout'] = timeout
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('aem:compose:run', __args__, opts=opts, typ=RunResult).value
+
+    return AwaitableRunResult(
+        exit_code=pulumi.get(__ret__, 'exit_code'),
+        stderr=pulumi.get(__ret__, 'stderr'),
+        stdout=pulumi.get(__ret__, 'stdout'))
+
+
+@_utilities.lift_output_func(run)
+def run_output(client: Optional[pulumi.Input[pulumi.InputType['Client']]] = None,
+               command: Optional[pulumi.Input[Optional[str]]] = None,
+               dir: Optional[pulumi.Input[Optional[str]]] = None,
+               script: Optional[pulumi.Input[Optional[pulumi.InputType['InstanceScript']]]] = None,
+               sudo: Optional[pulumi.Input[Optional[bool]]] = None,
+               system: Optional[pulumi.Input[Optional[pulumi.InputType['System']]]] = None,
+               timeout: Optional[pulumi.Input[Optional[str]]] = None,
+               opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[RunResult]:
+    """
+    Executes the command on the machine on which the AEM instance is running (e.g. to read facts like the package list or bundle states).
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param str command: Shell command to be executed (e.g. 'sh aemw package list --output-format yaml'). Mutually exclusive with 'script'.
+    :param str dir: Remote directory in which the command is executed. By default, the data directory is used.
+    :param pulumi.InputType['InstanceScript'] script: Script(s) to be executed. Inline commands are stopped at the first failure. Mutually exclusive with 'command'.
+    :param bool sudo: Execute the command as a superuser.
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param str timeout: Maximum time of the command execution. By default, the client action timeout is used.
+    """
+    ...
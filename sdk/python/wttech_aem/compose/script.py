@@ -0,0 +1,335 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ScriptArgs', 'Script']
+
+@pulumi.input_type
+class ScriptArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 data: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 groovy: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input['SystemArgs']] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a Script resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] command: Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Mapping[str, Any]] data: Data passed to the Groovy script (available as 'data' variable).
+        :param pulumi.Input[str] groovy: Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input['SystemArgs'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] triggers: Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+        """
+        pulumi.set(__self__, "client", client)
+        if command is not None:
+            pulumi.set(__self__, "command", command)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if data is not None:
+            pulumi.set(__self__, "data", data)
+        if groovy is not None:
+            pulumi.set(__self__, "groovy", groovy)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def command(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def data(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Data passed to the Groovy script (available as 'data' variable).
+        """
+        return pulumi.get(self, "data")
+
+    @data.setter
+    def data(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "data", value)
+
+    @property
+    @pulumi.getter
+    def groovy(self) -> Optional[pulumi.Input[str]]:
+        """
+        Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+        """
+        return pulumi.get(self, "groovy")
+
+    @groovy.setter
+    def groovy(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "groovy", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input['SystemArgs']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input['SystemArgs']]):
+        pulumi.set(self, "system", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "triggers", value)
+
+
+class Script(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 data: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 groovy: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        Groovy script or AEM Compose CLI command executed on the AEM instances once per trigger values (e.g. content migration). Deleting the resource does not revert the effects of the script.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] command: Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Mapping[str, Any]] data: Data passed to the Groovy script (available as 'data' variable).
+        :param pulumi.Input[str] groovy: Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[pulumi.InputType['SystemArgs']] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] triggers: Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ScriptArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Groovy script or AEM Compose CLI command executed on the AEM instances once per trigger values (e.g. content migration). Deleting the resource does not revert the effects of the script.
+
+        :param str resource_name: The name of the resource.
+        :param ScriptArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ScriptArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 data: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 groovy: Optional[pulumi.Input[str]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 system: Optional[pulumi.Input[pulumi.InputType['SystemArgs']]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ScriptArgs.__new__(ScriptArgs)
+
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["command"] = command
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["data"] = data
+            __props__.__dict__["groovy"] = groovy
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["system"] = system
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["instances"] = None
+        super(Script, __self__).__init__(
+            'aem:compose:Script',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Script':
+        """
+        Get an existing Script resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ScriptArgs.__new__(ScriptArgs)
+
+        __props__.__dict__["client"] = None
+        __props__.__dict__["command"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["data"] = None
+        __props__.__dict__["groovy"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["system"] = None
+        __props__.__dict__["triggers"] = None
+        return Script(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def command(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Arguments of the AEM Compose CLI command executed against the instances (e.g. ['repl', 'agent', 'setup', ...]). Mutually exclusive with 'groovy'.
+        """
+        return pulumi.get(self, "command")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def data(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Data passed to the Groovy script (available as 'data' variable).
+        """
+        return pulumi.get(self, "data")
+
+    @property
+    @pulumi.getter
+    def groovy(self) -> pulumi.Output[Optional[str]]:
+        """
+        Groovy script executed using AEM Groovy Console (must be installed on the instances). Mutually exclusive with 'command'.
+        """
+        return pulumi.get(self, "groovy")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.ScriptInstanceModel']]:
+        """
+        Outputs recorded during the last execution of the script on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional['outputs.System']]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        Arbitrary values which cause the script to be executed again when changed. Changes of the script itself do not re-execute it, so that migrations run exactly once per trigger value.
+        """
+        return pulumi.get(self, "triggers")
+
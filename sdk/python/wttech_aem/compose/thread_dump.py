@@ -0,0 +1,86 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from ._inputs import *
+
+__all__ = [
+    'ThreadDumpResult',
+    'AwaitableThreadDumpResult',
+    'thread_dump',
+    'thread_dump_output',
+]
+
+@pulumi.output_type
+class ThreadDumpResult:
+    def __init__(__self__, content=None):
+        if content and not isinstance(content, str):
+            raise TypeError("Expected argument 'content' to be a str")
+        pulumi.set(__self__, "content", content)
+
+    @property
+    @pulumi.getter
+    def content(self) -> str:
+        """
+        Thread dump of the AEM instance JVM.
+        """
+        return pulumi.get(self, "content")
+
+
+class AwaitableThreadDumpResult(ThreadDumpResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ThreadDumpResult(
+            content=self.content)
+
+
+def thread_dump(client: Optional[pulumi.InputType['Client']] = None,
+                compose: Optional[pulumi.InputType['Compose']] = None,
+                instance_id: Optional[str] = None,
+                system: Optional[pulumi.InputType['System']] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableThreadDumpResult:
+    """
+    Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param str instance_id: Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    """
+    __args__ = dict()
+    __args__['client'] = client
+    __args__['compose'] = compose
+    __args__['instance_id'] = instance_id
+    __args__['system'] = system
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('aem:compose:threadDump', __args__, opts=opts, typ=ThreadDumpResult).value
+
+    return AwaitableThreadDumpResult(
+        content=pulumi.get(__ret__, 'content'))
+
+
+@_utilities.lift_output_func(thread_dump)
+def thread_dump_output(client: Optional[pulumi.Input[pulumi.InputType['Client']]] = None,
+                       compose: Optional[pulumi.Input[Optional[pulumi.InputType['Compose']]]] = None,
+                       instance_id: Optional[pulumi.Input[str]] = None,
+                       system: Optional[pulumi.Input[Optional[pulumi.InputType['System']]]] = None,
+                       opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[ThreadDumpResult]:
+    """
+    Takes the thread dump of the running AEM instance using JDK tools (jcmd or jstack) available on the machine.
+
+
+    :param pulumi.InputType['Client'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    :param pulumi.InputType['Compose'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+    :param str instance_id: Unique identifier of AEM instance defined in the configuration (e.g. 'local_author').
+    :param pulumi.InputType['System'] system: Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+    """
+    ...
@@ -0,0 +1,426 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['UserArgs', 'User']
+
+@pulumi.input_type
+class UserArgs:
+    def __init__(__self__, *,
+                 client: pulumi.Input['ClientArgs'],
+                 compose: Optional[pulumi.Input['ComposeArgs']] = None,
+                 email: Optional[pulumi.Input[str]] = None,
+                 family_name: Optional[pulumi.Input[str]] = None,
+                 given_name: Optional[pulumi.Input[str]] = None,
+                 groups: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 intermediate_path: Optional[pulumi.Input[str]] = None,
+                 password: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[bool]] = None,
+                 user_id: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a User resource.
+        :param pulumi.Input['ClientArgs'] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input['ComposeArgs'] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] email: Email address stored in the user profile.
+        :param pulumi.Input[str] family_name: Family name stored in the user profile.
+        :param pulumi.Input[str] given_name: Given name stored in the user profile.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] groups: Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] intermediate_path: Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+        :param pulumi.Input[str] password: Password of the user. When empty, the user is not able to log in with a password.
+        :param pulumi.Input[bool] system: Creates the system (service) user, which has no password and is used by the code through service user mappings.
+        :param pulumi.Input[str] user_id: Identifier of the user. By default, the resource name is used.
+        """
+        pulumi.set(__self__, "client", client)
+        if compose is not None:
+            pulumi.set(__self__, "compose", compose)
+        if email is not None:
+            pulumi.set(__self__, "email", email)
+        if family_name is not None:
+            pulumi.set(__self__, "family_name", family_name)
+        if given_name is not None:
+            pulumi.set(__self__, "given_name", given_name)
+        if groups is not None:
+            pulumi.set(__self__, "groups", groups)
+        if instance_ids is not None:
+            pulumi.set(__self__, "instance_ids", instance_ids)
+        if intermediate_path is not None:
+            pulumi.set(__self__, "intermediate_path", intermediate_path)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if system is not None:
+            pulumi.set(__self__, "system", system)
+        if user_id is not None:
+            pulumi.set(__self__, "user_id", user_id)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Input['ClientArgs']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @client.setter
+    def client(self, value: pulumi.Input['ClientArgs']):
+        pulumi.set(self, "client", value)
+
+    @property
+    @pulumi.getter
+    def compose(self) -> Optional[pulumi.Input['ComposeArgs']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @compose.setter
+    def compose(self, value: Optional[pulumi.Input['ComposeArgs']]):
+        pulumi.set(self, "compose", value)
+
+    @property
+    @pulumi.getter
+    def email(self) -> Optional[pulumi.Input[str]]:
+        """
+        Email address stored in the user profile.
+        """
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "email", value)
+
+    @property
+    @pulumi.getter
+    def family_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Family name stored in the user profile.
+        """
+        return pulumi.get(self, "family_name")
+
+    @family_name.setter
+    def family_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "family_name", value)
+
+    @property
+    @pulumi.getter
+    def given_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Given name stored in the user profile.
+        """
+        return pulumi.get(self, "given_name")
+
+    @given_name.setter
+    def given_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "given_name", value)
+
+    @property
+    @pulumi.getter
+    def groups(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+        """
+        return pulumi.get(self, "groups")
+
+    @groups.setter
+    def groups(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "groups", value)
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @instance_ids.setter
+    def instance_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "instance_ids", value)
+
+    @property
+    @pulumi.getter
+    def intermediate_path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+        """
+        return pulumi.get(self, "intermediate_path")
+
+    @intermediate_path.setter
+    def intermediate_path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "intermediate_path", value)
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[pulumi.Input[str]]:
+        """
+        Password of the user. When empty, the user is not able to log in with a password.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "password", value)
+
+    @property
+    @pulumi.getter
+    def system(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Creates the system (service) user, which has no password and is used by the code through service user mappings.
+        """
+        return pulumi.get(self, "system")
+
+    @system.setter
+    def system(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "system", value)
+
+    @property
+    @pulumi.getter
+    def user_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Identifier of the user. By default, the resource name is used.
+        """
+        return pulumi.get(self, "user_id")
+
+    @user_id.setter
+    def user_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user_id", value)
+
+
+class User(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 email: Optional[pulumi.Input[str]] = None,
+                 family_name: Optional[pulumi.Input[str]] = None,
+                 given_name: Optional[pulumi.Input[str]] = None,
+                 groups: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 intermediate_path: Optional[pulumi.Input[str]] = None,
+                 password: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[bool]] = None,
+                 user_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        User created on the AEM instances along with its group membership. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ClientArgs']] client: Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[pulumi.InputType['ComposeArgs']] compose: AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        :param pulumi.Input[str] email: Email address stored in the user profile.
+        :param pulumi.Input[str] family_name: Family name stored in the user profile.
+        :param pulumi.Input[str] given_name: Given name stored in the user profile.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] groups: Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] instance_ids: Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        :param pulumi.Input[str] intermediate_path: Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+        :param pulumi.Input[str] password: Password of the user. When empty, the user is not able to log in with a password.
+        :param pulumi.Input[bool] system: Creates the system (service) user, which has no password and is used by the code through service user mappings.
+        :param pulumi.Input[str] user_id: Identifier of the user. By default, the resource name is used.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: UserArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        User created on the AEM instances along with its group membership. Deleted from the instances when the resource is deleted.
+
+        :param str resource_name: The name of the resource.
+        :param UserArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(UserArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 client: Optional[pulumi.Input[pulumi.InputType['ClientArgs']]] = None,
+                 compose: Optional[pulumi.Input[pulumi.InputType['ComposeArgs']]] = None,
+                 email: Optional[pulumi.Input[str]] = None,
+                 family_name: Optional[pulumi.Input[str]] = None,
+                 given_name: Optional[pulumi.Input[str]] = None,
+                 groups: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 instance_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 intermediate_path: Optional[pulumi.Input[str]] = None,
+                 password: Optional[pulumi.Input[str]] = None,
+                 system: Optional[pulumi.Input[bool]] = None,
+                 user_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = UserArgs.__new__(UserArgs)
+
+            if client is None and not opts.urn:
+                raise TypeError("Missing required property 'client'")
+            __props__.__dict__["client"] = client
+            __props__.__dict__["compose"] = compose
+            __props__.__dict__["email"] = email
+            __props__.__dict__["family_name"] = family_name
+            __props__.__dict__["given_name"] = given_name
+            __props__.__dict__["groups"] = groups
+            __props__.__dict__["instance_ids"] = instance_ids
+            __props__.__dict__["intermediate_path"] = intermediate_path
+            __props__.__dict__["password"] = None if password is None else pulumi.Output.secret(password)
+            __props__.__dict__["system"] = system
+            __props__.__dict__["user_id"] = user_id
+            __props__.__dict__["instances"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["password"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["intermediate_path", "system", "user_id"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(User, __self__).__init__(
+            'aem:compose:User',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'User':
+        """
+        Get an existing User resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = UserArgs.__new__(UserArgs)
+
+        __props__.__dict__["client"] = None
+        __props__.__dict__["compose"] = None
+        __props__.__dict__["email"] = None
+        __props__.__dict__["family_name"] = None
+        __props__.__dict__["given_name"] = None
+        __props__.__dict__["groups"] = None
+        __props__.__dict__["instance_ids"] = None
+        __props__.__dict__["instances"] = None
+        __props__.__dict__["intermediate_path"] = None
+        __props__.__dict__["password"] = None
+        __props__.__dict__["system"] = None
+        __props__.__dict__["user_id"] = None
+        return User(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def client(self) -> pulumi.Output['outputs.Client']:
+        """
+        Connection settings used to access the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "client")
+
+    @property
+    @pulumi.getter
+    def compose(self) -> pulumi.Output[Optional['outputs.Compose']]:
+        """
+        AEM Compose CLI configuration of the AEM instance. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "compose")
+
+    @property
+    @pulumi.getter
+    def email(self) -> pulumi.Output[Optional[str]]:
+        """
+        Email address stored in the user profile.
+        """
+        return pulumi.get(self, "email")
+
+    @property
+    @pulumi.getter
+    def family_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        Family name stored in the user profile.
+        """
+        return pulumi.get(self, "family_name")
+
+    @property
+    @pulumi.getter
+    def given_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        Given name stored in the user profile.
+        """
+        return pulumi.get(self, "given_name")
+
+    @property
+    @pulumi.getter
+    def groups(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of the groups to which the user belongs (e.g. 'contributor'). Memberships not listed here are kept untouched.
+        """
+        return pulumi.get(self, "groups")
+
+    @property
+    @pulumi.getter
+    def instance_ids(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Identifiers of AEM instances defined in the configuration to operate on (e.g. 'local_author'). By default, all active instances are used.
+        """
+        return pulumi.get(self, "instance_ids")
+
+    @property
+    @pulumi.getter
+    def instances(self) -> pulumi.Output[Sequence['outputs.UserInstanceModel']]:
+        """
+        Current state of the user on the target AEM instances.
+        """
+        return pulumi.get(self, "instances")
+
+    @property
+    @pulumi.getter
+    def intermediate_path(self) -> pulumi.Output[Optional[str]]:
+        """
+        Path under which the user is created (e.g. '/home/users/acme'). By default, the path is generated by the repository.
+        """
+        return pulumi.get(self, "intermediate_path")
+
+    @property
+    @pulumi.getter
+    def password(self) -> pulumi.Output[Optional[str]]:
+        """
+        Password of the user. When empty, the user is not able to log in with a password.
+        """
+        return pulumi.get(self, "password")
+
+    @property
+    @pulumi.getter
+    def system(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Operating system configuration of the machine on which the AEM instance is running. Typically passed from the 'Instance' resource outputs.
+        """
+        return pulumi.get(self, "system")
+
+    @property
+    @pulumi.getter
+    def user_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        Identifier of the user. By default, the resource name is used.
+        """
+        return pulumi.get(self, "user_id")
+
@@ -1,6 +1,9 @@
 package tests
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/blang/semver"
@@ -37,6 +40,283 @@ func TestInstanceModelCheck(t *testing.T) {
 	assert.Equal(t, result, "/mnt/aemc")
 }
 
+func TestInstanceModelCheckConfigOverrides(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Instance"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+			"compose": resource.NewObjectProperty(resource.PropertyMap{
+				"config_overrides": resource.NewObjectProperty(resource.PropertyMap{
+					"java": resource.NewObjectProperty(resource.PropertyMap{
+						"home_dir": resource.NewStringProperty("/usr/lib/jvm/java-11"),
+					}),
+				}),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, response.Failures)
+	inputs := response.Inputs["compose"].V.(resource.PropertyMap)
+	assert.Equal(t, "replace", inputs["config_lists_strategy"].StringValue())
+	assert.True(t, inputs["config_overrides"].IsObject())
+}
+
+func TestInstanceModelCheckComposeInstances(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Instance"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+			"compose": resource.NewObjectProperty(resource.PropertyMap{
+				"instances": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewObjectProperty(resource.PropertyMap{
+						"id":       resource.NewStringProperty("dev_author"),
+						"http_url": resource.NewStringProperty("http://127.0.0.1:4502"),
+						"password": resource.NewStringProperty("s3cret"),
+					}),
+				}),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, response.Failures)
+	compose := response.Inputs["compose"].V.(resource.PropertyMap)
+	instance := compose["instances"].ArrayValue()[0].ObjectValue()
+	assert.Equal(t, "dev_author", instance["id"].StringValue())
+
+	schemaResponse, err := prov.GetSchema(p.GetSchemaRequest{})
+	require.NoError(t, err)
+	var schema struct {
+		Types map[string]struct {
+			Properties map[string]struct {
+				Secret bool `json:"secret"`
+			} `json:"properties"`
+		} `json:"types"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(schemaResponse.Schema), &schema))
+	assert.True(t, schema.Types["aem:compose:ComposeInstance"].Properties["password"].Secret)
+}
+
+func TestInstanceModelCheckAdminPassword(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Instance"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+			"compose": resource.NewObjectProperty(resource.PropertyMap{
+				"admin_password": resource.NewStringProperty("s3cret"),
+				"instances": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewObjectProperty(resource.PropertyMap{
+						"id":       resource.NewStringProperty("dev_author"),
+						"http_url": resource.NewStringProperty("http://127.0.0.1:4502"),
+						"password": resource.NewStringProperty("other"),
+					}),
+					resource.NewObjectProperty(resource.PropertyMap{
+						"id":       resource.NewStringProperty("dev_publish"),
+						"http_url": resource.NewStringProperty("http://127.0.0.1:4503"),
+						"user":     resource.NewStringProperty("deployer"),
+						"password": resource.NewStringProperty("other"),
+					}),
+				}),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "compose.instances", response.Failures[0].Property)
+}
+
+func TestInstanceModelCheckReadiness(t *testing.T) {
+	prov := provider()
+	check := func(compose resource.PropertyMap) (p.CheckResponse, error) {
+		return prov.Check(p.CheckRequest{
+			Urn: urn("Instance"),
+			News: resource.PropertyMap{
+				"client": resource.NewObjectProperty(resource.PropertyMap{
+					"type": resource.NewStringProperty("aws-ssm"),
+					"settings": resource.NewObjectProperty(resource.PropertyMap{
+						"instance_id": resource.NewStringProperty("i-0123456789"),
+					}),
+				}),
+				"compose": resource.NewObjectProperty(compose),
+			},
+		})
+	}
+
+	response, err := check(resource.PropertyMap{})
+	require.NoError(t, err)
+	assert.Empty(t, response.Failures)
+	assert.False(t, response.Inputs["compose"].ObjectValue().HasValue("readiness"))
+
+	response, err = check(resource.PropertyMap{
+		"readiness": resource.NewObjectProperty(resource.PropertyMap{
+			"probes": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewObjectProperty(resource.PropertyMap{"path": resource.NewStringProperty("/libs/granite/core/content/login.html")}),
+			}),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, response.Failures)
+	readiness := response.Inputs["compose"].ObjectValue()["readiness"].ObjectValue()
+	assert.Equal(t, "fail", readiness["policy"].StringValue())
+	assert.Equal(t, "10m", readiness["timeout"].StringValue())
+	assert.Equal(t, []resource.PropertyValue{resource.NewStringProperty("running"), resource.NewStringProperty("reachable"), resource.NewStringProperty("up-to-date")}, readiness["attributes"].ArrayValue())
+	assert.Equal(t, 200.0, readiness["probes"].ArrayValue()[0].ObjectValue()["status"].NumberValue())
+
+	response, err = check(resource.PropertyMap{
+		"readiness": resource.NewObjectProperty(resource.PropertyMap{
+			"attributes": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("responding")}),
+			"policy":     resource.NewStringProperty("ignore"),
+		}),
+	})
+	require.NoError(t, err)
+	var properties []string
+	for _, failure := range response.Failures {
+		properties = append(properties, failure.Property)
+	}
+	assert.ElementsMatch(t, []string{"compose.readiness.attributes", "compose.readiness.policy"}, properties)
+}
+
+func TestInstanceModelCheckBackup(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Instance"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+			"compose": resource.NewObjectProperty(resource.PropertyMap{
+				"backup": resource.NewObjectProperty(resource.PropertyMap{
+					"target": resource.NewStringProperty("s3://acme/backups"),
+				}),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, response.Failures)
+	backup := response.Inputs["compose"].ObjectValue()["backup"].ObjectValue()
+	assert.Equal(t, 7.0, backup["retention"].NumberValue())
+	assert.Equal(t, "gzip", backup["compression"].StringValue())
+	assert.False(t, backup["before_update"].BoolValue())
+	assert.False(t, backup["before_delete"].BoolValue())
+}
+
+func TestInstanceModelCheckFailures(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Instance"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("ssh"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"host": resource.NewStringProperty("x.x.x.x"),
+					"user": resource.NewStringProperty("root"),
+				}),
+				"action_timeout": resource.NewStringProperty("10 minutes"),
+			}),
+			"compose": resource.NewObjectProperty(resource.PropertyMap{
+				"config": resource.NewStringProperty("instance:\n  config:\n    local_author:\n      run_modes: local\n"),
+			}),
+			"preflight": resource.NewObjectProperty(resource.PropertyMap{
+				"java_version": resource.NewStringProperty("eleven"),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	var properties []string
+	for _, failure := range response.Failures {
+		properties = append(properties, failure.Property)
+	}
+	assert.Contains(t, properties, "client.settings.private_key")
+	assert.Contains(t, properties, "client.action_timeout")
+	assert.Contains(t, properties, "compose.config")
+	assert.Contains(t, properties, "preflight.java_version")
+}
+
+func TestPackageModelCheck(t *testing.T) {
+	prov := provider()
+
+	file := filepath.Join(t.TempDir(), "acme-all-1.0.0.zip")
+	require.NoError(t, os.WriteFile(file, []byte("acme"), 0644))
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Package"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+			"file": resource.NewStringProperty(file),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, "822b33ad87c148a0a20a5ba7cd5ebcaa68d36a18e7aad165554903f52ca82757", response.Inputs["checksum"].StringValue())
+}
+
+func TestSmokeTestModelCheck(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("SmokeTest"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+			"checks": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewObjectProperty(resource.PropertyMap{
+					"path": resource.NewStringProperty("/libs/granite/core/content/login.html"),
+				}),
+				resource.NewObjectProperty(resource.PropertyMap{
+					"path":       resource.NewStringProperty("content/acme.json"),
+					"json_value": resource.NewStringProperty("acme"),
+				}),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, response.Failures, 2)
+	check := response.Inputs["checks"].ArrayValue()[0].ObjectValue()
+	assert.Equal(t, "GET", check["method"].StringValue())
+	assert.Equal(t, float64(200), check["expected_status"].NumberValue())
+}
+
 func urn(typ string) resource.URN {
 	return resource.NewURN("stack", "proj", "",
 		tokens.Type("aem:compose:"+typ), "name")
@@ -45,3 +325,25 @@ func urn(typ string) resource.URN {
 func provider() integration.Server {
 	return integration.NewServer(aem.Name, semver.MustParse("1.0.0"), aem.Provider())
 }
+
+func TestInstanceModelCheckDefaultConfigure(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Instance"),
+		News: resource.PropertyMap{
+			"client": resource.NewObjectProperty(resource.PropertyMap{
+				"type": resource.NewStringProperty("aws-ssm"),
+				"settings": resource.NewObjectProperty(resource.PropertyMap{
+					"instance_id": resource.NewStringProperty("i-0123456789"),
+				}),
+			}),
+		},
+	})
+
+	require.NoError(t, err)
+	configure := response.Inputs["compose"].ObjectValue()["configure"].ObjectValue()["inline"].ArrayValue()
+	require.Len(t, configure, 2)
+	assert.Contains(t, configure[0].StringValue(), "sh aemw osgi config save --pid 'org.apache.sling.jcr.davex.impl.servlets.SlingDavExServlet'")
+	assert.Contains(t, configure[1].StringValue(), "sh aemw repl agent setup -A --location 'author' --name 'publish'")
+}